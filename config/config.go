@@ -6,6 +6,8 @@ import (
 	"os"
 	"strconv"
 	"time"
+
+	"github.com/project/library/internal/money"
 )
 
 type (
@@ -14,6 +16,12 @@ type (
 		PG
 		Outbox
 		Observability
+		Search
+		Uniqueness
+		Loan
+		Hold
+		Fine
+		Cover
 	}
 
 	GRPC struct {
@@ -32,13 +40,67 @@ type (
 	}
 
 	Outbox struct {
-		Enabled         bool          `env:"OUTBOX_ENABLED"`
-		Workers         int           `env:"OUTBOX_WORKERS"`
-		BatchSize       int           `env:"OUTBOX_BATCH_SIZE"`
-		WaitTimeMS      time.Duration `env:"OUTBOX_WAIT_TIME_MS"`
-		InProgressTTLMS time.Duration `env:"OUTBOX_IN_PROGRESS_TTL_MS"`
-		AuthorSendURL   string        `env:"OUTBOX_AUTHOR_SEND_URL"`
-		BookSendURL     string        `env:"OUTBOX_BOOK_SEND_URL"`
+		Enabled               bool          `env:"OUTBOX_ENABLED"`
+		Workers               int           `env:"OUTBOX_WORKERS"`
+		BatchSize             int           `env:"OUTBOX_BATCH_SIZE"`
+		WaitTimeMS            time.Duration `env:"OUTBOX_WAIT_TIME_MS"`
+		InProgressTTLMS       time.Duration `env:"OUTBOX_IN_PROGRESS_TTL_MS"`
+		AuthorSendURL         string        `env:"OUTBOX_AUTHOR_SEND_URL"`
+		BookSendURL           string        `env:"OUTBOX_BOOK_SEND_URL"`
+		BookDeletedSendURL    string        `env:"OUTBOX_BOOK_DELETED_SEND_URL"`
+		AuthorDeletedSendURL  string        `env:"OUTBOX_AUTHOR_DELETED_SEND_URL"`
+		AuthorMergedSendURL   string        `env:"OUTBOX_AUTHOR_MERGED_SEND_URL"`
+		BookGenreSendURL      string        `env:"OUTBOX_BOOK_GENRE_SEND_URL"`
+		PatronSendURL         string        `env:"OUTBOX_PATRON_SEND_URL"`
+		PatronDeletedSendURL  string        `env:"OUTBOX_PATRON_DELETED_SEND_URL"`
+		LoanSendURL           string        `env:"OUTBOX_LOAN_SEND_URL"`
+		HoldSendURL           string        `env:"OUTBOX_HOLD_SEND_URL"`
+		ChargeSendURL         string        `env:"OUTBOX_CHARGE_SEND_URL"`
+		ChargeReminderSendURL string        `env:"OUTBOX_CHARGE_REMINDER_SEND_URL"`
+	}
+
+	Search struct {
+		// Конфигурация полнотекстового поиска Postgres, используется и миграциями
+		TextConfig string `env:"SEARCH_TEXT_CONFIG"`
+		// Порог pg_trgm similarity для SearchAuthors, если он не задан в запросе
+		AuthorSimilarityThreshold float32 `env:"SEARCH_AUTHOR_SIMILARITY_THRESHOLD"`
+	}
+
+	Uniqueness struct {
+		// Авторы уникальны по нормализованному имени, книги - по названию и набору авторов
+		Enabled bool `env:"UNIQUENESS_ENABLED"`
+	}
+
+	Loan struct {
+		// Срок выдачи и продления в днях
+		PeriodDays int `env:"LOAN_PERIOD_DAYS"`
+	}
+
+	Hold struct {
+		// Сколько дней готовая бронь ждет читателя
+		PickupDays int `env:"HOLD_PICKUP_DAYS"`
+		// Период проверки просроченных готовых броней
+		SweepIntervalMS time.Duration `env:"HOLD_SWEEP_INTERVAL_MS"`
+	}
+
+	Fine struct {
+		// Штраф за день просрочки (больше 0) и максимальный штраф за один возврат в сотых долях,
+		// 0 - без ограничения, кроме предела колонки charge.amount.
+		// В переменных окружения задаются с точностью до сотых, например "0.25"
+		DailyRate int64 `env:"FINE_DAILY_RATE"`
+		MaxAmount int64 `env:"FINE_MAX_AMOUNT"`
+		// Сколько дней просрочки не штрафуются
+		GraceDays int `env:"FINE_GRACE_DAYS"`
+		// Период проверки неоплаченных штрафов и интервал между напоминаниями об одном штрафе
+		ReminderIntervalMS time.Duration `env:"FINE_REMINDER_INTERVAL_MS"`
+		ReminderRepeatDays int           `env:"FINE_REMINDER_REPEAT_DAYS"`
+	}
+
+	Cover struct {
+		// Хранилище содержимого обложек: postgres (large object) или fs
+		Storage string `env:"COVER_STORAGE"`
+		// Каталог для хранилища fs
+		Dir string `env:"COVER_DIR"`
 	}
 
 	Observability struct {
@@ -48,6 +110,21 @@ type (
 	}
 )
 
+const (
+	defaultTextConfig          = "russian"
+	defaultSimilarityThreshold = 0.3
+	defaultLoanPeriodDays      = 14
+	defaultHoldPickupDays      = 3
+	defaultHoldSweepInterval   = time.Minute
+	defaultFineDailyRate       = 25
+	defaultFineMaxAmount       = 1000
+	defaultFineGraceDays       = 1
+	defaultReminderInterval    = time.Hour
+	defaultReminderRepeatDays  = 7
+	defaultCoverStorage        = "postgres"
+	defaultCoverDir            = "covers"
+)
+
 func New() (*Config, error) {
 	cfg := &Config{}
 
@@ -98,15 +175,188 @@ func New() (*Config, error) {
 
 		cfg.Outbox.BookSendURL = os.Getenv("OUTBOX_BOOK_SEND_URL")
 		cfg.Outbox.AuthorSendURL = os.Getenv("OUTBOX_AUTHOR_SEND_URL")
+		cfg.Outbox.BookDeletedSendURL = os.Getenv("OUTBOX_BOOK_DELETED_SEND_URL")
+		cfg.Outbox.AuthorDeletedSendURL = os.Getenv("OUTBOX_AUTHOR_DELETED_SEND_URL")
+		cfg.Outbox.AuthorMergedSendURL = os.Getenv("OUTBOX_AUTHOR_MERGED_SEND_URL")
+		cfg.Outbox.BookGenreSendURL = os.Getenv("OUTBOX_BOOK_GENRE_SEND_URL")
+		cfg.Outbox.PatronSendURL = os.Getenv("OUTBOX_PATRON_SEND_URL")
+		cfg.Outbox.PatronDeletedSendURL = os.Getenv("OUTBOX_PATRON_DELETED_SEND_URL")
+		cfg.Outbox.LoanSendURL = os.Getenv("OUTBOX_LOAN_SEND_URL")
+		cfg.Outbox.HoldSendURL = os.Getenv("OUTBOX_HOLD_SEND_URL")
+		cfg.Outbox.ChargeSendURL = os.Getenv("OUTBOX_CHARGE_SEND_URL")
+		cfg.Outbox.ChargeReminderSendURL = os.Getenv("OUTBOX_CHARGE_REMINDER_SEND_URL")
 	}
 
 	cfg.Observability.JaegerURL = os.Getenv("JAEGER_URL")
 	cfg.Observability.MetricsPort = os.Getenv("METRICS_PORT")
 	cfg.Observability.PyroscopeUrl = os.Getenv("PYROSCOPE_URL")
 
+	cfg.Search.TextConfig, err = parseTextConfig(os.Getenv("SEARCH_TEXT_CONFIG"))
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Search.AuthorSimilarityThreshold, err = parseSimilarityThreshold(os.Getenv("SEARCH_AUTHOR_SIMILARITY_THRESHOLD"))
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Loan.PeriodDays, err = parseDays(os.Getenv("LOAN_PERIOD_DAYS"), defaultLoanPeriodDays)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Hold.PickupDays, err = parseDays(os.Getenv("HOLD_PICKUP_DAYS"), defaultHoldPickupDays)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Hold.SweepIntervalMS, err = parseInterval(os.Getenv("HOLD_SWEEP_INTERVAL_MS"), defaultHoldSweepInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Fine.DailyRate, err = parseAmount(os.Getenv("FINE_DAILY_RATE"), defaultFineDailyRate)
+	if err != nil {
+		return nil, err
+	}
+
+	// С нулевой ставкой любой просроченный возврат укладывался бы в льготный период
+	if cfg.Fine.DailyRate == 0 {
+		return nil, fmt.Errorf("fine daily rate must be positive: %s", os.Getenv("FINE_DAILY_RATE"))
+	}
+
+	cfg.Fine.MaxAmount, err = parseAmount(os.Getenv("FINE_MAX_AMOUNT"), defaultFineMaxAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Fine.GraceDays = defaultFineGraceDays
+	if graceDays := os.Getenv("FINE_GRACE_DAYS"); graceDays != "" {
+		cfg.Fine.GraceDays, err = parseInt(graceDays)
+		if err != nil {
+			return nil, err
+		}
+
+		if cfg.Fine.GraceDays < 0 {
+			return nil, fmt.Errorf("grace days must not be negative: %s", graceDays)
+		}
+	}
+
+	cfg.Fine.ReminderIntervalMS, err = parseInterval(os.Getenv("FINE_REMINDER_INTERVAL_MS"), defaultReminderInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Fine.ReminderRepeatDays, err = parseDays(os.Getenv("FINE_REMINDER_REPEAT_DAYS"), defaultReminderRepeatDays)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Cover.Storage, err = parseCoverStorage(os.Getenv("COVER_STORAGE"))
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Cover.Dir = os.Getenv("COVER_DIR")
+	if cfg.Cover.Dir == "" {
+		cfg.Cover.Dir = defaultCoverDir
+	}
+
+	if uniqueness := os.Getenv("UNIQUENESS_ENABLED"); uniqueness != "" {
+		cfg.Uniqueness.Enabled, err = strconv.ParseBool(uniqueness)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return cfg, nil
 }
 
+// parseTextConfig ограничивает набор конфигураций, т.к. значение подставляется в миграцию
+func parseTextConfig(s string) (string, error) {
+	switch s {
+	case "":
+		return defaultTextConfig, nil
+	case "russian", "english", "simple":
+		return s, nil
+	default:
+		return "", fmt.Errorf("unsupported text search config: %s", s)
+	}
+}
+
+func parseCoverStorage(s string) (string, error) {
+	switch s {
+	case "":
+		return defaultCoverStorage, nil
+	case "postgres", "fs":
+		return s, nil
+	default:
+		return "", fmt.Errorf("unsupported cover storage: %s", s)
+	}
+}
+
+func parseSimilarityThreshold(s string) (float32, error) {
+	if s == "" {
+		return defaultSimilarityThreshold, nil
+	}
+
+	threshold, err := strconv.ParseFloat(s, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	if threshold <= 0 || threshold > 1 {
+		return 0, fmt.Errorf("similarity threshold must be in (0, 1]: %s", s)
+	}
+
+	return float32(threshold), nil
+}
+
+func parseDays(s string, defaultDays int) (int, error) {
+	if s == "" {
+		return defaultDays, nil
+	}
+
+	days, err := parseInt(s)
+	if err != nil {
+		return 0, err
+	}
+
+	if days <= 0 {
+		return 0, fmt.Errorf("number of days must be positive: %s", s)
+	}
+
+	return days, nil
+}
+
+// parseInterval разбирает период фоновой задачи в миллисекундах: time.NewTicker не принимает период <= 0
+func parseInterval(s string, defaultInterval time.Duration) (time.Duration, error) {
+	if s == "" {
+		return defaultInterval, nil
+	}
+
+	interval, err := parseTime(s)
+	if err != nil {
+		return 0, err
+	}
+
+	if interval <= 0 {
+		return 0, fmt.Errorf("interval must be positive: %s", s)
+	}
+
+	return interval, nil
+}
+
+// parseAmount разбирает денежную сумму и возвращает ее в сотых долях: "0.25" -> 25
+func parseAmount(s string, defaultAmount int64) (int64, error) {
+	if s == "" {
+		return defaultAmount, nil
+	}
+
+	return money.Parse(s)
+}
+
 func parseInt(s string) (int, error) {
 	num, err := strconv.ParseInt(s, 10, 64)
 	if err != nil {
@@ -20,21 +20,44 @@ func TestNew(t *testing.T) {
 		{
 			name: "ValidConfig",
 			envVars: map[string]string{
-				"GRPC_PORT":                 "50051",
-				"GRPC_GATEWAY_PORT":         "8080",
-				"POSTGRES_HOST":             "localhost",
-				"POSTGRES_PORT":             "5432",
-				"POSTGRES_DB":               "testdb",
-				"POSTGRES_USER":             "testuser",
-				"POSTGRES_PASSWORD":         "testpassword",
-				"POSTGRES_MAX_CONN":         "10",
-				"OUTBOX_ENABLED":            "true",
-				"OUTBOX_WORKERS":            "5",
-				"OUTBOX_BATCH_SIZE":         "100",
-				"OUTBOX_WAIT_TIME_MS":       "500",
-				"OUTBOX_IN_PROGRESS_TTL_MS": "1000",
-				"OUTBOX_BOOK_SEND_URL":      "http://book-service/send",
-				"OUTBOX_AUTHOR_SEND_URL":    "http://author-service/send",
+				"GRPC_PORT":                          "50051",
+				"GRPC_GATEWAY_PORT":                  "8080",
+				"POSTGRES_HOST":                      "localhost",
+				"POSTGRES_PORT":                      "5432",
+				"POSTGRES_DB":                        "testdb",
+				"POSTGRES_USER":                      "testuser",
+				"POSTGRES_PASSWORD":                  "testpassword",
+				"POSTGRES_MAX_CONN":                  "10",
+				"OUTBOX_ENABLED":                     "true",
+				"OUTBOX_WORKERS":                     "5",
+				"OUTBOX_BATCH_SIZE":                  "100",
+				"OUTBOX_WAIT_TIME_MS":                "500",
+				"OUTBOX_IN_PROGRESS_TTL_MS":          "1000",
+				"OUTBOX_BOOK_SEND_URL":               "http://book-service/send",
+				"OUTBOX_AUTHOR_SEND_URL":             "http://author-service/send",
+				"OUTBOX_BOOK_DELETED_SEND_URL":       "http://book-service/deleted",
+				"OUTBOX_AUTHOR_DELETED_SEND_URL":     "http://author-service/deleted",
+				"OUTBOX_AUTHOR_MERGED_SEND_URL":      "http://author-service/merged",
+				"OUTBOX_BOOK_GENRE_SEND_URL":         "http://book-service/genres",
+				"OUTBOX_PATRON_SEND_URL":             "http://patron-service/send",
+				"OUTBOX_PATRON_DELETED_SEND_URL":     "http://patron-service/deleted",
+				"OUTBOX_LOAN_SEND_URL":               "http://loan-service/send",
+				"LOAN_PERIOD_DAYS":                   "21",
+				"OUTBOX_HOLD_SEND_URL":               "http://hold-service/send",
+				"HOLD_PICKUP_DAYS":                   "5",
+				"HOLD_SWEEP_INTERVAL_MS":             "30000",
+				"OUTBOX_CHARGE_SEND_URL":             "http://charge-service/send",
+				"OUTBOX_CHARGE_REMINDER_SEND_URL":    "http://charge-service/reminders",
+				"FINE_DAILY_RATE":                    "1.5",
+				"FINE_MAX_AMOUNT":                    "30.00",
+				"FINE_GRACE_DAYS":                    "0",
+				"FINE_REMINDER_INTERVAL_MS":          "600000",
+				"FINE_REMINDER_REPEAT_DAYS":          "3",
+				"SEARCH_TEXT_CONFIG":                 "english",
+				"SEARCH_AUTHOR_SIMILARITY_THRESHOLD": "0.5",
+				"UNIQUENESS_ENABLED":                 "true",
+				"COVER_STORAGE":                      "fs",
+				"COVER_DIR":                          "/var/lib/library/covers",
 			},
 			want: &Config{
 				GRPC: GRPC{
@@ -51,17 +74,211 @@ func TestNew(t *testing.T) {
 					MaxConn:  "10",
 				},
 				Outbox: Outbox{
-					Enabled:         true,
-					Workers:         5,
-					BatchSize:       100,
-					WaitTimeMS:      500 * time.Millisecond,
-					InProgressTTLMS: 1000 * time.Millisecond,
-					BookSendURL:     "http://book-service/send",
-					AuthorSendURL:   "http://author-service/send",
+					Enabled:               true,
+					Workers:               5,
+					BatchSize:             100,
+					WaitTimeMS:            500 * time.Millisecond,
+					InProgressTTLMS:       1000 * time.Millisecond,
+					BookSendURL:           "http://book-service/send",
+					AuthorSendURL:         "http://author-service/send",
+					BookDeletedSendURL:    "http://book-service/deleted",
+					AuthorDeletedSendURL:  "http://author-service/deleted",
+					AuthorMergedSendURL:   "http://author-service/merged",
+					BookGenreSendURL:      "http://book-service/genres",
+					PatronSendURL:         "http://patron-service/send",
+					PatronDeletedSendURL:  "http://patron-service/deleted",
+					LoanSendURL:           "http://loan-service/send",
+					HoldSendURL:           "http://hold-service/send",
+					ChargeSendURL:         "http://charge-service/send",
+					ChargeReminderSendURL: "http://charge-service/reminders",
+				},
+				Search: Search{
+					TextConfig:                "english",
+					AuthorSimilarityThreshold: 0.5,
+				},
+				Uniqueness: Uniqueness{
+					Enabled: true,
+				},
+				Loan: Loan{
+					PeriodDays: 21,
+				},
+				Hold: Hold{
+					PickupDays:      5,
+					SweepIntervalMS: 30 * time.Second,
+				},
+				Fine: Fine{
+					DailyRate:          150,
+					MaxAmount:          3000,
+					ReminderIntervalMS: 10 * time.Minute,
+					ReminderRepeatDays: 3,
+				},
+				Cover: Cover{
+					Storage: "fs",
+					Dir:     "/var/lib/library/covers",
 				},
 			},
 			wantErr: false,
 		},
+		{
+			name: "default text search config",
+			envVars: map[string]string{
+				"OUTBOX_ENABLED": "false",
+			},
+			want: &Config{
+				PG: PG{
+					URL: "postgres://:@:/?sslmode=disable&pool_max_conns=",
+				},
+				Search: Search{
+					TextConfig:                "russian",
+					AuthorSimilarityThreshold: 0.3,
+				},
+				Loan: Loan{
+					PeriodDays: 14,
+				},
+				Hold: Hold{
+					PickupDays:      3,
+					SweepIntervalMS: time.Minute,
+				},
+				Fine: Fine{
+					DailyRate:          25,
+					MaxAmount:          1000,
+					GraceDays:          1,
+					ReminderIntervalMS: time.Hour,
+					ReminderRepeatDays: 7,
+				},
+				Cover: Cover{
+					Storage: "postgres",
+					Dir:     "covers",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid text search config",
+			envVars: map[string]string{
+				"OUTBOX_ENABLED":     "false",
+				"SEARCH_TEXT_CONFIG": "german'; DROP TABLE book; --",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "invalid similarity threshold",
+			envVars: map[string]string{
+				"OUTBOX_ENABLED":                     "false",
+				"SEARCH_AUTHOR_SIMILARITY_THRESHOLD": "threshold",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "similarity threshold out of range",
+			envVars: map[string]string{
+				"OUTBOX_ENABLED":                     "false",
+				"SEARCH_AUTHOR_SIMILARITY_THRESHOLD": "1.5",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "loan period not positive",
+			envVars: map[string]string{
+				"OUTBOX_ENABLED":   "false",
+				"LOAN_PERIOD_DAYS": "0",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "invalid hold sweep interval",
+			envVars: map[string]string{
+				"OUTBOX_ENABLED":         "false",
+				"HOLD_SWEEP_INTERVAL_MS": "often",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "hold sweep interval not positive",
+			envVars: map[string]string{
+				"OUTBOX_ENABLED":         "false",
+				"HOLD_SWEEP_INTERVAL_MS": "0",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "negative hold sweep interval",
+			envVars: map[string]string{
+				"OUTBOX_ENABLED":         "false",
+				"HOLD_SWEEP_INTERVAL_MS": "-1000",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "fine rate with more than two decimals",
+			envVars: map[string]string{
+				"OUTBOX_ENABLED":  "false",
+				"FINE_DAILY_RATE": "0.125",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "zero fine daily rate",
+			envVars: map[string]string{
+				"OUTBOX_ENABLED":  "false",
+				"FINE_DAILY_RATE": "0.00",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "fine reminder interval not positive",
+			envVars: map[string]string{
+				"OUTBOX_ENABLED":            "false",
+				"FINE_REMINDER_INTERVAL_MS": "0",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "invalid fine max amount",
+			envVars: map[string]string{
+				"OUTBOX_ENABLED":  "false",
+				"FINE_MAX_AMOUNT": "-5",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "negative grace days",
+			envVars: map[string]string{
+				"OUTBOX_ENABLED":  "false",
+				"FINE_GRACE_DAYS": "-1",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "unsupported cover storage",
+			envVars: map[string]string{
+				"OUTBOX_ENABLED": "false",
+				"COVER_STORAGE":  "s3",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "invalid uniqueness enabled",
+			envVars: map[string]string{
+				"OUTBOX_ENABLED":     "false",
+				"UNIQUENESS_ENABLED": "sometimes",
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "invalid outbox enabled",
 			envVars: map[string]string{
@@ -0,0 +1,6 @@
+-- +goose Up
+-- NULL - книга не удалена. Строка не удаляется физически, чтобы книгу можно было восстановить
+ALTER TABLE book ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP DEFAULT NULL;
+
+-- +goose Down
+ALTER TABLE book DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Конфигурация берется из SEARCH_TEXT_CONFIG на момент применения миграции.
+-- Для смены конфигурации колонку нужно пересоздать.
+-- +goose ENVSUB ON
+ALTER TABLE book ADD COLUMN IF NOT EXISTS name_tsv tsvector
+    GENERATED ALWAYS AS (to_tsvector('${SEARCH_TEXT_CONFIG:-russian}'::regconfig, name)) STORED;
+-- +goose ENVSUB OFF
+
+-- +goose Down
+ALTER TABLE book DROP COLUMN IF EXISTS name_tsv;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_book_name_tsv ON book USING GIN (name_tsv);
+
+-- +goose Down
+DROP INDEX idx_book_name_tsv;
@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- +goose Down
+DROP EXTENSION IF EXISTS pg_trgm;
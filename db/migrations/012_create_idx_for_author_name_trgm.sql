@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+
+-- GIN по триграммам ускоряет оператор %, используемый в SearchAuthors
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_author_name_trgm ON author USING GIN (name gin_trgm_ops);
+
+-- +goose Down
+DROP INDEX idx_author_name_trgm;
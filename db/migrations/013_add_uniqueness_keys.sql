@@ -0,0 +1,9 @@
+-- +goose Up
+-- Ключи заполняются только в режиме уникальности (UNIQUENESS_ENABLED),
+-- NULL не конфликтует в уникальном индексе
+ALTER TABLE author ADD COLUMN name_key TEXT;
+ALTER TABLE book ADD COLUMN unique_key TEXT;
+
+-- +goose Down
+ALTER TABLE book DROP COLUMN unique_key;
+ALTER TABLE author DROP COLUMN name_key;
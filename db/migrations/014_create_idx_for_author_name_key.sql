@@ -0,0 +1,6 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS idx_author_name_key ON author(name_key);
+
+-- +goose Down
+DROP INDEX idx_author_name_key;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+
+-- Удаленные книги не мешают добавить такую же, но конфликтуют при восстановлении
+CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS idx_book_unique_key ON book(unique_key) WHERE deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX idx_book_unique_key;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- ISBN-13 без разделителей, ISBN-10 приводится к ISBN-13 перед сохранением
+ALTER TABLE book ADD COLUMN isbn TEXT;
+
+-- +goose Down
+ALTER TABLE book DROP COLUMN isbn;
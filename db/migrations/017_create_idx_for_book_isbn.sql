@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+
+-- Индекс также используется GetBookByISBN
+CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS idx_book_isbn ON book(isbn) WHERE deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX idx_book_isbn;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE book
+    ADD COLUMN publication_year INT CHECK (publication_year BETWEEN 1 AND 9999),
+    ADD COLUMN language         TEXT, -- Тег BCP-47 в канонической записи
+    ADD COLUMN page_count       INT CHECK (page_count > 0),
+    ADD COLUMN description      TEXT;
+
+-- +goose Down
+ALTER TABLE book
+    DROP COLUMN description,
+    DROP COLUMN page_count,
+    DROP COLUMN language,
+    DROP COLUMN publication_year;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+
+-- Фильтр ListBooks по году часто используется вместе с языком
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_book_publication_year ON book(publication_year, language);
+
+-- +goose Down
+DROP INDEX idx_book_publication_year;
@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS genre
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name       TEXT                  NOT NULL,
+    parent_id  UUID REFERENCES genre (id) ON DELETE RESTRICT, -- NULL у корневых жанров
+    created_at TIMESTAMP DEFAULT now() NOT NULL,
+    CONSTRAINT genre_parent_name_key UNIQUE NULLS NOT DISTINCT (parent_id, name) -- Имена уникальны среди соседей, в том числе среди корней
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS genre;
@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS book_genre
+(
+    book_id    UUID NOT NULL REFERENCES book (id) ON DELETE CASCADE,
+    genre_id   UUID NOT NULL REFERENCES genre (id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT now() NOT NULL,
+    PRIMARY KEY (book_id, genre_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS book_genre;
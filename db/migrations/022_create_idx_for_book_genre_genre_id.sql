@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+
+-- Индекс используется при поиске книг по поддереву жанров
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_book_genre_genre ON book_genre (genre_id);
+
+-- +goose Down
+DROP INDEX idx_book_genre_genre;
@@ -0,0 +1,28 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS publisher
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name       TEXT                   NOT NULL,
+    created_at TIMESTAMP DEFAULT now() NOT NULL,
+    updated_at TIMESTAMP DEFAULT now() NOT NULL
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_publisher_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_publisher_timestamp
+    BEFORE UPDATE
+    ON publisher
+    FOR EACH ROW
+EXECUTE FUNCTION update_publisher_timestamp();
+
+-- +goose Down
+DROP TABLE IF EXISTS publisher;
+DROP FUNCTION IF EXISTS update_publisher_timestamp();
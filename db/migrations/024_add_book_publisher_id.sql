@@ -0,0 +1,6 @@
+-- +goose Up
+-- При удалении издательства книги остаются без издательства
+ALTER TABLE book ADD COLUMN IF NOT EXISTS publisher_id UUID REFERENCES publisher (id) ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE book DROP COLUMN IF EXISTS publisher_id;
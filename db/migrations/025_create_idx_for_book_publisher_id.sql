@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+
+-- Индекс используется GetPublisherBooks и при удалении издательства
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_book_publisher ON book (publisher_id);
+
+-- +goose Down
+DROP INDEX idx_book_publisher;
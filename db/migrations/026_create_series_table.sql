@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS series
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name       TEXT                   NOT NULL,
+    created_at TIMESTAMP DEFAULT now() NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS series;
@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS book_series
+(
+    series_id UUID NOT NULL REFERENCES series (id) ON DELETE CASCADE,
+    book_id   UUID NOT NULL REFERENCES book (id) ON DELETE CASCADE,
+    volume    INT  NOT NULL CHECK (volume > 0),
+    PRIMARY KEY (series_id, book_id),
+    CONSTRAINT book_series_volume_key UNIQUE (series_id, volume) -- Один том серии - одна книга
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS book_series;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+
+-- Индекс используется при чтении серий книги
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_book_series_book ON book_series (book_id);
+
+-- +goose Down
+DROP INDEX idx_book_series_book;
@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE author_book
+    ADD COLUMN role    TEXT NOT NULL DEFAULT 'author'
+        CHECK (role IN ('author', 'editor', 'translator', 'illustrator')),
+    ADD COLUMN ordinal INT  NOT NULL DEFAULT 0; -- Позиция в списке участников, 1 - основной автор
+
+-- Существующие связи нумеруются в порядке id авторов
+UPDATE author_book
+SET ordinal = numbered.ordinal
+FROM (
+    SELECT author_id, book_id, row_number() OVER (PARTITION BY book_id ORDER BY author_id) AS ordinal
+    FROM author_book
+) AS numbered
+WHERE author_book.author_id = numbered.author_id AND author_book.book_id = numbered.book_id;
+
+-- +goose Down
+ALTER TABLE author_book
+    DROP COLUMN ordinal,
+    DROP COLUMN role;
@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE author
+    ADD COLUMN biography    TEXT,
+    ADD COLUMN birth_date   DATE,
+    ADD COLUMN death_date   DATE,
+    ADD COLUMN external_ids JSONB DEFAULT '{}' NOT NULL, -- Идентификаторы во внешних каталогах: VIAF, Wikidata
+    ADD CONSTRAINT author_life_dates_check CHECK (death_date >= birth_date);
+
+-- +goose Down
+ALTER TABLE author
+    DROP CONSTRAINT author_life_dates_check,
+    DROP COLUMN external_ids,
+    DROP COLUMN death_date,
+    DROP COLUMN birth_date,
+    DROP COLUMN biography;
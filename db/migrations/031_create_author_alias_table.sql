@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS author_alias
+(
+    author_id UUID REFERENCES author (id) ON DELETE CASCADE,
+    alias     TEXT NOT NULL,
+    position  INT  NOT NULL, -- Порядок псевдонимов в профиле автора
+    PRIMARY KEY (author_id, alias)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS author_alias;
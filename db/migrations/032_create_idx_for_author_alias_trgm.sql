@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+
+-- SearchAuthors ищет по псевдонимам тем же оператором %, что и по имени
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_author_alias_trgm ON author_alias USING GIN (alias gin_trgm_ops);
+
+-- +goose Down
+DROP INDEX idx_author_alias_trgm;
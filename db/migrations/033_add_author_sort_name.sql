@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE author
+    ADD COLUMN sort_name  TEXT, -- Ключ сортировки списков: "Tolkien, J.R.R."
+    ADD COLUMN search_key TEXT; -- Транслитерированное имя в нижнем регистре для нечеткого поиска
+
+-- Старые имена состоят из латиницы, цифр и одиночных пробелов,
+-- поэтому ключи совпадают с теми, что строит сервис
+UPDATE author
+SET sort_name  = regexp_replace(name, '^(.*) (\S+)$', '\2, \1'),
+    search_key = lower(name);
+
+ALTER TABLE author
+    ALTER COLUMN sort_name SET NOT NULL,
+    ALTER COLUMN search_key SET NOT NULL;
+
+-- +goose Down
+ALTER TABLE author
+    DROP COLUMN search_key,
+    DROP COLUMN sort_name;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+
+-- ListAuthors сортирует и листает страницы по (sort_name, id)
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_author_sort_name ON author (sort_name, id);
+
+-- +goose Down
+DROP INDEX idx_author_sort_name;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+
+-- SearchAuthors находит "Достоевский" по запросу "Dostoevsky" через транслитерированный ключ
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_author_search_key_trgm ON author USING GIN (search_key gin_trgm_ops);
+
+-- +goose Down
+DROP INDEX idx_author_search_key_trgm;
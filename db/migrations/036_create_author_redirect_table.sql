@@ -0,0 +1,11 @@
+-- +goose Up
+-- Авторы, слитые с дубликатом: запись автора удалена, GetAuthorInfo по старому id возвращает target_id
+CREATE TABLE IF NOT EXISTS author_redirect
+(
+    source_id UUID PRIMARY KEY,
+    target_id UUID      NOT NULL REFERENCES author (id) ON DELETE CASCADE,
+    merged_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS author_redirect;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+
+-- Индекс используется при повторном слиянии и каскадном удалении автора
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_author_redirect_target_id ON author_redirect (target_id);
+
+-- +goose Down
+DROP INDEX idx_author_redirect_target_id;
@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS book_copy
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    barcode    TEXT NOT NULL CONSTRAINT book_copy_barcode_key UNIQUE,
+    book_id    UUID NOT NULL REFERENCES book (id) ON DELETE CASCADE,
+    branch     TEXT NOT NULL, -- Код филиала
+    location   TEXT,          -- Расположение внутри филиала: зал, стеллаж, полка
+    condition  TEXT NOT NULL DEFAULT 'good' CHECK (condition IN ('new', 'good', 'fair', 'poor')),
+    status     TEXT NOT NULL DEFAULT 'available' CHECK (status IN ('available', 'in_repair', 'lost', 'retired')),
+    created_at TIMESTAMP DEFAULT now() NOT NULL,
+    updated_at TIMESTAMP DEFAULT now() NOT NULL,
+    retired_at TIMESTAMP,
+    CONSTRAINT book_copy_retired_check CHECK ((status = 'retired') = (retired_at IS NOT NULL))
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_book_copy_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_book_copy_timestamp
+    BEFORE UPDATE
+    ON book_copy
+    FOR EACH ROW
+EXECUTE FUNCTION update_book_copy_timestamp();
+
+-- +goose Down
+DROP TABLE IF EXISTS book_copy;
+DROP FUNCTION IF EXISTS update_book_copy_timestamp();
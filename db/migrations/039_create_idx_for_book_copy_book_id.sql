@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+
+-- Индекс используется при выборке экземпляров книги и подсчете доступных
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_book_copy_book_id ON book_copy (book_id, branch);
+
+-- +goose Down
+DROP INDEX idx_book_copy_book_id;
@@ -0,0 +1,33 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS patron
+(
+    id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    card_number TEXT NOT NULL CONSTRAINT patron_card_number_key UNIQUE,
+    name        TEXT NOT NULL,
+    email       TEXT,
+    phone       TEXT,
+    status      TEXT NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'suspended')),
+    expires_on  DATE NOT NULL, -- Последний день действия читательского билета
+    created_at  TIMESTAMP DEFAULT now() NOT NULL,
+    updated_at  TIMESTAMP DEFAULT now() NOT NULL
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_patron_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_patron_timestamp
+    BEFORE UPDATE
+    ON patron
+    FOR EACH ROW
+EXECUTE FUNCTION update_patron_timestamp();
+
+-- +goose Down
+DROP TABLE IF EXISTS patron;
+DROP FUNCTION IF EXISTS update_patron_timestamp();
@@ -0,0 +1,32 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS loan
+(
+    id             UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    copy_id        UUID NOT NULL REFERENCES book_copy (id) ON DELETE CASCADE,
+    patron_id      UUID NOT NULL REFERENCES patron (id) ON DELETE CASCADE,
+    checked_out_at TIMESTAMP DEFAULT now() NOT NULL,
+    due_at         TIMESTAMP NOT NULL,
+    renewals       INT DEFAULT 0 NOT NULL,
+    returned_at    TIMESTAMP, -- NULL, пока экземпляр у читателя
+    updated_at     TIMESTAMP DEFAULT now() NOT NULL
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_loan_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_loan_timestamp
+    BEFORE UPDATE
+    ON loan
+    FOR EACH ROW
+EXECUTE FUNCTION update_loan_timestamp();
+
+-- +goose Down
+DROP TABLE IF EXISTS loan;
+DROP FUNCTION IF EXISTS update_loan_timestamp();
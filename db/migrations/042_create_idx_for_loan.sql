@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+
+-- Экземпляр может быть выдан только одному читателю одновременно
+CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS idx_loan_active_copy_key ON loan (copy_id) WHERE returned_at IS NULL;
+
+-- Индекс используется ListPatronLoans
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_loan_patron_id ON loan (patron_id, checked_out_at);
+
+-- +goose Down
+DROP INDEX idx_loan_active_copy_key;
+DROP INDEX idx_loan_patron_id;
@@ -0,0 +1,33 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS book_hold
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    book_id    UUID NOT NULL REFERENCES book (id) ON DELETE CASCADE,
+    patron_id  UUID NOT NULL REFERENCES patron (id) ON DELETE CASCADE,
+    status     TEXT NOT NULL DEFAULT 'waiting'
+        CHECK (status IN ('waiting', 'ready', 'fulfilled', 'cancelled', 'expired')),
+    placed_at  TIMESTAMP DEFAULT now() NOT NULL,
+    ready_at   TIMESTAMP,
+    expires_at TIMESTAMP, -- Срок, до которого готовая бронь ждет читателя
+    updated_at TIMESTAMP DEFAULT now() NOT NULL
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_book_hold_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_book_hold_timestamp
+    BEFORE UPDATE
+    ON book_hold
+    FOR EACH ROW
+EXECUTE FUNCTION update_book_hold_timestamp();
+
+-- +goose Down
+DROP TABLE IF EXISTS book_hold;
+DROP FUNCTION IF EXISTS update_book_hold_timestamp();
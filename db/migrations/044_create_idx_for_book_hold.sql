@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+
+-- Читатель стоит в очереди на книгу не больше одного раза
+CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS idx_book_hold_active_key ON book_hold (book_id, patron_id)
+    WHERE status IN ('waiting', 'ready');
+
+-- Очередь книги для FulfillNextHold
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_book_hold_queue ON book_hold (book_id, placed_at)
+    WHERE status = 'waiting';
+
+-- Поиск просроченных готовых броней
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_book_hold_expires_at ON book_hold (expires_at)
+    WHERE status = 'ready';
+
+-- Индекс используется ListHolds по читателю
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_book_hold_patron_id ON book_hold (patron_id, placed_at);
+
+-- +goose Down
+DROP INDEX idx_book_hold_active_key;
+DROP INDEX idx_book_hold_queue;
+DROP INDEX idx_book_hold_expires_at;
+DROP INDEX idx_book_hold_patron_id;
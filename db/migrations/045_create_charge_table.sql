@@ -0,0 +1,39 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS charge
+(
+    id            UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    patron_id     UUID NOT NULL REFERENCES patron (id) ON DELETE CASCADE,
+    book_id       UUID NOT NULL REFERENCES book (id) ON DELETE CASCADE,
+    due_at        TIMESTAMP NOT NULL,
+    returned_at   TIMESTAMP NOT NULL,
+    days_late     INTEGER NOT NULL CHECK (days_late > 0),
+    amount        NUMERIC(12, 2) NOT NULL CHECK (amount > 0),
+    status        TEXT NOT NULL DEFAULT 'outstanding' CHECK (status IN ('outstanding', 'paid', 'waived')),
+    waiver_reason TEXT,
+    settled_at    TIMESTAMP, -- Когда штраф оплачен или списан
+    reminded_at   TIMESTAMP, -- Последнее напоминание об оплате
+    created_at    TIMESTAMP DEFAULT now() NOT NULL,
+    updated_at    TIMESTAMP DEFAULT now() NOT NULL,
+    -- Один просроченный возврат начисляется один раз
+    CONSTRAINT charge_late_return_key UNIQUE (patron_id, book_id, due_at)
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_charge_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_charge_timestamp
+    BEFORE UPDATE
+    ON charge
+    FOR EACH ROW
+EXECUTE FUNCTION update_charge_timestamp();
+
+-- +goose Down
+DROP TABLE IF EXISTS charge;
+DROP FUNCTION IF EXISTS update_charge_timestamp();
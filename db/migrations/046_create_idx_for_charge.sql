@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+
+-- Индекс используется ListCharges и проверкой долгов в DeletePatron
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_charge_patron_id ON charge (patron_id, created_at);
+
+-- Поиск неоплаченных штрафов, о которых пора напомнить
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_charge_reminder ON charge ((COALESCE(reminded_at, created_at)))
+    WHERE status = 'outstanding';
+
+-- +goose Down
+DROP INDEX idx_charge_patron_id;
+DROP INDEX idx_charge_reminder;
@@ -0,0 +1,35 @@
+-- +goose Up
+-- Агрегат рецензий хранится в книге и меняется в одной транзакции с рецензией
+ALTER TABLE book
+    ADD COLUMN IF NOT EXISTS review_count INTEGER NOT NULL DEFAULT 0 CHECK (review_count >= 0),
+    ADD COLUMN IF NOT EXISTS rating_sum   INTEGER NOT NULL DEFAULT 0 CHECK (rating_sum >= 0);
+
+-- Изменение агрегата не считается изменением книги: updated_at входит в ключ версии событий outbox
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_book_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF (NEW.review_count, NEW.rating_sum) IS DISTINCT FROM (OLD.review_count, OLD.rating_sum) THEN
+        RETURN NEW;
+    END IF;
+
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_book_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+ALTER TABLE book
+    DROP COLUMN IF EXISTS review_count,
+    DROP COLUMN IF EXISTS rating_sum;
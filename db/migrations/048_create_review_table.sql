@@ -0,0 +1,34 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS review
+(
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    book_id    UUID NOT NULL REFERENCES book (id) ON DELETE CASCADE,
+    -- Рецензии удаляемого читателя вычитаются из агрегата книги в DeletePatron
+    patron_id  UUID NOT NULL REFERENCES patron (id) ON DELETE CASCADE,
+    rating     SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    text       TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT now() NOT NULL,
+    updated_at TIMESTAMP DEFAULT now() NOT NULL,
+    -- Читатель оставляет одну рецензию на книгу
+    CONSTRAINT review_book_patron_key UNIQUE (book_id, patron_id)
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_review_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_review_timestamp
+    BEFORE UPDATE
+    ON review
+    FOR EACH ROW
+EXECUTE FUNCTION update_review_timestamp();
+
+-- +goose Down
+DROP TABLE IF EXISTS review;
+DROP FUNCTION IF EXISTS update_review_timestamp();
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+
+-- Индекс используется ListBookReviews: новые рецензии первыми
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_review_book_created_at ON review (book_id, created_at DESC, id DESC);
+
+-- Рецензии удаляемого читателя
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_review_patron_id ON review (patron_id);
+
+-- +goose Down
+DROP INDEX idx_review_book_created_at;
+DROP INDEX idx_review_patron_id;
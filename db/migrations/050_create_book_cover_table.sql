@@ -0,0 +1,31 @@
+-- +goose Up
+-- Содержимое обложки хранится в BlobStorage по sha256, одно содержимое может быть у нескольких книг
+CREATE TABLE IF NOT EXISTS book_cover
+(
+    book_id      UUID PRIMARY KEY REFERENCES book (id) ON DELETE CASCADE,
+    content_type TEXT NOT NULL,
+    size         BIGINT NOT NULL CHECK (size > 0),
+    sha256       TEXT NOT NULL,
+    created_at   TIMESTAMP DEFAULT now() NOT NULL,
+    updated_at   TIMESTAMP DEFAULT now() NOT NULL
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_book_cover_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE OR REPLACE TRIGGER trigger_update_book_cover_timestamp
+    BEFORE UPDATE
+    ON book_cover
+    FOR EACH ROW
+EXECUTE FUNCTION update_book_cover_timestamp();
+
+-- +goose Down
+DROP TABLE IF EXISTS book_cover;
+DROP FUNCTION IF EXISTS update_book_cover_timestamp();
@@ -0,0 +1,13 @@
+-- +goose Up
+-- Содержимое для COVER_STORAGE=postgres: large object на каждый уникальный sha256
+CREATE TABLE IF NOT EXISTS blob
+(
+    sha256     TEXT PRIMARY KEY,
+    oid        OID NOT NULL,
+    size       BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT now() NOT NULL
+);
+
+-- +goose Down
+SELECT lo_unlink(oid) FROM blob;
+DROP TABLE IF EXISTS blob;
@@ -0,0 +1,40 @@
+-- +goose Up
+-- Снимок книги после каждого изменения: поля книги и участники. Жанры, серии, экземпляры и рецензии не версионируются
+CREATE TABLE IF NOT EXISTS book_revision
+(
+    id               BIGSERIAL PRIMARY KEY,
+    book_id          UUID      NOT NULL REFERENCES book (id) ON DELETE CASCADE,
+    name             TEXT      NOT NULL,
+    isbn             TEXT,
+    publication_year INTEGER,
+    language         TEXT,
+    page_count       INTEGER,
+    description      TEXT,
+    publisher_id     UUID, -- Без внешнего ключа: издательство могут удалить, а снимок остается прежним
+    author_ids       UUID[]    NOT NULL,
+    roles            TEXT[]    NOT NULL,
+    deleted_at       TIMESTAMP,
+    revised_at       TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- История существующих книг начинается с их текущего состояния
+INSERT INTO book_revision (book_id, name, isbn, publication_year, language, page_count, description,
+                           publisher_id, author_ids, roles, deleted_at, revised_at)
+SELECT book.id,
+       book.name,
+       book.isbn,
+       book.publication_year,
+       book.language,
+       book.page_count,
+       book.description,
+       book.publisher_id,
+       array_remove(array_agg(author_book.author_id ORDER BY author_book.ordinal, author_book.author_id), NULL),
+       array_remove(array_agg(author_book.role ORDER BY author_book.ordinal, author_book.author_id), NULL),
+       book.deleted_at,
+       book.updated_at
+FROM book
+         LEFT JOIN author_book ON book.id = author_book.book_id
+GROUP BY book.id;
+
+-- +goose Down
+DROP TABLE IF EXISTS book_revision;
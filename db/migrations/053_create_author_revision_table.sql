@@ -0,0 +1,33 @@
+-- +goose Up
+-- Снимок профиля автора после каждого изменения. История удаляется вместе с автором,
+-- GetAuthorHistory по id слитого автора возвращает историю основной записи
+CREATE TABLE IF NOT EXISTS author_revision
+(
+    id           BIGSERIAL PRIMARY KEY,
+    author_id    UUID      NOT NULL REFERENCES author (id) ON DELETE CASCADE,
+    name         TEXT      NOT NULL,
+    sort_name    TEXT      NOT NULL,
+    biography    TEXT,
+    birth_date   DATE,
+    death_date   DATE,
+    aliases      TEXT[]    NOT NULL,
+    external_ids JSONB     NOT NULL,
+    revised_at   TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- История существующих авторов начинается с их текущего состояния
+INSERT INTO author_revision (author_id, name, sort_name, biography, birth_date, death_date, aliases,
+                             external_ids, revised_at)
+SELECT id,
+       name,
+       sort_name,
+       biography,
+       birth_date,
+       death_date,
+       ARRAY(SELECT alias FROM author_alias WHERE author_id = author.id ORDER BY position),
+       external_ids,
+       updated_at
+FROM author;
+
+-- +goose Down
+DROP TABLE IF EXISTS author_revision;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose NO TRANSACTION
+
+-- Индексы используются историей и чтением состояния на момент времени
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_book_revision_book_revised_at ON book_revision (book_id, revised_at, id);
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_author_revision_author_revised_at ON author_revision (author_id, revised_at, id);
+
+-- +goose Down
+DROP INDEX idx_book_revision_book_revised_at;
+DROP INDEX idx_author_revision_author_revised_at;
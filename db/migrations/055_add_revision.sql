@@ -0,0 +1,112 @@
+-- +goose Up
+-- Номер версии для оптимистичной блокировки: UpdateBook и ChangeAuthorInfo с expected_revision
+ALTER TABLE book
+    ADD COLUMN IF NOT EXISTS revision BIGINT NOT NULL DEFAULT 1 CHECK (revision >= 1);
+
+ALTER TABLE author
+    ADD COLUMN IF NOT EXISTS revision BIGINT NOT NULL DEFAULT 1 CHECK (revision >= 1);
+
+ALTER TABLE book_revision
+    ADD COLUMN IF NOT EXISTS revision BIGINT;
+
+ALTER TABLE author_revision
+    ADD COLUMN IF NOT EXISTS revision BIGINT;
+
+-- Снимки нумеруются по порядку, текущая версия - номер последнего снимка
+UPDATE book_revision
+SET revision = numbered.revision
+FROM (SELECT id, row_number() OVER (PARTITION BY book_id ORDER BY revised_at, id) AS revision
+      FROM book_revision) AS numbered
+WHERE book_revision.id = numbered.id;
+
+UPDATE author_revision
+SET revision = numbered.revision
+FROM (SELECT id, row_number() OVER (PARTITION BY author_id ORDER BY revised_at, id) AS revision
+      FROM author_revision) AS numbered
+WHERE author_revision.id = numbered.id;
+
+-- Перенумерация не должна менять updated_at
+ALTER TABLE book DISABLE TRIGGER trigger_update_book_timestamp;
+ALTER TABLE author DISABLE TRIGGER trigger_update_author_timestamp;
+
+UPDATE book
+SET revision = last.revision
+FROM (SELECT book_id, max(revision) AS revision FROM book_revision GROUP BY book_id) AS last
+WHERE book.id = last.book_id;
+
+UPDATE author
+SET revision = last.revision
+FROM (SELECT author_id, max(revision) AS revision FROM author_revision GROUP BY author_id) AS last
+WHERE author.id = last.author_id;
+
+ALTER TABLE book ENABLE TRIGGER trigger_update_book_timestamp;
+ALTER TABLE author ENABLE TRIGGER trigger_update_author_timestamp;
+
+ALTER TABLE book_revision
+    ALTER COLUMN revision SET NOT NULL;
+
+ALTER TABLE author_revision
+    ALTER COLUMN revision SET NOT NULL;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_book_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF (NEW.review_count, NEW.rating_sum) IS DISTINCT FROM (OLD.review_count, OLD.rating_sum) THEN
+        RETURN NEW;
+    END IF;
+
+    NEW.updated_at = now();
+    NEW.revision = OLD.revision + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_author_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    NEW.revision = OLD.revision + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_author_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_book_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF (NEW.review_count, NEW.rating_sum) IS DISTINCT FROM (OLD.review_count, OLD.rating_sum) THEN
+        RETURN NEW;
+    END IF;
+
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+ALTER TABLE author_revision
+    DROP COLUMN IF EXISTS revision;
+
+ALTER TABLE book_revision
+    DROP COLUMN IF EXISTS revision;
+
+ALTER TABLE author
+    DROP COLUMN IF EXISTS revision;
+
+ALTER TABLE book
+    DROP COLUMN IF EXISTS revision;
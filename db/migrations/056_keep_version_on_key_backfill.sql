@@ -0,0 +1,63 @@
+-- +goose Up
+-- Заполнение ключей уникальности при включении UNIQUENESS_ENABLED не меняет запись:
+-- в транзакции с library.keep_version триггеры не трогают updated_at и revision
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_book_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF (NEW.review_count, NEW.rating_sum) IS DISTINCT FROM (OLD.review_count, OLD.rating_sum) THEN
+        RETURN NEW;
+    END IF;
+
+    IF current_setting('library.keep_version', true) = 'on' THEN
+        RETURN NEW;
+    END IF;
+
+    NEW.updated_at = now();
+    NEW.revision = OLD.revision + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_author_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF current_setting('library.keep_version', true) = 'on' THEN
+        RETURN NEW;
+    END IF;
+
+    NEW.updated_at = now();
+    NEW.revision = OLD.revision + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_author_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = now();
+    NEW.revision = OLD.revision + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_book_timestamp() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF (NEW.review_count, NEW.rating_sum) IS DISTINCT FROM (OLD.review_count, OLD.rating_sum) THEN
+        RETURN NEW;
+    END IF;
+
+    NEW.updated_at = now();
+    NEW.revision = OLD.revision + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
@@ -0,0 +1,88 @@
+//go:build integration_test
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	library "github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// startLibrary запускает сервис и возвращает клиент полного API: копия в этом пакете знает только старые методы
+func startLibrary(t *testing.T) library.LibraryClient {
+	t.Helper()
+
+	executable := getLibraryExecutable(t)
+	grpcPort := findFreePort(t)
+	grpcGatewayPort := findFreePort(t)
+
+	cmd := setupLibrary(t, executable, grpcPort, grpcGatewayPort)
+	t.Cleanup(func() {
+		stopLibrary(t, cmd)
+	})
+
+	conn, err := grpc.NewClient("127.0.0.1:"+grpcPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return library.NewLibraryClient(conn)
+}
+
+func registerTestAuthor(t *testing.T, client library.LibraryClient) string {
+	t.Helper()
+
+	res, err := client.RegisterAuthor(context.Background(), &library.RegisterAuthorRequest{
+		Name: "Author " + uuid.NewString()[:8],
+	})
+	require.NoError(t, err)
+
+	return res.GetId()
+}
+
+func requireCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+
+	s, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, code, s.Code(), s.Message())
+}
+
+func TestUpdateDeletedBook(t *testing.T) {
+	client := startLibrary(t)
+	ctx := context.Background()
+
+	authorId := registerTestAuthor(t, client)
+	otherAuthorId := registerTestAuthor(t, client)
+
+	added, err := client.AddBook(ctx, &library.AddBookRequest{
+		Name:      "Deleted book " + uuid.NewString()[:8],
+		AuthorIds: []string{authorId},
+	})
+	require.NoError(t, err)
+	bookId := added.GetBook().GetId()
+
+	_, err = client.DeleteBook(ctx, &library.DeleteBookRequest{Id: bookId})
+	require.NoError(t, err)
+
+	// Удаленная книга не меняется: ни название, ни авторы
+	_, err = client.UpdateBook(ctx, &library.UpdateBookRequest{
+		Id:        bookId,
+		Name:      "Renamed",
+		AuthorIds: []string{otherAuthorId},
+	})
+	requireCode(t, err, codes.NotFound)
+
+	restored, err := client.RestoreBook(ctx, &library.RestoreBookRequest{Id: bookId})
+	require.NoError(t, err)
+	require.Equal(t, added.GetBook().GetName(), restored.GetBook().GetName())
+	require.Equal(t, []string{authorId}, restored.GetBook().GetAuthorIds())
+}
@@ -0,0 +1,88 @@
+//go:build integration_test
+
+package integration
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	library "github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+)
+
+// Отмены и продвижения очереди одной книги идут параллельно, но готовыми становятся только первые брони очереди
+func TestHoldQueueRace(t *testing.T) {
+	client := startLibrary(t)
+	ctx := context.Background()
+
+	const totalHolds = 10
+
+	added, err := client.AddBook(ctx, &library.AddBookRequest{
+		Name:      "Held book " + uuid.NewString()[:8],
+		AuthorIds: []string{registerTestAuthor(t, client)},
+	})
+	require.NoError(t, err)
+	bookId := added.GetBook().GetId()
+
+	holdIds := make([]string, 0, totalHolds)
+	for range totalHolds {
+		placed, err := client.PlaceHold(ctx, &library.PlaceHoldRequest{
+			BookId:   bookId,
+			PatronId: registerTestPatron(t, client),
+		})
+		require.NoError(t, err)
+		holdIds = append(holdIds, placed.GetHold().GetId())
+	}
+
+	// Отменяется и первая бронь очереди, которую в это же время продвигают
+	cancelled := []string{holdIds[0], holdIds[2], holdIds[4]}
+
+	wg := new(sync.WaitGroup)
+	errs := make(chan error, len(cancelled)*2)
+	for _, holdId := range cancelled {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := client.CancelHold(ctx, &library.CancelHoldRequest{Id: holdId})
+			errs <- err
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			_, err := client.FulfillNextHold(ctx, &library.FulfillNextHoldRequest{BookId: bookId})
+			errs <- err
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	listed, err := client.ListHolds(ctx, &library.ListHoldsRequest{BookId: bookId, IncludeClosed: true})
+	require.NoError(t, err)
+	require.Len(t, listed.GetHolds(), totalHolds)
+
+	waiting := false
+	for _, hold := range listed.GetHolds() {
+		switch hold.GetStatus() {
+		case library.HoldStatus_HOLD_STATUS_CANCELLED:
+			require.Contains(t, cancelled, hold.GetId())
+		case library.HoldStatus_HOLD_STATUS_READY:
+			require.False(t, waiting, "hold %s is ready while an earlier hold is still waiting", hold.GetId())
+		case library.HoldStatus_HOLD_STATUS_WAITING:
+			waiting = true
+		default:
+			require.Failf(t, "unexpected hold status", "hold %s: %s", hold.GetId(), hold.GetStatus())
+		}
+	}
+
+	require.True(t, waiting)
+}
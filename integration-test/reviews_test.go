@@ -0,0 +1,110 @@
+//go:build integration_test
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	library "github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func registerTestPatron(t *testing.T, client library.LibraryClient) string {
+	t.Helper()
+
+	res, err := client.RegisterPatron(context.Background(), &library.RegisterPatronRequest{
+		CardNumber: "R-" + uuid.NewString()[:8],
+		Name:       "Reader",
+		ExpiresOn:  time.Now().AddDate(1, 0, 0).Format(time.DateOnly),
+	})
+	require.NoError(t, err)
+
+	return res.GetPatron().GetId()
+}
+
+// Рейтинг хранится в книге и меняется вместе с рецензиями, проверяется, что он не расходится с ними
+func TestBookRatingAggregate(t *testing.T) {
+	client := startLibrary(t)
+	ctx := context.Background()
+
+	added, err := client.AddBook(ctx, &library.AddBookRequest{
+		Name:      "Rated book " + uuid.NewString()[:8],
+		AuthorIds: []string{registerTestAuthor(t, client)},
+	})
+	require.NoError(t, err)
+	bookId := added.GetBook().GetId()
+
+	requireRating := func(t *testing.T, count int64, average float64) {
+		t.Helper()
+
+		info, err := client.GetBookInfo(ctx, &library.GetBookInfoRequest{Id: bookId})
+		require.NoError(t, err)
+		require.Equal(t, count, info.GetRating().GetReviewCount())
+		require.InDelta(t, average, info.GetRating().GetAverageRating(), 1e-9)
+
+		// Изменение рейтинга не считается изменением книги
+		require.Equal(t, added.GetBook().GetRevision(), info.GetBook().GetRevision())
+		require.Equal(t, added.GetBook().GetUpdatedAt().AsTime(), info.GetBook().GetUpdatedAt().AsTime())
+	}
+
+	requireRating(t, 0, 0)
+
+	firstPatronId := registerTestPatron(t, client)
+	secondPatronId := registerTestPatron(t, client)
+
+	first, err := client.CreateReview(ctx, &library.CreateReviewRequest{
+		BookId:   bookId,
+		PatronId: firstPatronId,
+		Rating:   5,
+	})
+	require.NoError(t, err)
+
+	second, err := client.CreateReview(ctx, &library.CreateReviewRequest{
+		BookId:   bookId,
+		PatronId: secondPatronId,
+		Rating:   2,
+		Text:     "Too long",
+	})
+	require.NoError(t, err)
+	requireRating(t, 2, 3.5)
+
+	// Изменение только текста не меняет рейтинг
+	_, err = client.UpdateReview(ctx, &library.UpdateReviewRequest{
+		Id:   second.GetReview().GetId(),
+		Text: proto.String("Long, but worth it"),
+	})
+	require.NoError(t, err)
+	requireRating(t, 2, 3.5)
+
+	_, err = client.UpdateReview(ctx, &library.UpdateReviewRequest{
+		Id:     second.GetReview().GetId(),
+		Rating: proto.Int32(4),
+	})
+	require.NoError(t, err)
+	requireRating(t, 2, 4.5)
+
+	_, err = client.DeleteReview(ctx, &library.DeleteReviewRequest{Id: first.GetReview().GetId()})
+	require.NoError(t, err)
+	requireRating(t, 1, 4)
+
+	// Рецензии удаленного читателя уходят из рейтинга
+	_, err = client.CreateReview(ctx, &library.CreateReviewRequest{
+		BookId:   bookId,
+		PatronId: firstPatronId,
+		Rating:   1,
+	})
+	require.NoError(t, err)
+	requireRating(t, 2, 2.5)
+
+	_, err = client.DeletePatron(ctx, &library.DeletePatronRequest{Id: secondPatronId})
+	require.NoError(t, err)
+	requireRating(t, 1, 1)
+
+	_, err = client.DeletePatron(ctx, &library.DeletePatronRequest{Id: firstPatronId})
+	require.NoError(t, err)
+	requireRating(t, 0, 0)
+}
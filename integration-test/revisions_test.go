@@ -0,0 +1,198 @@
+//go:build integration_test
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	library "github.com/project/library/generated/api/library"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func getBookHistory(t *testing.T, client library.LibraryClient, bookId string) []*library.BookRevision {
+	t.Helper()
+
+	stream, err := client.GetBookHistory(context.Background(), &library.GetBookHistoryRequest{Id: bookId})
+	require.NoError(t, err)
+
+	result := make([]*library.BookRevision, 0)
+	for {
+		revision, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return result
+		}
+
+		require.NoError(t, err)
+		result = append(result, revision)
+	}
+}
+
+func getAuthorHistory(t *testing.T, client library.LibraryClient, authorId string) []*library.AuthorRevision {
+	t.Helper()
+
+	stream, err := client.GetAuthorHistory(context.Background(), &library.GetAuthorHistoryRequest{Id: authorId})
+	require.NoError(t, err)
+
+	result := make([]*library.AuthorRevision, 0)
+	for {
+		revision, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return result
+		}
+
+		require.NoError(t, err)
+		result = append(result, revision)
+	}
+}
+
+// Каждое изменение книги оставляет снимок, номера снимков идут подряд и совпадают с revision книги
+func TestBookRevisions(t *testing.T) {
+	client := startLibrary(t)
+	ctx := context.Background()
+
+	publisher, err := client.CreatePublisher(ctx, &library.CreatePublisherRequest{
+		Name: "Publisher " + uuid.NewString()[:8],
+	})
+	require.NoError(t, err)
+	publisherId := publisher.GetPublisher().GetId()
+
+	added, err := client.AddBook(ctx, &library.AddBookRequest{
+		Name:        "Revised book " + uuid.NewString()[:8],
+		AuthorIds:   []string{registerTestAuthor(t, client)},
+		PublisherId: proto.String(publisherId),
+	})
+	require.NoError(t, err)
+	book := added.GetBook()
+	require.Equal(t, int64(1), book.GetRevision())
+
+	_, err = client.UpdateBook(ctx, &library.UpdateBookRequest{
+		Id:               book.GetId(),
+		Name:             "Renamed " + book.GetName(),
+		AuthorIds:        book.GetAuthorIds(),
+		ExpectedRevision: proto.Int64(1),
+	})
+	require.NoError(t, err)
+
+	_, err = client.DeleteBook(ctx, &library.DeleteBookRequest{Id: book.GetId()})
+	require.NoError(t, err)
+
+	_, err = client.RestoreBook(ctx, &library.RestoreBookRequest{Id: book.GetId()})
+	require.NoError(t, err)
+
+	// Издательство отвязывается от книги каскадом, это тоже изменение книги
+	_, err = client.DeletePublisher(ctx, &library.DeletePublisherRequest{Id: publisherId})
+	require.NoError(t, err)
+
+	history := getBookHistory(t, client, book.GetId())
+	require.Len(t, history, 5)
+
+	for i, revision := range history {
+		require.Equal(t, int64(i+1), revision.GetBook().GetRevision())
+	}
+
+	require.Equal(t, book.GetName(), history[0].GetBook().GetName())
+	require.Equal(t, "Renamed "+book.GetName(), history[1].GetBook().GetName())
+	require.True(t, history[2].GetDeleted())
+	require.False(t, history[3].GetDeleted())
+	require.Equal(t, publisherId, history[3].GetBook().GetPublisherId())
+	require.Empty(t, history[4].GetBook().GetPublisherId())
+
+	current, err := client.GetBookInfo(ctx, &library.GetBookInfoRequest{Id: book.GetId()})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), current.GetBook().GetRevision())
+	require.Empty(t, current.GetBook().GetPublisherId())
+
+	beforeDelete, err := client.GetBookInfo(ctx, &library.GetBookInfoRequest{
+		Id:   book.GetId(),
+		AsOf: history[3].GetRevisedAt(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, publisherId, beforeDelete.GetBook().GetPublisherId())
+	require.Equal(t, int64(4), beforeDelete.GetBook().GetRevision())
+}
+
+func TestAuthorRevisions(t *testing.T) {
+	client := startLibrary(t)
+	ctx := context.Background()
+
+	authorId := registerTestAuthor(t, client)
+
+	_, err := client.ChangeAuthorInfo(ctx, &library.ChangeAuthorInfoRequest{
+		Id:               authorId,
+		Name:             "Changed Author",
+		Biography:        proto.String("Biography"),
+		ExpectedRevision: proto.Int64(1),
+	})
+	require.NoError(t, err)
+
+	history := getAuthorHistory(t, client, authorId)
+	require.Len(t, history, 2)
+	require.Equal(t, int64(1), history[0].GetRevision())
+	require.Equal(t, int64(2), history[1].GetRevision())
+	require.Equal(t, "Changed Author", history[1].GetName())
+
+	current, err := client.GetAuthorInfo(ctx, &library.GetAuthorInfoRequest{Id: authorId})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), current.GetRevision())
+
+	first, err := client.GetAuthorInfo(ctx, &library.GetAuthorInfoRequest{
+		Id:   authorId,
+		AsOf: history[0].GetRevisedAt(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first.GetRevision())
+	require.Empty(t, first.GetBiography())
+}
+
+// requireLatestRevision проверяет, что последний снимок книги совпадает с ее текущей версией
+func requireLatestRevision(t *testing.T, client library.LibraryClient, bookId string) {
+	t.Helper()
+
+	current, err := client.GetBookInfo(context.Background(), &library.GetBookInfoRequest{Id: bookId})
+	require.NoError(t, err)
+
+	history := getBookHistory(t, client, bookId)
+	require.NotEmpty(t, history)
+
+	latest := history[len(history)-1].GetBook()
+	require.Equal(t, current.GetBook().GetRevision(), latest.GetRevision())
+	require.Equal(t, current.GetBook().GetUpdatedAt().AsTime(), latest.GetUpdatedAt().AsTime())
+	require.Equal(t, current.GetBook().GetAuthorIds(), latest.GetAuthorIds())
+}
+
+func TestAuthorChangesBookRevisions(t *testing.T) {
+	client := startLibrary(t)
+	ctx := context.Background()
+
+	firstAuthorId := registerTestAuthor(t, client)
+	secondAuthorId := registerTestAuthor(t, client)
+	targetAuthorId := registerTestAuthor(t, client)
+
+	added, err := client.AddBook(ctx, &library.AddBookRequest{
+		Name:      "Coauthored book " + uuid.NewString()[:8],
+		AuthorIds: []string{firstAuthorId, secondAuthorId},
+	})
+	require.NoError(t, err)
+	bookId := added.GetBook().GetId()
+
+	removed, err := client.DeleteAuthor(ctx, &library.DeleteAuthorRequest{
+		Id:     firstAuthorId,
+		Policy: library.DeleteAuthorPolicy_DELETE_AUTHOR_POLICY_DETACH,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{bookId}, removed.GetDetachedBookIds())
+	requireLatestRevision(t, client, bookId)
+
+	merged, err := client.MergeAuthors(ctx, &library.MergeAuthorsRequest{
+		SourceIds: []string{secondAuthorId},
+		TargetId:  targetAuthorId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{bookId}, merged.GetBookIds())
+	requireLatestRevision(t, client, bookId)
+}
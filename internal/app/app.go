@@ -48,14 +48,29 @@ func Run(logger *zap.Logger, cfg *config.Config) {
 	// Накатывание миграций
 	db.SetupPostgres(dbPool, logger)
 
-	repo := repository.NewPostgresRepository(dbPool, logger)
+	repo := repository.NewPostgresRepository(dbPool, logger, cfg)
+
+	// Записи, сохраненные до включения режима уникальности, получают ключи до приема запросов
+	if err = repo.BackfillUniquenessKeys(ctx); err != nil {
+		logger.Error("Can not backfill uniqueness keys.", zap.Error(err))
+		return
+	}
 	outboxRepo := repository.NewOutbox(dbPool, logger)
 	transactor := repository.NewTransactor(dbPool, logger)
 
+	blobStorage, err := newBlobStorage(cfg, dbPool, logger)
+	if err != nil {
+		logger.Error("Can not create blob storage.", zap.Error(err))
+		return
+	}
+
 	runOutbox(ctx, cfg, logger, outboxRepo, transactor)
 
-	useCases := library.New(logger, repo, repo, outboxRepo, transactor)
-	ctrl := controller.New(logger, useCases, useCases)
+	useCases := library.New(logger, repo, repo, repo, repo, repo, repo, repo, repo, repo, repo, repo, repo, blobStorage, outboxRepo, transactor)
+	ctrl := controller.New(logger, useCases, useCases, useCases, useCases, useCases, useCases, useCases, useCases, useCases, useCases, useCases, useCases)
+
+	runHoldSweep(ctx, cfg, logger, useCases)
+	runChargeReminders(ctx, cfg, logger, useCases)
 
 	go runRest(ctx, cfg, logger)
 	go runGrpc(cfg, logger, ctrl)
@@ -66,4 +81,13 @@ func Run(logger *zap.Logger, cfg *config.Config) {
 	time.Sleep(timeToSuccessEnd)
 }
 
+// newBlobStorage выбирает хранилище содержимого обложек по COVER_STORAGE
+func newBlobStorage(cfg *config.Config, dbPool *pgxpool.Pool, logger *zap.Logger) (repository.BlobStorage, error) {
+	if cfg.Cover.Storage == "fs" {
+		return repository.NewFileBlobStorage(cfg.Cover.Dir, logger)
+	}
+
+	return repository.NewPostgresBlobStorage(dbPool, logger), nil
+}
+
 //FIXME add pyroscope
@@ -0,0 +1,67 @@
+package app
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	generated "github.com/project/library/generated/api/library"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+)
+
+// downloadCoverHandler отдает обложку по REST как есть, а не потоком JSON-сообщений, как сделал бы gateway
+func downloadCoverHandler(client generated.LibraryClient, logger *zap.Logger) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		stream, err := client.DownloadBookCover(r.Context(), &generated.DownloadBookCoverRequest{
+			BookId: pathParams["id"],
+		})
+		if err != nil {
+			writeGrpcError(w, err)
+			return
+		}
+
+		// Ошибки запроса приходят до первого сообщения, поэтому их еще можно вернуть статусом
+		first, err := stream.Recv()
+		if err != nil {
+			writeGrpcError(w, err)
+			return
+		}
+
+		info := first.GetInfo()
+		etag := strconv.Quote(info.GetSha256())
+
+		w.Header().Set("ETag", etag)
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", info.GetContentType())
+		w.Header().Set("Content-Length", strconv.FormatInt(info.GetSize(), 10))
+
+		for {
+			resp, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if err != nil {
+				// Заголовки уже отправлены, клиент увидит оборванный ответ
+				logger.Error("Failed to receive book cover.", zap.Error(err))
+				return
+			}
+
+			if _, err = w.Write(resp.GetChunk()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func writeGrpcError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+}
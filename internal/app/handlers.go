@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/project/library/config"
 	"github.com/project/library/internal/entity"
 	"github.com/project/library/internal/usecase/outbox"
 	"github.com/project/library/internal/usecase/repository"
@@ -14,15 +15,34 @@ import (
 
 func globalOutboxHandler(
 	client *http.Client,
-	bookURL string,
-	authorURL string,
+	cfg *config.Config,
 ) outbox.GlobalHandler {
 	return func(kind repository.OutboxKind) (outbox.KindHandler, error) {
 		switch kind {
 		case repository.OutboxKindBook:
-			return bookOutboxHandler(client, bookURL), nil
+			return bookOutboxHandler(client, cfg.Outbox.BookSendURL), nil
 		case repository.OutboxKindAuthor:
-			return authorOutboxHandler(client, authorURL), nil
+			return authorOutboxHandler(client, cfg.Outbox.AuthorSendURL), nil
+		case repository.OutboxKindBookDeleted:
+			return bookOutboxHandler(client, cfg.Outbox.BookDeletedSendURL), nil
+		case repository.OutboxKindAuthorDeleted:
+			return authorOutboxHandler(client, cfg.Outbox.AuthorDeletedSendURL), nil
+		case repository.OutboxKindBookGenreAdded:
+			return bookGenreOutboxHandler(client, cfg.Outbox.BookGenreSendURL), nil
+		case repository.OutboxKindAuthorMerged:
+			return authorRedirectOutboxHandler(client, cfg.Outbox.AuthorMergedSendURL), nil
+		case repository.OutboxKindPatron:
+			return patronOutboxHandler(client, cfg.Outbox.PatronSendURL), nil
+		case repository.OutboxKindPatronDeleted:
+			return patronOutboxHandler(client, cfg.Outbox.PatronDeletedSendURL), nil
+		case repository.OutboxKindLoan:
+			return loanOutboxHandler(client, cfg.Outbox.LoanSendURL), nil
+		case repository.OutboxKindHold:
+			return holdOutboxHandler(client, cfg.Outbox.HoldSendURL), nil
+		case repository.OutboxKindCharge:
+			return chargeOutboxHandler(client, cfg.Outbox.ChargeSendURL), nil
+		case repository.OutboxKindChargeReminder:
+			return chargeOutboxHandler(client, cfg.Outbox.ChargeReminderSendURL), nil
 		default:
 			return nil, fmt.Errorf("Unsupported outbox kind: %d", kind)
 		}
@@ -78,3 +98,81 @@ func authorOutboxHandler(
 		return author.Id, nil
 	})
 }
+
+func bookGenreOutboxHandler(
+	client *http.Client,
+	url string,
+) outbox.KindHandler {
+	return outboxHandler(client, url, func(data []byte) (string, error) {
+		bookGenre := entity.BookGenre{}
+		if err := json.Unmarshal(data, &bookGenre); err != nil {
+			return "", err
+		}
+		return bookGenre.BookId, nil
+	})
+}
+
+func authorRedirectOutboxHandler(
+	client *http.Client,
+	url string,
+) outbox.KindHandler {
+	return outboxHandler(client, url, func(data []byte) (string, error) {
+		redirect := entity.AuthorRedirect{}
+		if err := json.Unmarshal(data, &redirect); err != nil {
+			return "", err
+		}
+		return redirect.SourceId, nil
+	})
+}
+
+func patronOutboxHandler(
+	client *http.Client,
+	url string,
+) outbox.KindHandler {
+	return outboxHandler(client, url, func(data []byte) (string, error) {
+		patron := entity.Patron{}
+		if err := json.Unmarshal(data, &patron); err != nil {
+			return "", err
+		}
+		return patron.Id, nil
+	})
+}
+
+func loanOutboxHandler(
+	client *http.Client,
+	url string,
+) outbox.KindHandler {
+	return outboxHandler(client, url, func(data []byte) (string, error) {
+		loan := entity.Loan{}
+		if err := json.Unmarshal(data, &loan); err != nil {
+			return "", err
+		}
+		return loan.Id, nil
+	})
+}
+
+func holdOutboxHandler(
+	client *http.Client,
+	url string,
+) outbox.KindHandler {
+	return outboxHandler(client, url, func(data []byte) (string, error) {
+		hold := entity.Hold{}
+		if err := json.Unmarshal(data, &hold); err != nil {
+			return "", err
+		}
+		return hold.Id, nil
+	})
+}
+
+func chargeOutboxHandler(
+	client *http.Client,
+	url string,
+) outbox.KindHandler {
+	return outboxHandler(client, url, func(data []byte) (string, error) {
+		charge := entity.Charge{}
+		if err := json.Unmarshal(data, &charge); err != nil {
+			return "", err
+		}
+		return charge.Id, nil
+	})
+}
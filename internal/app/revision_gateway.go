@@ -0,0 +1,115 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	generated "github.com/project/library/generated/api/library"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Запросы, для которых If-Match превращается в expected_revision
+var ifMatchPaths = map[string]bool{
+	"/v1/library/book":   true,
+	"/v1/library/author": true,
+}
+
+// revisionETag отдает revision книги и автора в заголовке ETag, чтобы клиент мог вернуть его в If-Match
+func revisionETag(_ context.Context, w http.ResponseWriter, resp proto.Message) error {
+	switch msg := resp.(type) {
+	case *generated.GetBookInfoResponse:
+		// Для as_of это версия на тот момент: If-Match с ней для измененной книги вернет 412
+		if msg.GetBook().GetRevision() > 0 {
+			w.Header().Set("ETag", strconv.Quote(strconv.FormatInt(msg.GetBook().GetRevision(), 10)))
+		}
+	case *generated.GetAuthorInfoResponse:
+		if msg.GetRevision() > 0 {
+			w.Header().Set("ETag", strconv.Quote(strconv.FormatInt(msg.GetRevision(), 10)))
+		}
+	}
+
+	return nil
+}
+
+// ifMatchHandler переносит If-Match в поле expected_revision тела UpdateBook и ChangeAuthorInfo
+func ifMatchHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifMatch := strings.TrimSpace(r.Header.Get("If-Match"))
+		if r.Method != http.MethodPut || !ifMatchPaths[r.URL.Path] || ifMatch == "" || ifMatch == "*" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		revision, err := parseRevisionETag(ifMatch)
+		if err != nil {
+			http.Error(w, "If-Match must be a single revision ETag, e.g. \"3\"", http.StatusBadRequest)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Некорректное тело передается как есть, ошибку вернет gateway
+		fields := make(map[string]json.RawMessage)
+		if json.Unmarshal(body, &fields) == nil {
+			delete(fields, "expectedRevision")
+			fields["expected_revision"] = json.RawMessage(strconv.FormatInt(revision, 10))
+
+			if patched, err := json.Marshal(fields); err == nil {
+				body = patched
+			}
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+		r.Header.Set("Content-Length", strconv.Itoa(len(body)))
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// parseRevisionETag принимает только сильный ETag с номером версии: слабый нельзя сравнивать для If-Match
+func parseRevisionETag(etag string) (int64, error) {
+	unquoted, err := strconv.Unquote(etag)
+	if err != nil || !strings.HasPrefix(etag, `"`) {
+		return 0, strconv.ErrSyntax
+	}
+
+	revision, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || revision < 1 {
+		return 0, strconv.ErrSyntax
+	}
+
+	return revision, nil
+}
+
+// revisionErrorHandler возвращает 412 Precondition Failed, если revision не совпал с ожидаемым
+func revisionErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler,
+	w http.ResponseWriter, r *http.Request, err error) {
+	if status.Code(err) == codes.Aborted {
+		w = &statusOverrideWriter{ResponseWriter: w, code: http.StatusPreconditionFailed}
+	}
+
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+// statusOverrideWriter подменяет код ответа, тело ошибки остается как у gateway
+type statusOverrideWriter struct {
+	http.ResponseWriter
+	code int
+}
+
+func (w *statusOverrideWriter) WriteHeader(int) {
+	w.ResponseWriter.WriteHeader(w.code)
+}
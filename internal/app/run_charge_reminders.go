@@ -0,0 +1,40 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/config"
+	"github.com/project/library/internal/usecase/library"
+	"go.uber.org/zap"
+)
+
+// runChargeReminders периодически отправляет через outbox напоминания о неоплаченных штрафах
+func runChargeReminders(
+	ctx context.Context,
+	cfg *config.Config,
+	logger *zap.Logger,
+	chargeUseCase library.ChargeUseCase,
+) {
+	go func() {
+		ticker := time.NewTicker(cfg.Fine.ReminderIntervalMS)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				sent, err := chargeUseCase.SendChargeReminders(ctx)
+				if err != nil {
+					logger.Error("Can not send charge reminders.", zap.Error(err))
+					continue
+				}
+
+				if sent > 0 {
+					logger.Info("Charge reminders sent.", zap.Int("count", sent))
+				}
+			}
+		}
+	}()
+}
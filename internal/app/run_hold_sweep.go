@@ -0,0 +1,40 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/config"
+	"github.com/project/library/internal/usecase/library"
+	"go.uber.org/zap"
+)
+
+// runHoldSweep периодически истекает незабранные брони и продвигает очереди книг
+func runHoldSweep(
+	ctx context.Context,
+	cfg *config.Config,
+	logger *zap.Logger,
+	holdUseCase library.HoldUseCase,
+) {
+	go func() {
+		ticker := time.NewTicker(cfg.Hold.SweepIntervalMS)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				expired, err := holdUseCase.ExpireHolds(ctx)
+				if err != nil {
+					logger.Error("Can not expire holds.", zap.Error(err))
+					continue
+				}
+
+				if expired > 0 {
+					logger.Info("Holds expired.", zap.Int("count", expired))
+				}
+			}
+		}
+	}()
+}
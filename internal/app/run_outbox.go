@@ -46,8 +46,7 @@ func runOutbox(
 
 	client := &http.Client{Transport: transport}
 
-	globalHandler := globalOutboxHandler(
-		client, cfg.Outbox.BookSendURL, cfg.Outbox.AuthorSendURL)
+	globalHandler := globalOutboxHandler(client, cfg)
 	outboxService := outbox.New(
 		logger, outboxRepository, globalHandler, cfg, transactor)
 
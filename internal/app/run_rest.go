@@ -15,7 +15,10 @@ import (
 
 func runRest(ctx context.Context, cfg *config.Config, logger *zap.Logger) {
 	// Создание мультиплексора, преобразующего REST HTTP запросы в gRPC вызовы
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(
+		runtime.WithForwardResponseOption(revisionETag),
+		runtime.WithErrorHandler(revisionErrorHandler),
+	)
 	// Параметры подключения к gRPC серверу. Подключение без TLS.
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 
@@ -28,11 +31,27 @@ func runRest(ctx context.Context, cfg *config.Config, logger *zap.Logger) {
 		os.Exit(-1)
 	}
 
+	// Обложка отдается отдельным обработчиком: gateway не умеет возвращать поток как бинарное тело
+	conn, err := grpc.NewClient(address, opts...)
+	if err != nil {
+		logger.Error("Can not create grpc client.", zap.Error(err))
+		os.Exit(-1)
+	}
+
+	defer conn.Close()
+
+	err = mux.HandlePath(http.MethodGet, "/v1/library/book/{id}/cover",
+		downloadCoverHandler(generated.NewLibraryClient(conn), logger))
+	if err != nil {
+		logger.Error("Can not register book cover handler.", zap.Error(err))
+		os.Exit(-1)
+	}
+
 	gatewayPort := ":" + cfg.GatewayPort
 	logger.Info("Gateway listening.", zap.String("port", gatewayPort))
 
 	// Запуск http сервера
-	if err = http.ListenAndServe(gatewayPort, mux); err != nil {
+	if err = http.ListenAndServe(gatewayPort, ifMatchHandler(mux)); err != nil {
 		logger.Error("Gateway listen error.", zap.Error(err))
 	}
 
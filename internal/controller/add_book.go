@@ -4,11 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/project/library/internal/entity"
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
-	"time"
 
 	"github.com/project/library/generated/api/library"
 )
@@ -49,7 +48,18 @@ func (i *impl) AddBook(ctx context.Context, req *library.AddBookRequest) (*libra
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	book, err := i.booksUseCase.AddBook(ctx, req.GetName(), req.GetAuthorIds())
+	book, err := i.booksUseCase.AddBook(ctx, &entity.Book{
+		Name:      req.GetName(),
+		AuthorIds: req.GetAuthorIds(),
+		ISBN:      req.Isbn,
+
+		PublicationYear: req.PublicationYear,
+		Language:        req.Language,
+		PageCount:       req.PageCount,
+		Description:     req.Description,
+		PublisherId:     req.PublisherId,
+		Contributors:    convertContributorsFromProto(req.GetContributors()),
+	})
 
 	if err != nil {
 		SendSpanStatusLoggerError(i.logger, ctx, "Failed to add book.", err, codes.Internal)
@@ -57,12 +67,6 @@ func (i *impl) AddBook(ctx context.Context, req *library.AddBookRequest) (*libra
 	}
 
 	return &library.AddBookResponse{
-		Book: &library.Book{
-			Id:        book.Id,
-			Name:      book.Name,
-			AuthorIds: book.AuthorIds,
-			CreatedAt: timestamppb.New(book.CreatedAt),
-			UpdatedAt: timestamppb.New(book.UpdatedAt),
-		},
+		Book: convertBookToProto(book),
 	}, nil
 }
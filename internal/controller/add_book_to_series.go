@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	AddBookToSeriesDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_add_book_to_series_duration_ms",
+		Help:    "Duration of AddBookToSeries in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	AddBookToSeriesRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_add_book_to_series_requests_total",
+		Help: "Total number of AddBookToSeries requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(AddBookToSeriesDuration)
+	prometheus.MustRegister(AddBookToSeriesRequests)
+}
+
+func (i *impl) AddBookToSeries(ctx context.Context, req *library.AddBookToSeriesRequest) (*library.AddBookToSeriesResponse, error) {
+	AddBookToSeriesRequests.Inc()
+	start := time.Now()
+	defer func() {
+		AddBookToSeriesDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "AddBookToSeries")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received AddBookToSeries request.",
+		layerCont, "series_id", req.GetSeriesId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid AddBookToSeries request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err := i.seriesUseCase.AddBookToSeries(ctx, req.GetSeriesId(), req.GetBookId(), req.GetVolume())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to add book to series.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.AddBookToSeriesResponse{}, nil
+}
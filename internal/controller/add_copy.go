@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	AddCopyDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_add_copy_duration_ms",
+		Help:    "Duration of AddCopy in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	AddCopyRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_add_copy_requests_total",
+		Help: "Total number of AddCopy requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(AddCopyDuration)
+	prometheus.MustRegister(AddCopyRequests)
+}
+
+func (i *impl) AddCopy(ctx context.Context, req *library.AddCopyRequest) (*library.AddCopyResponse, error) {
+	AddCopyRequests.Inc()
+	start := time.Now()
+	defer func() {
+		AddCopyDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "AddCopy")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received AddCopy request.",
+		layerCont, "book_id", req.GetBookId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid AddCopy request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	bookCopy, err := i.copyUseCase.AddCopy(ctx, &entity.Copy{
+		BookId:    req.GetBookId(),
+		Barcode:   req.GetBarcode(),
+		Branch:    req.GetBranch(),
+		Location:  req.Location,
+		Condition: convertCopyCondition(req.GetCondition()),
+	})
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to add copy.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.AddCopyResponse{
+		Copy: convertCopyToProto(bookCopy),
+	}, nil
+}
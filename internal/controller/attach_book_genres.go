@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	AttachBookGenresDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_attach_book_genres_duration_ms",
+		Help:    "Duration of AttachBookGenres in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	AttachBookGenresRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_attach_book_genres_requests_total",
+		Help: "Total number of AttachBookGenres requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(AttachBookGenresDuration)
+	prometheus.MustRegister(AttachBookGenresRequests)
+}
+
+func (i *impl) AttachBookGenres(ctx context.Context, req *library.AttachBookGenresRequest) (*library.AttachBookGenresResponse, error) {
+	AttachBookGenresRequests.Inc()
+	start := time.Now()
+	defer func() {
+		AttachBookGenresDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "AttachBookGenres")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received AttachBookGenres request.",
+		layerCont, "book_id", req.GetBookId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid AttachBookGenres request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	attached, err := i.genreUseCase.AttachBookGenres(ctx, req.GetBookId(), req.GetGenreIds())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to attach book genres.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	attachedIds := make([]string, len(attached))
+	for j, bookGenre := range attached {
+		attachedIds[j] = bookGenre.GenreId
+	}
+
+	return &library.AttachBookGenresResponse{
+		AttachedGenreIds: attachedIds,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	CancelHoldDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_cancel_hold_duration_ms",
+		Help:    "Duration of CancelHold in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	CancelHoldRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_cancel_hold_requests_total",
+		Help: "Total number of CancelHold requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(CancelHoldDuration)
+	prometheus.MustRegister(CancelHoldRequests)
+}
+
+func (i *impl) CancelHold(ctx context.Context, req *library.CancelHoldRequest) (*library.CancelHoldResponse, error) {
+	CancelHoldRequests.Inc()
+	start := time.Now()
+	defer func() {
+		CancelHoldDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "CancelHold")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received CancelHold request.",
+		layerCont, "hold_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid CancelHold request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	hold, err := i.holdUseCase.CancelHold(ctx, req.GetId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to cancel hold.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.CancelHoldResponse{
+		Hold: convertHoldToProto(hold),
+	}, nil
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"time"
 
 	"github.com/project/library/generated/api/library"
 )
@@ -49,7 +48,28 @@ func (i *impl) ChangeAuthorInfo(ctx context.Context, req *library.ChangeAuthorIn
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err := i.authorUseCase.ChangeAuthor(ctx, req.GetId(), req.GetName())
+	update := entity.AuthorUpdate{
+		Id:   req.GetId(),
+		Name: req.GetName(),
+
+		Biography: req.Biography,
+		BirthDate: req.BirthDate,
+		DeathDate: req.DeathDate,
+
+		ExpectedRevision: req.ExpectedRevision,
+	}
+
+	if req.Aliases != nil {
+		aliases := req.GetAliases().GetAliases()
+		update.Aliases = &aliases
+	}
+
+	if req.ExternalIds != nil {
+		externalIds := convertAuthorExternalIds(req.GetExternalIds())
+		update.ExternalIds = &externalIds
+	}
+
+	err := i.authorUseCase.ChangeAuthor(ctx, update)
 
 	if err != nil {
 		SendSpanStatusLoggerError(i.logger, ctx, "Failed to change author info.", err, codes.Internal)
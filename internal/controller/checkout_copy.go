@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	CheckoutCopyDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_checkout_copy_duration_ms",
+		Help:    "Duration of CheckoutCopy in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	CheckoutCopyRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_checkout_copy_requests_total",
+		Help: "Total number of CheckoutCopy requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(CheckoutCopyDuration)
+	prometheus.MustRegister(CheckoutCopyRequests)
+}
+
+func (i *impl) CheckoutCopy(ctx context.Context, req *library.CheckoutCopyRequest) (*library.CheckoutCopyResponse, error) {
+	CheckoutCopyRequests.Inc()
+	start := time.Now()
+	defer func() {
+		CheckoutCopyDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "CheckoutCopy")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received CheckoutCopy request.",
+		layerCont, "copy_id", req.GetCopyId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid CheckoutCopy request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	loan, err := i.loanUseCase.CheckoutCopy(ctx, req.GetCopyId(), req.GetPatronId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to checkout copy.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.CheckoutCopyResponse{
+		Loan: convertLoanToProto(loan),
+	}, nil
+}
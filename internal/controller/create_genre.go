@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	CreateGenreDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_create_genre_duration_ms",
+		Help:    "Duration of CreateGenre in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	CreateGenreRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_create_genre_requests_total",
+		Help: "Total number of CreateGenre requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(CreateGenreDuration)
+	prometheus.MustRegister(CreateGenreRequests)
+}
+
+func (i *impl) CreateGenre(ctx context.Context, req *library.CreateGenreRequest) (*library.CreateGenreResponse, error) {
+	CreateGenreRequests.Inc()
+	start := time.Now()
+	defer func() {
+		CreateGenreDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "CreateGenre")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received CreateGenre request.",
+		layerCont, "genre_name", req.GetName())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid CreateGenre request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	genre, err := i.genreUseCase.CreateGenre(ctx, &entity.Genre{
+		Name:     req.GetName(),
+		ParentId: req.ParentId,
+	})
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to create genre.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.CreateGenreResponse{
+		Genre: convertGenreToProto(genre),
+	}, nil
+}
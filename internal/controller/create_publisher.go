@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	CreatePublisherDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_create_publisher_duration_ms",
+		Help:    "Duration of CreatePublisher in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	CreatePublisherRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_create_publisher_requests_total",
+		Help: "Total number of CreatePublisher requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(CreatePublisherDuration)
+	prometheus.MustRegister(CreatePublisherRequests)
+}
+
+func (i *impl) CreatePublisher(ctx context.Context, req *library.CreatePublisherRequest) (*library.CreatePublisherResponse, error) {
+	CreatePublisherRequests.Inc()
+	start := time.Now()
+	defer func() {
+		CreatePublisherDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "CreatePublisher")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received CreatePublisher request.",
+		layerCont, "publisher_name", req.GetName())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid CreatePublisher request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	publisher, err := i.publisherUseCase.CreatePublisher(ctx, req.GetName())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to create publisher.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.CreatePublisherResponse{
+		Publisher: convertPublisherToProto(publisher),
+	}, nil
+}
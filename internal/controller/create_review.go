@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	CreateReviewDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_create_review_duration_ms",
+		Help:    "Duration of CreateReview in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	CreateReviewRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_create_review_requests_total",
+		Help: "Total number of CreateReview requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(CreateReviewDuration)
+	prometheus.MustRegister(CreateReviewRequests)
+}
+
+func (i *impl) CreateReview(ctx context.Context, req *library.CreateReviewRequest) (*library.CreateReviewResponse, error) {
+	CreateReviewRequests.Inc()
+	start := time.Now()
+	defer func() {
+		CreateReviewDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "CreateReview")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received CreateReview request.",
+		layerCont, "book_id", req.GetBookId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid CreateReview request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	review, err := i.reviewUseCase.CreateReview(ctx, &entity.Review{
+		BookId:   req.GetBookId(),
+		PatronId: req.GetPatronId(),
+		Rating:   req.GetRating(),
+		Text:     req.GetText(),
+	})
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to create review.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.CreateReviewResponse{
+		Review: convertReviewToProto(review),
+	}, nil
+}
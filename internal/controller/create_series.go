@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	CreateSeriesDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_create_series_duration_ms",
+		Help:    "Duration of CreateSeries in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	CreateSeriesRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_create_series_requests_total",
+		Help: "Total number of CreateSeries requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(CreateSeriesDuration)
+	prometheus.MustRegister(CreateSeriesRequests)
+}
+
+func (i *impl) CreateSeries(ctx context.Context, req *library.CreateSeriesRequest) (*library.CreateSeriesResponse, error) {
+	CreateSeriesRequests.Inc()
+	start := time.Now()
+	defer func() {
+		CreateSeriesDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "CreateSeries")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received CreateSeries request.",
+		layerCont, "series_name", req.GetName())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid CreateSeries request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	series, err := i.seriesUseCase.CreateSeries(ctx, req.GetName())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to create series.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.CreateSeriesResponse{
+		Series: convertSeriesToProto(series),
+	}, nil
+}
@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	DeleteAuthorDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_delete_author_duration_ms",
+		Help:    "Duration of DeleteAuthor in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	DeleteAuthorRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_delete_author_requests_total",
+		Help: "Total number of DeleteAuthor requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(DeleteAuthorDuration)
+	prometheus.MustRegister(DeleteAuthorRequests)
+}
+
+func (i *impl) DeleteAuthor(ctx context.Context, req *library.DeleteAuthorRequest) (*library.DeleteAuthorResponse, error) {
+	DeleteAuthorRequests.Inc()
+	start := time.Now()
+	defer func() {
+		DeleteAuthorDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "DeleteAuthor")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received DeleteAuthor request.",
+		layerCont, "author_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid DeleteAuthor request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	removal, err := i.authorUseCase.DeleteAuthor(ctx, req.GetId(), convertDeleteAuthorPolicy(req.GetPolicy()))
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to delete author.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	response := &library.DeleteAuthorResponse{
+		DetachedBookIds: make([]string, len(removal.DetachedBooks)),
+		DeletedBookIds:  make([]string, len(removal.DeletedBooks)),
+	}
+	for j, book := range removal.DetachedBooks {
+		response.DetachedBookIds[j] = book.Id
+	}
+	for j, book := range removal.DeletedBooks {
+		response.DeletedBookIds[j] = book.Id
+	}
+
+	return response, nil
+}
+
+func convertDeleteAuthorPolicy(policy library.DeleteAuthorPolicy) entity.DeleteAuthorPolicy {
+	switch policy {
+	case library.DeleteAuthorPolicy_DELETE_AUTHOR_POLICY_DETACH:
+		return entity.DeleteAuthorPolicyDetach
+	case library.DeleteAuthorPolicy_DELETE_AUTHOR_POLICY_CASCADE:
+		return entity.DeleteAuthorPolicyCascade
+	default:
+		return entity.DeleteAuthorPolicyRestrict
+	}
+}
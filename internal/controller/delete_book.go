@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	DeleteBookDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_delete_book_duration_ms",
+		Help:    "Duration of DeleteBook in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	DeleteBookRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_delete_book_requests_total",
+		Help: "Total number of DeleteBook requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(DeleteBookDuration)
+	prometheus.MustRegister(DeleteBookRequests)
+}
+
+func (i *impl) DeleteBook(ctx context.Context, req *library.DeleteBookRequest) (*library.DeleteBookResponse, error) {
+	DeleteBookRequests.Inc()
+	start := time.Now()
+	defer func() {
+		DeleteBookDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "DeleteBook")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received DeleteBook request.",
+		layerCont, "book_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid DeleteBook request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err := i.booksUseCase.DeleteBook(ctx, req.GetId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to delete book.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.DeleteBookResponse{}, nil
+}
@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	DeletePatronDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_delete_patron_duration_ms",
+		Help:    "Duration of DeletePatron in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	DeletePatronRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_delete_patron_requests_total",
+		Help: "Total number of DeletePatron requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(DeletePatronDuration)
+	prometheus.MustRegister(DeletePatronRequests)
+}
+
+func (i *impl) DeletePatron(ctx context.Context, req *library.DeletePatronRequest) (*library.DeletePatronResponse, error) {
+	DeletePatronRequests.Inc()
+	start := time.Now()
+	defer func() {
+		DeletePatronDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "DeletePatron")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received DeletePatron request.",
+		layerCont, "patron_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid DeletePatron request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err := i.patronUseCase.DeletePatron(ctx, req.GetId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to delete patron.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.DeletePatronResponse{}, nil
+}
@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	DeletePublisherDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_delete_publisher_duration_ms",
+		Help:    "Duration of DeletePublisher in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	DeletePublisherRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_delete_publisher_requests_total",
+		Help: "Total number of DeletePublisher requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(DeletePublisherDuration)
+	prometheus.MustRegister(DeletePublisherRequests)
+}
+
+func (i *impl) DeletePublisher(ctx context.Context, req *library.DeletePublisherRequest) (*library.DeletePublisherResponse, error) {
+	DeletePublisherRequests.Inc()
+	start := time.Now()
+	defer func() {
+		DeletePublisherDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "DeletePublisher")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received DeletePublisher request.",
+		layerCont, "publisher_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid DeletePublisher request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err := i.publisherUseCase.DeletePublisher(ctx, req.GetId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to delete publisher.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.DeletePublisherResponse{}, nil
+}
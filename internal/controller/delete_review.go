@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	DeleteReviewDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_delete_review_duration_ms",
+		Help:    "Duration of DeleteReview in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	DeleteReviewRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_delete_review_requests_total",
+		Help: "Total number of DeleteReview requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(DeleteReviewDuration)
+	prometheus.MustRegister(DeleteReviewRequests)
+}
+
+func (i *impl) DeleteReview(ctx context.Context, req *library.DeleteReviewRequest) (*library.DeleteReviewResponse, error) {
+	DeleteReviewRequests.Inc()
+	start := time.Now()
+	defer func() {
+		DeleteReviewDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "DeleteReview")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received DeleteReview request.",
+		layerCont, "review_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid DeleteReview request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err := i.reviewUseCase.DeleteReview(ctx, req.GetId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to delete review.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.DeleteReviewResponse{}, nil
+}
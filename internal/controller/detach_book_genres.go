@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	DetachBookGenresDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_detach_book_genres_duration_ms",
+		Help:    "Duration of DetachBookGenres in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	DetachBookGenresRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_detach_book_genres_requests_total",
+		Help: "Total number of DetachBookGenres requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(DetachBookGenresDuration)
+	prometheus.MustRegister(DetachBookGenresRequests)
+}
+
+func (i *impl) DetachBookGenres(ctx context.Context, req *library.DetachBookGenresRequest) (*library.DetachBookGenresResponse, error) {
+	DetachBookGenresRequests.Inc()
+	start := time.Now()
+	defer func() {
+		DetachBookGenresDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "DetachBookGenres")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received DetachBookGenres request.",
+		layerCont, "book_id", req.GetBookId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid DetachBookGenres request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err := i.genreUseCase.DetachBookGenres(ctx, req.GetBookId(), req.GetGenreIds())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to detach book genres.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.DetachBookGenresResponse{}, nil
+}
@@ -0,0 +1,97 @@
+package controller
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	DownloadBookCoverDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_download_book_cover_duration_ms",
+		Help:    "Duration of DownloadBookCover in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	DownloadBookCoverRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_download_book_cover_requests_total",
+		Help: "Total number of DownloadBookCover requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(DownloadBookCoverDuration)
+	prometheus.MustRegister(DownloadBookCoverRequests)
+}
+
+// Размер части содержимого в одном сообщении потока
+const coverChunkSize = 64 << 10
+
+func (i *impl) DownloadBookCover(
+	req *library.DownloadBookCoverRequest,
+	stream grpc.ServerStreamingServer[library.DownloadBookCoverResponse],
+) error {
+	DownloadBookCoverRequests.Inc()
+	start := time.Now()
+	defer func() {
+		DownloadBookCoverDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(stream.Context(), "DownloadBookCover")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received DownloadBookCover request.",
+		layerCont, "book_id", req.GetBookId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid DownloadBookCover request.", err, codes.InvalidArgument)
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cover, content, err := i.coverUseCase.DownloadBookCover(ctx, req.GetBookId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to download book cover.", err, codes.Internal)
+		return i.ConvertErr(err)
+	}
+
+	defer content.Close()
+
+	err = stream.Send(&library.DownloadBookCoverResponse{
+		Data: &library.DownloadBookCoverResponse_Info{Info: convertBookCoverToProto(cover)},
+	})
+	if err != nil {
+		return err
+	}
+
+	chunk := make([]byte, coverChunkSize)
+	for {
+		n, readErr := content.Read(chunk)
+		if n > 0 {
+			// Send сериализует сообщение сразу, поэтому буфер можно переиспользовать
+			err = stream.Send(&library.DownloadBookCoverResponse{
+				Data: &library.DownloadBookCoverResponse_Chunk{Chunk: chunk[:n]},
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if errors.Is(readErr, io.EOF) {
+			return nil
+		}
+
+		if readErr != nil {
+			SendSpanStatusLoggerError(i.logger, ctx, "Failed to read book cover.", readErr, codes.Internal)
+			return status.Error(codes.Internal, readErr.Error())
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	FulfillNextHoldDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_fulfill_next_hold_duration_ms",
+		Help:    "Duration of FulfillNextHold in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	FulfillNextHoldRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_fulfill_next_hold_requests_total",
+		Help: "Total number of FulfillNextHold requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(FulfillNextHoldDuration)
+	prometheus.MustRegister(FulfillNextHoldRequests)
+}
+
+func (i *impl) FulfillNextHold(ctx context.Context, req *library.FulfillNextHoldRequest) (*library.FulfillNextHoldResponse, error) {
+	FulfillNextHoldRequests.Inc()
+	start := time.Now()
+	defer func() {
+		FulfillNextHoldDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "FulfillNextHold")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received FulfillNextHold request.",
+		layerCont, "book_id", req.GetBookId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid FulfillNextHold request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	hold, err := i.holdUseCase.FulfillNextHold(ctx, req.GetBookId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to fulfill next hold.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.FulfillNextHoldResponse{
+		Hold: convertHoldToProto(hold),
+	}, nil
+}
@@ -7,8 +7,6 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
-	"time"
 
 	"github.com/project/library/generated/api/library"
 )
@@ -51,7 +49,13 @@ func (i *impl) GetAuthorBooks(req *library.GetAuthorBooksRequest, server library
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	books, err := i.booksUseCase.GetAuthorBooks(ctx, req.GetAuthorId())
+	var role *entity.ContributorRole
+	if req.Role != nil {
+		converted := convertContributorRole(req.GetRole())
+		role = &converted
+	}
+
+	books, err := i.booksUseCase.GetAuthorBooks(ctx, req.GetAuthorId(), role)
 
 	if err != nil {
 		SendSpanStatusLoggerError(i.logger, ctx, "Failed to get author books.", err, codes.Internal)
@@ -59,13 +63,7 @@ func (i *impl) GetAuthorBooks(req *library.GetAuthorBooksRequest, server library
 	}
 
 	for _, book := range books {
-		err := server.Send(&library.Book{
-			Id:        book.Id,
-			Name:      book.Name,
-			AuthorIds: book.AuthorIds,
-			CreatedAt: timestamppb.New(book.CreatedAt),
-			UpdatedAt: timestamppb.New(book.UpdatedAt),
-		})
+		err := server.Send(convertBookToProto(book))
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	GetAuthorHistoryDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_get_author_history_duration_ms",
+		Help:    "Duration of GetAuthorHistory in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	GetAuthorHistoryRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_get_author_history_requests_total",
+		Help: "Total number of GetAuthorHistory requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(GetAuthorHistoryDuration)
+	prometheus.MustRegister(GetAuthorHistoryRequests)
+}
+
+func (i *impl) GetAuthorHistory(req *library.GetAuthorHistoryRequest, server library.Library_GetAuthorHistoryServer) error {
+	GetAuthorHistoryRequests.Inc()
+	start := time.Now()
+	defer func() {
+		GetAuthorHistoryDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(server.Context(), "GetAuthorHistory")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received GetAuthorHistory request.",
+		layerCont, "author_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid GetAuthorHistory request.", err, codes.InvalidArgument)
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	revisions, err := i.authorUseCase.GetAuthorHistory(ctx, req.GetId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to get author history.", err, codes.Internal)
+		return i.ConvertErr(err)
+	}
+
+	for _, revision := range revisions {
+		err := server.Send(convertAuthorRevisionToProto(revision))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"time"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/project/library/generated/api/library"
 )
@@ -49,7 +49,13 @@ func (i *impl) GetAuthorInfo(ctx context.Context, req *library.GetAuthorInfoRequ
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	author, err := i.authorUseCase.GetAuthorInfo(ctx, req.GetId())
+	var author *entity.Author
+	var err error
+	if req.AsOf != nil {
+		author, err = i.authorUseCase.GetAuthorInfoAsOf(ctx, req.GetId(), req.GetAsOf().AsTime())
+	} else {
+		author, err = i.authorUseCase.GetAuthorInfo(ctx, req.GetId())
+	}
 
 	if err != nil {
 		SendSpanStatusLoggerError(i.logger, ctx, "Failed to get author info.", err, codes.Internal)
@@ -57,7 +63,20 @@ func (i *impl) GetAuthorInfo(ctx context.Context, req *library.GetAuthorInfoRequ
 	}
 
 	return &library.GetAuthorInfoResponse{
-		Id:   author.Id,
-		Name: author.Name,
+		Id:        author.Id,
+		Name:      author.Name,
+		SortName:  author.SortName,
+		CreatedAt: timestamppb.New(author.CreatedAt),
+		UpdatedAt: timestamppb.New(author.UpdatedAt),
+		Revision:  author.Revision,
+
+		Biography: author.Biography,
+		BirthDate: author.BirthDate,
+		DeathDate: author.DeathDate,
+		Aliases:   author.Aliases,
+		ExternalIds: &library.AuthorExternalIds{
+			Viaf:     author.ExternalIds.Viaf,
+			Wikidata: author.ExternalIds.Wikidata,
+		},
 	}, nil
 }
@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	GetBookByISBNDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_get_book_by_isbn_duration_ms",
+		Help:    "Duration of GetBookByISBN in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	GetBookByISBNRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_get_book_by_isbn_requests_total",
+		Help: "Total number of GetBookByISBN requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(GetBookByISBNDuration)
+	prometheus.MustRegister(GetBookByISBNRequests)
+}
+
+func (i *impl) GetBookByISBN(ctx context.Context, req *library.GetBookByISBNRequest) (*library.GetBookByISBNResponse, error) {
+	GetBookByISBNRequests.Inc()
+	start := time.Now()
+	defer func() {
+		GetBookByISBNDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "GetBookByISBN")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received GetBookByISBN request.",
+		layerCont, "isbn", req.GetIsbn())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid GetBookByISBN request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	book, err := i.booksUseCase.GetBookByISBN(ctx, req.GetIsbn())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to get book by isbn.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.GetBookByISBNResponse{
+		Book: convertBookToProto(book),
+	}, nil
+}
@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	GetBookHistoryDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_get_book_history_duration_ms",
+		Help:    "Duration of GetBookHistory in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	GetBookHistoryRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_get_book_history_requests_total",
+		Help: "Total number of GetBookHistory requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(GetBookHistoryDuration)
+	prometheus.MustRegister(GetBookHistoryRequests)
+}
+
+func (i *impl) GetBookHistory(req *library.GetBookHistoryRequest, server library.Library_GetBookHistoryServer) error {
+	GetBookHistoryRequests.Inc()
+	start := time.Now()
+	defer func() {
+		GetBookHistoryDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(server.Context(), "GetBookHistory")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received GetBookHistory request.",
+		layerCont, "book_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid GetBookHistory request.", err, codes.InvalidArgument)
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	revisions, err := i.booksUseCase.GetBookHistory(ctx, req.GetId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to get book history.", err, codes.Internal)
+		return i.ConvertErr(err)
+	}
+
+	for _, revision := range revisions {
+		err := server.Send(convertBookRevisionToProto(revision))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -8,8 +8,6 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
-	"time"
 
 	"github.com/project/library/generated/api/library"
 )
@@ -50,7 +48,13 @@ func (i *impl) GetBookInfo(ctx context.Context, req *library.GetBookInfoRequest)
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	book, err := i.booksUseCase.GetBook(ctx, req.GetId())
+	var book *entity.Book
+	var err error
+	if req.AsOf != nil {
+		book, err = i.booksUseCase.GetBookAsOf(ctx, req.GetId(), req.GetAsOf().AsTime())
+	} else {
+		book, err = i.booksUseCase.GetBook(ctx, req.GetId())
+	}
 
 	if err != nil {
 		SendSpanStatusLoggerError(i.logger, ctx, "Failed to get book.", err, codes.Internal)
@@ -58,12 +62,8 @@ func (i *impl) GetBookInfo(ctx context.Context, req *library.GetBookInfoRequest)
 	}
 
 	return &library.GetBookInfoResponse{
-		Book: &library.Book{
-			Id:        book.Id,
-			Name:      book.Name,
-			AuthorIds: book.AuthorIds,
-			CreatedAt: timestamppb.New(book.CreatedAt),
-			UpdatedAt: timestamppb.New(book.UpdatedAt),
-		},
+		Book:         convertBookToProto(book),
+		Availability: convertCopyAvailabilityToProto(book.Availability),
+		Rating:       convertBookRatingToProto(book.Rating),
 	}, nil
 }
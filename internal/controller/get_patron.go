@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	GetPatronDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_get_patron_duration_ms",
+		Help:    "Duration of GetPatron in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	GetPatronRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_get_patron_requests_total",
+		Help: "Total number of GetPatron requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(GetPatronDuration)
+	prometheus.MustRegister(GetPatronRequests)
+}
+
+func (i *impl) GetPatron(ctx context.Context, req *library.GetPatronRequest) (*library.GetPatronResponse, error) {
+	GetPatronRequests.Inc()
+	start := time.Now()
+	defer func() {
+		GetPatronDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "GetPatron")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received GetPatron request.",
+		layerCont, "patron_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid GetPatron request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	patron, err := i.patronUseCase.GetPatron(ctx, req.GetId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to get patron.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.GetPatronResponse{
+		Patron: convertPatronToProto(patron),
+	}, nil
+}
@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	GetPublisherDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_get_publisher_duration_ms",
+		Help:    "Duration of GetPublisher in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	GetPublisherRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_get_publisher_requests_total",
+		Help: "Total number of GetPublisher requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(GetPublisherDuration)
+	prometheus.MustRegister(GetPublisherRequests)
+}
+
+func (i *impl) GetPublisher(ctx context.Context, req *library.GetPublisherRequest) (*library.GetPublisherResponse, error) {
+	GetPublisherRequests.Inc()
+	start := time.Now()
+	defer func() {
+		GetPublisherDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "GetPublisher")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received GetPublisher request.",
+		layerCont, "publisher_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid GetPublisher request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	publisher, err := i.publisherUseCase.GetPublisher(ctx, req.GetId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to get publisher.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.GetPublisherResponse{
+		Publisher: convertPublisherToProto(publisher),
+	}, nil
+}
@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	GetPublisherBooksDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_get_publisher_books_duration_ms",
+		Help:    "Duration of GetPublisherBooks in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	GetPublisherBooksRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_get_publisher_books_requests_total",
+		Help: "Total number of GetPublisherBooks requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(GetPublisherBooksDuration)
+	prometheus.MustRegister(GetPublisherBooksRequests)
+}
+
+func (i *impl) GetPublisherBooks(req *library.GetPublisherBooksRequest, server library.Library_GetPublisherBooksServer) error {
+	GetPublisherBooksRequests.Inc()
+	start := time.Now()
+	defer func() {
+		GetPublisherBooksDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx := server.Context()
+
+	ctx, span := CreateTracerSpan(ctx, "GetPublisherBooks")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received GetPublisherBooks request.",
+		layerCont, "publisher_id", req.GetPublisherId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid GetPublisherBooks request.", err, codes.InvalidArgument)
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	books, err := i.booksUseCase.GetPublisherBooks(ctx, req.GetPublisherId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to get publisher books.", err, codes.Internal)
+		return i.ConvertErr(err)
+	}
+
+	for _, book := range books {
+		err := server.Send(convertBookToProto(book))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
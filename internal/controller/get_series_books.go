@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	GetSeriesBooksDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_get_series_books_duration_ms",
+		Help:    "Duration of GetSeriesBooks in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	GetSeriesBooksRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_get_series_books_requests_total",
+		Help: "Total number of GetSeriesBooks requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(GetSeriesBooksDuration)
+	prometheus.MustRegister(GetSeriesBooksRequests)
+}
+
+func (i *impl) GetSeriesBooks(req *library.GetSeriesBooksRequest, server library.Library_GetSeriesBooksServer) error {
+	GetSeriesBooksRequests.Inc()
+	start := time.Now()
+	defer func() {
+		GetSeriesBooksDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx := server.Context()
+
+	ctx, span := CreateTracerSpan(ctx, "GetSeriesBooks")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received GetSeriesBooks request.",
+		layerCont, "series_id", req.GetSeriesId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid GetSeriesBooks request.", err, codes.InvalidArgument)
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	books, err := i.booksUseCase.GetSeriesBooks(ctx, req.GetSeriesId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to get series books.", err, codes.Internal)
+		return i.ConvertErr(err)
+	}
+
+	for _, book := range books {
+		err := server.Send(convertBookToProto(book))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	ListAuthorsDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_list_authors_duration_ms",
+		Help:    "Duration of ListAuthors in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	ListAuthorsRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_list_authors_requests_total",
+		Help: "Total number of ListAuthors requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(ListAuthorsDuration)
+	prometheus.MustRegister(ListAuthorsRequests)
+}
+
+func (i *impl) ListAuthors(ctx context.Context, req *library.ListAuthorsRequest) (*library.ListAuthorsResponse, error) {
+	ListAuthorsRequests.Inc()
+	start := time.Now()
+	defer func() {
+		ListAuthorsDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "ListAuthors")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received ListAuthors request.",
+		layerCont, "name_prefix", req.GetNamePrefix())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid ListAuthors request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := i.authorUseCase.ListAuthors(ctx, entity.AuthorFilter{
+		NamePrefix:    req.GetNamePrefix(),
+		WithBookCount: req.GetWithBookCount(),
+		PageSize:      int(req.GetPageSize()),
+		PageToken:     req.GetPageToken(),
+	})
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to list authors.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	authors := make([]*library.AuthorSummary, len(page.Authors))
+	for j, entry := range page.Authors {
+		authors[j] = &library.AuthorSummary{
+			Id:        entry.Author.Id,
+			Name:      entry.Author.Name,
+			SortName:  entry.Author.SortName,
+			BookCount: entry.BookCount,
+		}
+	}
+
+	return &library.ListAuthorsResponse{
+		Authors:       authors,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
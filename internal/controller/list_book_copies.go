@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	ListBookCopiesDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_list_book_copies_duration_ms",
+		Help:    "Duration of ListBookCopies in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	ListBookCopiesRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_list_book_copies_requests_total",
+		Help: "Total number of ListBookCopies requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(ListBookCopiesDuration)
+	prometheus.MustRegister(ListBookCopiesRequests)
+}
+
+func (i *impl) ListBookCopies(ctx context.Context, req *library.ListBookCopiesRequest) (*library.ListBookCopiesResponse, error) {
+	ListBookCopiesRequests.Inc()
+	start := time.Now()
+	defer func() {
+		ListBookCopiesDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "ListBookCopies")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received ListBookCopies request.",
+		layerCont, "book_id", req.GetBookId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid ListBookCopies request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	copies, err := i.copyUseCase.ListBookCopies(ctx, entity.CopyFilter{
+		BookId:         req.GetBookId(),
+		Branch:         req.GetBranch(),
+		IncludeRetired: req.GetIncludeRetired(),
+	})
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to list book copies.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	response := &library.ListBookCopiesResponse{
+		Copies: make([]*library.Copy, len(copies)),
+	}
+	for j, bookCopy := range copies {
+		response.Copies[j] = convertCopyToProto(bookCopy)
+	}
+
+	return response, nil
+}
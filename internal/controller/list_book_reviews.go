@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	ListBookReviewsDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_list_book_reviews_duration_ms",
+		Help:    "Duration of ListBookReviews in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	ListBookReviewsRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_list_book_reviews_requests_total",
+		Help: "Total number of ListBookReviews requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(ListBookReviewsDuration)
+	prometheus.MustRegister(ListBookReviewsRequests)
+}
+
+func (i *impl) ListBookReviews(ctx context.Context, req *library.ListBookReviewsRequest) (*library.ListBookReviewsResponse, error) {
+	ListBookReviewsRequests.Inc()
+	start := time.Now()
+	defer func() {
+		ListBookReviewsDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "ListBookReviews")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received ListBookReviews request.",
+		layerCont, "book_id", req.GetBookId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid ListBookReviews request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := i.reviewUseCase.ListBookReviews(ctx, entity.ReviewFilter{
+		BookId:    req.GetBookId(),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	})
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to list book reviews.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	reviews := make([]*library.Review, len(page.Reviews))
+	for j, review := range page.Reviews {
+		reviews[j] = convertReviewToProto(review)
+	}
+
+	return &library.ListBookReviewsResponse{
+		Reviews:       reviews,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	ListBooksDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_list_books_duration_ms",
+		Help:    "Duration of ListBooks in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	ListBooksRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_list_books_requests_total",
+		Help: "Total number of ListBooks requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(ListBooksDuration)
+	prometheus.MustRegister(ListBooksRequests)
+}
+
+func (i *impl) ListBooks(ctx context.Context, req *library.ListBooksRequest) (*library.ListBooksResponse, error) {
+	ListBooksRequests.Inc()
+	start := time.Now()
+	defer func() {
+		ListBooksDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "ListBooks")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received ListBooks request.",
+		layerCont, "name_prefix", req.GetNamePrefix())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid ListBooks request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := i.booksUseCase.ListBooks(ctx, entity.BookFilter{
+		NamePrefix:    req.GetNamePrefix(),
+		AuthorIds:     req.GetAuthorIds(),
+		CreatedAfter:  optionalTime(req.GetCreatedAfter()),
+		CreatedBefore: optionalTime(req.GetCreatedBefore()),
+		UpdatedAfter:  optionalTime(req.GetUpdatedAfter()),
+		UpdatedBefore: optionalTime(req.GetUpdatedBefore()),
+		YearFrom:      req.YearFrom,
+		YearTo:        req.YearTo,
+		Language:      req.GetLanguage(),
+		GenreIds:      req.GetGenreIds(),
+		OrderBy:       convertBookOrder(req.GetOrderBy()),
+		PageSize:      int(req.GetPageSize()),
+		PageToken:     req.GetPageToken(),
+	})
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to list books.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	books := make([]*library.Book, len(page.Books))
+	for j, book := range page.Books {
+		books[j] = convertBookToProto(book)
+	}
+
+	return &library.ListBooksResponse{
+		Books:         books,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func convertBookOrder(order library.BookOrder) entity.BookOrder {
+	switch order {
+	case library.BookOrder_BOOK_ORDER_CREATED_AT_DESC:
+		return entity.BookOrderCreatedAtDesc
+	case library.BookOrder_BOOK_ORDER_NAME_ASC:
+		return entity.BookOrderNameAsc
+	case library.BookOrder_BOOK_ORDER_NAME_DESC:
+		return entity.BookOrderNameDesc
+	default:
+		return entity.BookOrderCreatedAtAsc
+	}
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	ListChargesDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_list_charges_duration_ms",
+		Help:    "Duration of ListCharges in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	ListChargesRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_list_charges_requests_total",
+		Help: "Total number of ListCharges requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(ListChargesDuration)
+	prometheus.MustRegister(ListChargesRequests)
+}
+
+func (i *impl) ListCharges(ctx context.Context, req *library.ListChargesRequest) (*library.ListChargesResponse, error) {
+	ListChargesRequests.Inc()
+	start := time.Now()
+	defer func() {
+		ListChargesDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "ListCharges")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received ListCharges request.",
+		layerCont, "patron_id", req.GetPatronId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid ListCharges request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	charges, err := i.chargeUseCase.ListCharges(ctx, entity.ChargeFilter{
+		PatronId:       req.GetPatronId(),
+		BookId:         req.GetBookId(),
+		IncludeSettled: req.GetIncludeSettled(),
+	})
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to list charges.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	response := &library.ListChargesResponse{
+		Charges: make([]*library.Charge, len(charges)),
+	}
+	for j, charge := range charges {
+		response.Charges[j] = convertChargeToProto(charge)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	ListGenresDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_list_genres_duration_ms",
+		Help:    "Duration of ListGenres in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	ListGenresRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_list_genres_requests_total",
+		Help: "Total number of ListGenres requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(ListGenresDuration)
+	prometheus.MustRegister(ListGenresRequests)
+}
+
+func (i *impl) ListGenres(ctx context.Context, req *library.ListGenresRequest) (*library.ListGenresResponse, error) {
+	ListGenresRequests.Inc()
+	start := time.Now()
+	defer func() {
+		ListGenresDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "ListGenres")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received ListGenres request.",
+		layerCont, "parent_id", req.GetParentId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid ListGenres request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	genres, err := i.genreUseCase.ListGenres(ctx, entity.GenreFilter{
+		ParentId: req.ParentId,
+	})
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to list genres.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	protoGenres := make([]*library.Genre, len(genres))
+	for j, genre := range genres {
+		protoGenres[j] = convertGenreToProto(genre)
+	}
+
+	return &library.ListGenresResponse{
+		Genres: protoGenres,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	ListHoldsDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_list_holds_duration_ms",
+		Help:    "Duration of ListHolds in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	ListHoldsRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_list_holds_requests_total",
+		Help: "Total number of ListHolds requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(ListHoldsDuration)
+	prometheus.MustRegister(ListHoldsRequests)
+}
+
+func (i *impl) ListHolds(ctx context.Context, req *library.ListHoldsRequest) (*library.ListHoldsResponse, error) {
+	ListHoldsRequests.Inc()
+	start := time.Now()
+	defer func() {
+		ListHoldsDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "ListHolds")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received ListHolds request.",
+		layerCont, "book_id", req.GetBookId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid ListHolds request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	holds, err := i.holdUseCase.ListHolds(ctx, entity.HoldFilter{
+		BookId:        req.GetBookId(),
+		PatronId:      req.GetPatronId(),
+		IncludeClosed: req.GetIncludeClosed(),
+	})
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to list holds.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	response := &library.ListHoldsResponse{
+		Holds: make([]*library.Hold, len(holds)),
+	}
+	for j, hold := range holds {
+		response.Holds[j] = convertHoldToProto(hold)
+	}
+
+	return response, nil
+}
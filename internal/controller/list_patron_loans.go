@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	ListPatronLoansDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_list_patron_loans_duration_ms",
+		Help:    "Duration of ListPatronLoans in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	ListPatronLoansRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_list_patron_loans_requests_total",
+		Help: "Total number of ListPatronLoans requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(ListPatronLoansDuration)
+	prometheus.MustRegister(ListPatronLoansRequests)
+}
+
+func (i *impl) ListPatronLoans(ctx context.Context, req *library.ListPatronLoansRequest) (*library.ListPatronLoansResponse, error) {
+	ListPatronLoansRequests.Inc()
+	start := time.Now()
+	defer func() {
+		ListPatronLoansDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "ListPatronLoans")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received ListPatronLoans request.",
+		layerCont, "patron_id", req.GetPatronId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid ListPatronLoans request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	loans, err := i.loanUseCase.ListPatronLoans(ctx, entity.LoanFilter{
+		PatronId:        req.GetPatronId(),
+		IncludeReturned: req.GetIncludeReturned(),
+	})
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to list patron loans.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	response := &library.ListPatronLoansResponse{
+		Loans: make([]*library.Loan, len(loans)),
+	}
+	for j, loan := range loans {
+		response.Loans[j] = convertLoanToProto(loan)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	MergeAuthorsDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_merge_authors_duration_ms",
+		Help:    "Duration of MergeAuthors in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	MergeAuthorsRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_merge_authors_requests_total",
+		Help: "Total number of MergeAuthors requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(MergeAuthorsDuration)
+	prometheus.MustRegister(MergeAuthorsRequests)
+}
+
+func (i *impl) MergeAuthors(ctx context.Context, req *library.MergeAuthorsRequest) (*library.MergeAuthorsResponse, error) {
+	MergeAuthorsRequests.Inc()
+	start := time.Now()
+	defer func() {
+		MergeAuthorsDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "MergeAuthors")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received MergeAuthors request.",
+		layerCont, "author_id", req.GetTargetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid MergeAuthors request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	merge, err := i.authorUseCase.MergeAuthors(ctx, req.GetSourceIds(), req.GetTargetId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to merge authors.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	response := &library.MergeAuthorsResponse{
+		BookIds: make([]string, len(merge.Books)),
+	}
+	for j, book := range merge.Books {
+		response.BookIds[j] = book.Id
+	}
+
+	return response, nil
+}
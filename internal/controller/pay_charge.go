@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	PayChargeDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_pay_charge_duration_ms",
+		Help:    "Duration of PayCharge in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	PayChargeRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_pay_charge_requests_total",
+		Help: "Total number of PayCharge requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(PayChargeDuration)
+	prometheus.MustRegister(PayChargeRequests)
+}
+
+func (i *impl) PayCharge(ctx context.Context, req *library.PayChargeRequest) (*library.PayChargeResponse, error) {
+	PayChargeRequests.Inc()
+	start := time.Now()
+	defer func() {
+		PayChargeDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "PayCharge")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received PayCharge request.",
+		layerCont, "charge_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid PayCharge request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	charge, err := i.chargeUseCase.PayCharge(ctx, req.GetId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to pay charge.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.PayChargeResponse{
+		Charge: convertChargeToProto(charge),
+	}, nil
+}
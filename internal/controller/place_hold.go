@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	PlaceHoldDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_place_hold_duration_ms",
+		Help:    "Duration of PlaceHold in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	PlaceHoldRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_place_hold_requests_total",
+		Help: "Total number of PlaceHold requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(PlaceHoldDuration)
+	prometheus.MustRegister(PlaceHoldRequests)
+}
+
+func (i *impl) PlaceHold(ctx context.Context, req *library.PlaceHoldRequest) (*library.PlaceHoldResponse, error) {
+	PlaceHoldRequests.Inc()
+	start := time.Now()
+	defer func() {
+		PlaceHoldDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "PlaceHold")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received PlaceHold request.",
+		layerCont, "book_id", req.GetBookId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid PlaceHold request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	hold, err := i.holdUseCase.PlaceHold(ctx, req.GetBookId(), req.GetPatronId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to place hold.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.PlaceHoldResponse{
+		Hold: convertHoldToProto(hold),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	RecordLateReturnDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_record_late_return_duration_ms",
+		Help:    "Duration of RecordLateReturn in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	RecordLateReturnRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_record_late_return_requests_total",
+		Help: "Total number of RecordLateReturn requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(RecordLateReturnDuration)
+	prometheus.MustRegister(RecordLateReturnRequests)
+}
+
+func (i *impl) RecordLateReturn(ctx context.Context, req *library.RecordLateReturnRequest) (*library.RecordLateReturnResponse, error) {
+	RecordLateReturnRequests.Inc()
+	start := time.Now()
+	defer func() {
+		RecordLateReturnDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "RecordLateReturn")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received RecordLateReturn request.",
+		layerCont, "patron_id", req.GetPatronId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid RecordLateReturn request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	charge, err := i.chargeUseCase.RecordLateReturn(ctx, entity.LateReturn{
+		PatronId:   req.GetPatronId(),
+		BookId:     req.GetBookId(),
+		DueAt:      req.GetDueAt().AsTime(),
+		ReturnedAt: req.GetReturnedAt().AsTime(),
+	})
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to record late return.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.RecordLateReturnResponse{
+		Charge: convertChargeToProto(charge),
+	}, nil
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"time"
 
 	"github.com/project/library/generated/api/library"
 )
@@ -49,7 +48,15 @@ func (i *impl) RegisterAuthor(ctx context.Context, req *library.RegisterAuthorRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	author, err := i.authorUseCase.RegisterAuthor(ctx, req.GetName())
+	author, err := i.authorUseCase.RegisterAuthor(ctx, &entity.Author{
+		Name: req.GetName(),
+
+		Biography:   req.Biography,
+		BirthDate:   req.BirthDate,
+		DeathDate:   req.DeathDate,
+		Aliases:     req.GetAliases(),
+		ExternalIds: convertAuthorExternalIds(req.GetExternalIds()),
+	})
 
 	if err != nil {
 		SendSpanStatusLoggerError(i.logger, ctx, "Failed to register author.", err, codes.Internal)
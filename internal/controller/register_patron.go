@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	RegisterPatronDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_register_patron_duration_ms",
+		Help:    "Duration of RegisterPatron in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	RegisterPatronRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_register_patron_requests_total",
+		Help: "Total number of RegisterPatron requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(RegisterPatronDuration)
+	prometheus.MustRegister(RegisterPatronRequests)
+}
+
+func (i *impl) RegisterPatron(ctx context.Context, req *library.RegisterPatronRequest) (*library.RegisterPatronResponse, error) {
+	RegisterPatronRequests.Inc()
+	start := time.Now()
+	defer func() {
+		RegisterPatronDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "RegisterPatron")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received RegisterPatron request.",
+		layerCont, "card_number", req.GetCardNumber())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid RegisterPatron request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	patron, err := i.patronUseCase.RegisterPatron(ctx, &entity.Patron{
+		CardNumber: req.GetCardNumber(),
+		Name:       req.GetName(),
+		Email:      req.Email,
+		Phone:      req.Phone,
+		ExpiresOn:  req.GetExpiresOn(),
+	})
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to register patron.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.RegisterPatronResponse{
+		Patron: convertPatronToProto(patron),
+	}, nil
+}
@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	RemoveBookFromSeriesDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_remove_book_from_series_duration_ms",
+		Help:    "Duration of RemoveBookFromSeries in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	RemoveBookFromSeriesRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_remove_book_from_series_requests_total",
+		Help: "Total number of RemoveBookFromSeries requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(RemoveBookFromSeriesDuration)
+	prometheus.MustRegister(RemoveBookFromSeriesRequests)
+}
+
+func (i *impl) RemoveBookFromSeries(ctx context.Context, req *library.RemoveBookFromSeriesRequest) (*library.RemoveBookFromSeriesResponse, error) {
+	RemoveBookFromSeriesRequests.Inc()
+	start := time.Now()
+	defer func() {
+		RemoveBookFromSeriesDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "RemoveBookFromSeries")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received RemoveBookFromSeries request.",
+		layerCont, "series_id", req.GetSeriesId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid RemoveBookFromSeries request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err := i.seriesUseCase.RemoveBookFromSeries(ctx, req.GetSeriesId(), req.GetBookId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to remove book from series.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.RemoveBookFromSeriesResponse{}, nil
+}
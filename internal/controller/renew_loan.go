@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	RenewLoanDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_renew_loan_duration_ms",
+		Help:    "Duration of RenewLoan in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	RenewLoanRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_renew_loan_requests_total",
+		Help: "Total number of RenewLoan requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(RenewLoanDuration)
+	prometheus.MustRegister(RenewLoanRequests)
+}
+
+func (i *impl) RenewLoan(ctx context.Context, req *library.RenewLoanRequest) (*library.RenewLoanResponse, error) {
+	RenewLoanRequests.Inc()
+	start := time.Now()
+	defer func() {
+		RenewLoanDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "RenewLoan")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received RenewLoan request.",
+		layerCont, "loan_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid RenewLoan request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	loan, err := i.loanUseCase.RenewLoan(ctx, req.GetId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to renew loan.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.RenewLoanResponse{
+		Loan: convertLoanToProto(loan),
+	}, nil
+}
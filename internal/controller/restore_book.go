@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	RestoreBookDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_restore_book_duration_ms",
+		Help:    "Duration of RestoreBook in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	RestoreBookRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_restore_book_requests_total",
+		Help: "Total number of RestoreBook requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(RestoreBookDuration)
+	prometheus.MustRegister(RestoreBookRequests)
+}
+
+func (i *impl) RestoreBook(ctx context.Context, req *library.RestoreBookRequest) (*library.RestoreBookResponse, error) {
+	RestoreBookRequests.Inc()
+	start := time.Now()
+	defer func() {
+		RestoreBookDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "RestoreBook")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received RestoreBook request.",
+		layerCont, "book_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid RestoreBook request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	book, err := i.booksUseCase.RestoreBook(ctx, req.GetId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to restore book.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.RestoreBookResponse{
+		Book: convertBookToProto(book),
+	}, nil
+}
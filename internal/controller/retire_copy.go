@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	RetireCopyDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_retire_copy_duration_ms",
+		Help:    "Duration of RetireCopy in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	RetireCopyRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_retire_copy_requests_total",
+		Help: "Total number of RetireCopy requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(RetireCopyDuration)
+	prometheus.MustRegister(RetireCopyRequests)
+}
+
+func (i *impl) RetireCopy(ctx context.Context, req *library.RetireCopyRequest) (*library.RetireCopyResponse, error) {
+	RetireCopyRequests.Inc()
+	start := time.Now()
+	defer func() {
+		RetireCopyDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "RetireCopy")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received RetireCopy request.",
+		layerCont, "copy_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid RetireCopy request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	bookCopy, err := i.copyUseCase.RetireCopy(ctx, req.GetId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to retire copy.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.RetireCopyResponse{
+		Copy: convertCopyToProto(bookCopy),
+	}, nil
+}
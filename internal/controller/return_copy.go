@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	ReturnCopyDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_return_copy_duration_ms",
+		Help:    "Duration of ReturnCopy in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	ReturnCopyRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_return_copy_requests_total",
+		Help: "Total number of ReturnCopy requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(ReturnCopyDuration)
+	prometheus.MustRegister(ReturnCopyRequests)
+}
+
+func (i *impl) ReturnCopy(ctx context.Context, req *library.ReturnCopyRequest) (*library.ReturnCopyResponse, error) {
+	ReturnCopyRequests.Inc()
+	start := time.Now()
+	defer func() {
+		ReturnCopyDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "ReturnCopy")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received ReturnCopy request.",
+		layerCont, "copy_id", req.GetCopyId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid ReturnCopy request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	loan, err := i.loanUseCase.ReturnCopy(ctx, req.GetCopyId())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to return copy.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.ReturnCopyResponse{
+		Loan: convertLoanToProto(loan),
+	}, nil
+}
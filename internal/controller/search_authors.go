@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	SearchAuthorsDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_search_authors_duration_ms",
+		Help:    "Duration of SearchAuthors in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	SearchAuthorsRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_search_authors_requests_total",
+		Help: "Total number of SearchAuthors requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(SearchAuthorsDuration)
+	prometheus.MustRegister(SearchAuthorsRequests)
+}
+
+func (i *impl) SearchAuthors(ctx context.Context, req *library.SearchAuthorsRequest) (*library.SearchAuthorsResponse, error) {
+	SearchAuthorsRequests.Inc()
+	start := time.Now()
+	defer func() {
+		SearchAuthorsDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "SearchAuthors")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received SearchAuthors request.",
+		layerCont, "query", req.GetQuery())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid SearchAuthors request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	matches, err := i.authorUseCase.SearchAuthors(ctx, entity.AuthorSearch{
+		Query:     req.GetQuery(),
+		Threshold: req.GetThreshold(),
+		Limit:     int(req.GetLimit()),
+	})
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to search authors.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	authors := make([]*library.AuthorMatch, len(matches))
+	for j, match := range matches {
+		authors[j] = &library.AuthorMatch{
+			Id:         match.Author.Id,
+			Name:       match.Author.Name,
+			Similarity: match.Similarity,
+		}
+	}
+
+	return &library.SearchAuthorsResponse{
+		Authors: authors,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	SearchBooksDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_search_books_duration_ms",
+		Help:    "Duration of SearchBooks in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	SearchBooksRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_search_books_requests_total",
+		Help: "Total number of SearchBooks requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(SearchBooksDuration)
+	prometheus.MustRegister(SearchBooksRequests)
+}
+
+func (i *impl) SearchBooks(ctx context.Context, req *library.SearchBooksRequest) (*library.SearchBooksResponse, error) {
+	SearchBooksRequests.Inc()
+	start := time.Now()
+	defer func() {
+		SearchBooksDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "SearchBooks")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received SearchBooks request.",
+		layerCont, "query", req.GetQuery())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid SearchBooks request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := i.booksUseCase.SearchBooks(ctx, entity.BookSearch{
+		Query:     req.GetQuery(),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	})
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to search books.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	hits := make([]*library.BookSearchHit, len(page.Hits))
+	for j, hit := range page.Hits {
+		hits[j] = &library.BookSearchHit{
+			Book:    convertBookToProto(hit.Book),
+			Rank:    hit.Rank,
+			Snippet: hit.Snippet,
+		}
+	}
+
+	return &library.SearchBooksResponse{
+		Hits:          hits,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
@@ -14,19 +14,49 @@ const layerCont = "controller"
 // impl реализует все методы gRPC API. Валидирует запрос и вызывает бизнес-логику.
 type impl struct {
 	generated.UnimplementedLibraryServer
-	logger        *zap.Logger
-	booksUseCase  library.BooksUseCase
-	authorUseCase library.AuthorUseCase
+	logger           *zap.Logger
+	booksUseCase     library.BooksUseCase
+	authorUseCase    library.AuthorUseCase
+	genreUseCase     library.GenreUseCase
+	publisherUseCase library.PublisherUseCase
+	seriesUseCase    library.SeriesUseCase
+	copyUseCase      library.CopyUseCase
+	patronUseCase    library.PatronUseCase
+	loanUseCase      library.LoanUseCase
+	holdUseCase      library.HoldUseCase
+	chargeUseCase    library.ChargeUseCase
+	reviewUseCase    library.ReviewUseCase
+	coverUseCase     library.CoverUseCase
 }
 
 func New(
 	logger *zap.Logger,
 	booksUseCase library.BooksUseCase,
 	authorUseCase library.AuthorUseCase,
+	genreUseCase library.GenreUseCase,
+	publisherUseCase library.PublisherUseCase,
+	seriesUseCase library.SeriesUseCase,
+	copyUseCase library.CopyUseCase,
+	patronUseCase library.PatronUseCase,
+	loanUseCase library.LoanUseCase,
+	holdUseCase library.HoldUseCase,
+	chargeUseCase library.ChargeUseCase,
+	reviewUseCase library.ReviewUseCase,
+	coverUseCase library.CoverUseCase,
 ) *impl {
 	return &impl{
-		logger:        logger,
-		booksUseCase:  booksUseCase,
-		authorUseCase: authorUseCase,
+		logger:           logger,
+		booksUseCase:     booksUseCase,
+		authorUseCase:    authorUseCase,
+		genreUseCase:     genreUseCase,
+		publisherUseCase: publisherUseCase,
+		seriesUseCase:    seriesUseCase,
+		copyUseCase:      copyUseCase,
+		patronUseCase:    patronUseCase,
+		loanUseCase:      loanUseCase,
+		holdUseCase:      holdUseCase,
+		chargeUseCase:    chargeUseCase,
+		reviewUseCase:    reviewUseCase,
+		coverUseCase:     coverUseCase,
 	}
 }
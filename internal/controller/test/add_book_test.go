@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"github.com/project/library/internal/entity"
+	"strings"
 	"testing"
 
 	"github.com/project/library/generated/api/library"
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 )
 
 // Проверка ожидаемой работы
@@ -28,11 +30,12 @@ func TestAddBook(t *testing.T) {
 	}
 
 	tests := []struct {
-		name      string
-		args      args
-		want      *library.AddBookResponse
-		wantErr   error
-		mocksUsed bool
+		name             string
+		args             args
+		want             *library.AddBookResponse
+		wantContributors []entity.Contributor
+		wantErr          error
+		mocksUsed        bool
 	}{
 		{
 			name: "add book | without authors",
@@ -70,6 +73,171 @@ func TestAddBook(t *testing.T) {
 			wantErr:   nil,
 			mocksUsed: true,
 		},
+		{
+			name: "add book | with isbn",
+			args: args{ctx,
+				&library.AddBookRequest{
+					Name:      "book3",
+					AuthorIds: []string{uuid2},
+					Isbn:      proto.String("0-306-40615-2"),
+				},
+			},
+			want: &library.AddBookResponse{
+				Book: &library.Book{
+					Id:        uuid6,
+					Name:      "book3",
+					AuthorIds: []string{uuid2},
+					Isbn:      proto.String("9780306406157"),
+				},
+			},
+			wantErr:   nil,
+			mocksUsed: true,
+		},
+		{
+			name: "add book | with metadata",
+			args: args{ctx,
+				&library.AddBookRequest{
+					Name:            "book4",
+					PublicationYear: proto.Int32(1869),
+					Language:        proto.String("ru"),
+					PageCount:       proto.Int32(1300),
+					Description:     proto.String("Роман-эпопея"),
+				},
+			},
+			want: &library.AddBookResponse{
+				Book: &library.Book{
+					Id:              uuid7,
+					Name:            "book4",
+					PublicationYear: proto.Int32(1869),
+					Language:        proto.String("ru"),
+					PageCount:       proto.Int32(1300),
+					Description:     proto.String("Роман-эпопея"),
+				},
+			},
+			wantErr:   nil,
+			mocksUsed: true,
+		},
+		{
+			name: "add book | with invalid publication year",
+			args: args{
+				ctx,
+				&library.AddBookRequest{
+					Name:            "book",
+					PublicationYear: proto.Int32(0),
+				},
+			},
+			wantErr:   mockErr,
+			mocksUsed: false,
+		},
+		{
+			name: "add book | with too long description",
+			args: args{
+				ctx,
+				&library.AddBookRequest{
+					Name:        "book",
+					Description: proto.String(strings.Repeat("a", 10001)),
+				},
+			},
+			wantErr:   mockErr,
+			mocksUsed: false,
+		},
+		{
+			name: "add book | with too short isbn",
+			args: args{
+				ctx,
+				&library.AddBookRequest{
+					Name: "book",
+					Isbn: proto.String("123"),
+				},
+			},
+			wantErr:   mockErr,
+			mocksUsed: false,
+		},
+		{
+			name: "add book | with publisher",
+			args: args{
+				ctx,
+				&library.AddBookRequest{
+					Name:        "book",
+					PublisherId: proto.String(uuid4),
+				},
+			},
+			want: &library.AddBookResponse{
+				Book: &library.Book{
+					Id:          uuid1,
+					Name:        "book",
+					PublisherId: proto.String(uuid4),
+				},
+			},
+			wantErr:   nil,
+			mocksUsed: true,
+		},
+		{
+			name: "add book | with invalid publisher",
+			args: args{
+				ctx,
+				&library.AddBookRequest{
+					Name:        "book",
+					PublisherId: proto.String("aboba"),
+				},
+			},
+			wantErr:   mockErr,
+			mocksUsed: false,
+		},
+		{
+			name: "add book | with contributors",
+			args: args{
+				ctx,
+				&library.AddBookRequest{
+					Name: "book",
+					Contributors: []*library.Contributor{
+						{AuthorId: uuid5, Role: library.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR},
+						{AuthorId: uuid6, Role: library.ContributorRole_CONTRIBUTOR_ROLE_TRANSLATOR},
+					},
+				},
+			},
+			want: &library.AddBookResponse{
+				Book: &library.Book{
+					Id:        uuid1,
+					Name:      "book",
+					AuthorIds: []string{uuid5, uuid6},
+					Contributors: []*library.Contributor{
+						{AuthorId: uuid5, Role: library.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR},
+						{AuthorId: uuid6, Role: library.ContributorRole_CONTRIBUTOR_ROLE_TRANSLATOR},
+					},
+				},
+			},
+			wantContributors: []entity.Contributor{
+				{AuthorId: uuid5, Role: entity.ContributorRoleAuthor},
+				{AuthorId: uuid6, Role: entity.ContributorRoleTranslator},
+			},
+			wantErr:   nil,
+			mocksUsed: true,
+		},
+		{
+			name: "add book | with undefined contributor role",
+			args: args{
+				ctx,
+				&library.AddBookRequest{
+					Name:         "book",
+					Contributors: []*library.Contributor{{AuthorId: uuid5, Role: 42}},
+				},
+			},
+			wantErr:   mockErr,
+			mocksUsed: false,
+		},
+		{
+			name: "add book | with invalid contributor id",
+			args: args{
+				ctx,
+				&library.AddBookRequest{
+					Name:         "book",
+					Contributors: []*library.Contributor{{AuthorId: "1"}},
+				},
+			},
+			wantErr:   mockErr,
+			mocksUsed: false,
+		},
 		{
 			name: "add book | with invalid authors",
 			args: args{
@@ -119,18 +287,29 @@ func TestAddBook(t *testing.T) {
 
 			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
 			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
-			service := controller.New(logger, bookUseCase, authorUseCase)
+			service := controller.New(logger, bookUseCase, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			if test.mocksUsed {
 				// Описание действий заглушки
 				var book *entity.Book
 				if test.want != nil {
-					book = ProtoToBook(test.want.Book)
+					book = ProtoToBook(t, test.want.Book)
 				}
 
 				bookUseCase.
 					EXPECT().
-					AddBook(gomock.Any(), test.args.req.GetName(), test.args.req.GetAuthorIds()).
+					AddBook(gomock.Any(), &entity.Book{
+						Name:      test.args.req.GetName(),
+						AuthorIds: test.args.req.GetAuthorIds(),
+						ISBN:      test.args.req.Isbn,
+
+						PublicationYear: test.args.req.PublicationYear,
+						Language:        test.args.req.Language,
+						PageCount:       test.args.req.PageCount,
+						Description:     test.args.req.Description,
+						PublisherId:     test.args.req.PublisherId,
+						Contributors:    test.wantContributors,
+					}).
 					Return(book, test.wantErr)
 			}
 
@@ -140,6 +319,14 @@ func TestAddBook(t *testing.T) {
 				assert.Equal(t, test.want.GetBook().GetId(), got.GetBook().GetId())
 				assert.Equal(t, test.want.GetBook().GetName(), got.GetBook().GetName())
 				assert.Equal(t, test.want.GetBook().GetAuthorIds(), got.GetBook().GetAuthorIds())
+				assert.Equal(t, test.want.GetBook().Isbn, got.GetBook().Isbn)
+				assert.Equal(t, test.want.GetBook().PublicationYear, got.GetBook().PublicationYear)
+				assert.Equal(t, test.want.GetBook().Language, got.GetBook().Language)
+				assert.Equal(t, test.want.GetBook().PageCount, got.GetBook().PageCount)
+				assert.Equal(t, test.want.GetBook().Description, got.GetBook().Description)
+				assert.Equal(t, test.want.GetBook().PublisherId, got.GetBook().PublisherId)
+				assert.ElementsMatch(t, ProtoToBook(t, test.want.GetBook()).Contributors,
+					ProtoToBook(t, got.GetBook()).Contributors)
 			}
 
 			if test.wantErr == nil {
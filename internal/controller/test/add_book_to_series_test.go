@@ -0,0 +1,136 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_AddBookToSeries(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.AddBookToSeriesRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "add book to series | valid request",
+			args: args{
+				ctx,
+				&library.AddBookToSeriesRequest{
+					SeriesId: uuid1,
+					BookId:   uuid2,
+					Volume:   1,
+				},
+			},
+			wantErr:   nil,
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "add book to series | zero volume",
+			args: args{
+				ctx,
+				&library.AddBookToSeriesRequest{
+					SeriesId: uuid1,
+					BookId:   uuid2,
+					Volume:   0,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "add book to series | series not found",
+			args: args{
+				ctx,
+				&library.AddBookToSeriesRequest{
+					SeriesId: uuid1,
+					BookId:   uuid2,
+					Volume:   1,
+				},
+			},
+			wantErr:   entity.ErrSeriesNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "add book to series | book not found",
+			args: args{
+				ctx,
+				&library.AddBookToSeriesRequest{
+					SeriesId: uuid1,
+					BookId:   uuid2,
+					Volume:   1,
+				},
+			},
+			wantErr:   entity.ErrBookNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "add book to series | volume taken",
+			args: args{
+				ctx,
+				&library.AddBookToSeriesRequest{
+					SeriesId: uuid1,
+					BookId:   uuid2,
+					Volume:   1,
+				},
+			},
+			wantErr:   entity.ErrSeriesVolumeTaken,
+			wantCode:  codes.AlreadyExists,
+			mocksUsed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			seriesUseCase := mocks.NewMockSeriesUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, seriesUseCase, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				seriesUseCase.
+					EXPECT().
+					AddBookToSeries(gomock.Any(), test.args.req.GetSeriesId(), test.args.req.GetBookId(),
+						test.args.req.GetVolume()).
+					Return(test.wantErr)
+			}
+
+			got, err := service.AddBookToSeries(test.args.ctx, test.args.req)
+
+			if test.wantErr == nil {
+				assert.NoError(t, err)
+				assert.NotNil(t, got)
+			} else {
+				assert.Error(t, err)
+			}
+			assert.Equal(t, test.wantCode, status.Code(err))
+		})
+	}
+}
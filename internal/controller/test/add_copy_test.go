@@ -0,0 +1,203 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_AddCopy(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+	shelf := "Hall 2, shelf 14"
+
+	type args struct {
+		ctx context.Context
+		req *library.AddCopyRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantCopy  *entity.Copy
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "add copy | valid request",
+			args: args{
+				ctx,
+				&library.AddCopyRequest{
+					BookId:    uuid1,
+					Barcode:   "LIB-000123",
+					Branch:    "central",
+					Location:  &shelf,
+					Condition: library.CopyCondition_COPY_CONDITION_NEW,
+				},
+			},
+			wantCopy: &entity.Copy{
+				BookId:    uuid1,
+				Barcode:   "LIB-000123",
+				Branch:    "central",
+				Location:  &shelf,
+				Condition: entity.CopyConditionNew,
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "add copy | unspecified condition is good",
+			args: args{
+				ctx,
+				&library.AddCopyRequest{
+					BookId:  uuid1,
+					Barcode: "0001",
+					Branch:  "north",
+				},
+			},
+			wantCopy: &entity.Copy{
+				BookId:    uuid1,
+				Barcode:   "0001",
+				Branch:    "north",
+				Condition: entity.CopyConditionGood,
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "add copy | barcode taken",
+			args: args{
+				ctx,
+				&library.AddCopyRequest{
+					BookId:  uuid1,
+					Barcode: "0001",
+					Branch:  "north",
+				},
+			},
+			wantCopy: &entity.Copy{
+				BookId:  uuid1,
+				Barcode: "0001",
+				Branch:  "north",
+			},
+			wantErr:   &entity.AlreadyExistsError{Err: entity.ErrCopyAlreadyExists, Id: uuid2},
+			wantCode:  codes.AlreadyExists,
+			mocksUsed: true,
+		},
+		{
+			name: "add copy | book not found",
+			args: args{
+				ctx,
+				&library.AddCopyRequest{
+					BookId:  uuid1,
+					Barcode: "0001",
+					Branch:  "north",
+				},
+			},
+			wantCopy: &entity.Copy{
+				BookId:  uuid1,
+				Barcode: "0001",
+				Branch:  "north",
+			},
+			wantErr:   entity.ErrBookNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "add copy | short barcode",
+			args: args{
+				ctx,
+				&library.AddCopyRequest{
+					BookId:  uuid1,
+					Barcode: "12",
+					Branch:  "north",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "add copy | empty branch",
+			args: args{
+				ctx,
+				&library.AddCopyRequest{
+					BookId:  uuid1,
+					Barcode: "0001",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "add copy | unknown condition",
+			args: args{
+				ctx,
+				&library.AddCopyRequest{
+					BookId:    uuid1,
+					Barcode:   "0001",
+					Branch:    "north",
+					Condition: 42,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			copyUseCase := mocks.NewMockCopyUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, copyUseCase, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				var added *entity.Copy
+				if test.wantErr == nil {
+					added = &entity.Copy{}
+					*added = *test.wantCopy
+					added.Id = uuid2
+					added.CreatedAt = time.Now()
+					added.UpdatedAt = added.CreatedAt
+				}
+
+				copyUseCase.
+					EXPECT().
+					AddCopy(gomock.Any(), test.wantCopy).
+					Return(added, test.wantErr)
+			}
+
+			got, err := service.AddCopy(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, uuid2, got.GetCopy().GetId())
+			assert.Equal(t, test.args.req.GetBarcode(), got.GetCopy().GetBarcode())
+			assert.Equal(t, test.args.req.Location, got.GetCopy().Location)
+			assert.Equal(t, library.CopyStatus_COPY_STATUS_AVAILABLE, got.GetCopy().GetStatus())
+			assert.Nil(t, got.GetCopy().GetRetiredAt())
+		})
+	}
+}
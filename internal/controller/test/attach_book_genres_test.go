@@ -0,0 +1,153 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_AttachBookGenres(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.AttachBookGenresRequest
+	}
+
+	tests := []struct {
+		name         string
+		args         args
+		wantAttached []string
+		wantErr      error
+		wantCode     codes.Code
+		mocksUsed    bool
+	}{
+		{
+			name: "attach book genres | valid request",
+			args: args{
+				ctx,
+				&library.AttachBookGenresRequest{
+					BookId:   uuid1,
+					GenreIds: []string{uuid2, uuid3},
+				},
+			},
+			wantAttached: []string{uuid2, uuid3},
+			wantErr:      nil,
+			wantCode:     codes.OK,
+			mocksUsed:    true,
+		},
+		{
+			name: "attach book genres | already attached",
+			args: args{
+				ctx,
+				&library.AttachBookGenresRequest{
+					BookId:   uuid1,
+					GenreIds: []string{uuid2},
+				},
+			},
+			wantAttached: []string{},
+			wantErr:      nil,
+			wantCode:     codes.OK,
+			mocksUsed:    true,
+		},
+		{
+			name: "attach book genres | no genres",
+			args: args{
+				ctx,
+				&library.AttachBookGenresRequest{
+					BookId: uuid1,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "attach book genres | invalid genre id",
+			args: args{
+				ctx,
+				&library.AttachBookGenresRequest{
+					BookId:   uuid1,
+					GenreIds: []string{"aboba"},
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "attach book genres | book not found",
+			args: args{
+				ctx,
+				&library.AttachBookGenresRequest{
+					BookId:   uuid1,
+					GenreIds: []string{uuid2},
+				},
+			},
+			wantErr:   entity.ErrBookNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "attach book genres | genre not found",
+			args: args{
+				ctx,
+				&library.AttachBookGenresRequest{
+					BookId:   uuid1,
+					GenreIds: []string{uuid2},
+				},
+			},
+			wantErr:   entity.ErrGenreNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			genreUseCase := mocks.NewMockGenreUseCase(ctrl)
+			service := controller.New(logger, nil, nil, genreUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				var attached []*entity.BookGenre
+				for _, genreId := range test.wantAttached {
+					attached = append(attached, &entity.BookGenre{
+						BookId:  test.args.req.GetBookId(),
+						GenreId: genreId,
+					})
+				}
+
+				genreUseCase.
+					EXPECT().
+					AttachBookGenres(gomock.Any(), test.args.req.GetBookId(), test.args.req.GetGenreIds()).
+					Return(attached, test.wantErr)
+			}
+
+			got, err := service.AttachBookGenres(test.args.ctx, test.args.req)
+
+			if test.wantErr == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, test.wantAttached, got.GetAttachedGenreIds())
+			} else {
+				assert.Error(t, err)
+			}
+			assert.Equal(t, test.wantCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_CancelHold(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.CancelHoldRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "cancel hold | valid request",
+			args: args{
+				ctx,
+				&library.CancelHoldRequest{
+					Id: uuid1,
+				},
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "cancel hold | not found",
+			args: args{
+				ctx,
+				&library.CancelHoldRequest{
+					Id: uuid1,
+				},
+			},
+			wantErr:   entity.ErrHoldNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "cancel hold | already closed",
+			args: args{
+				ctx,
+				&library.CancelHoldRequest{
+					Id: uuid1,
+				},
+			},
+			wantErr:   entity.ErrHoldClosed,
+			wantCode:  codes.FailedPrecondition,
+			mocksUsed: true,
+		},
+		{
+			name: "cancel hold | invalid uuid",
+			args: args{
+				ctx,
+				&library.CancelHoldRequest{
+					Id: "aboba",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			holdUseCase := mocks.NewMockHoldUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, holdUseCase, nil, nil, nil)
+
+			if test.mocksUsed {
+				var hold *entity.Hold
+				if test.wantErr == nil {
+					hold = &entity.Hold{
+						Id:       test.args.req.GetId(),
+						BookId:   uuid2,
+						PatronId: uuid3,
+						Status:   entity.HoldStatusCancelled,
+						PlacedAt: time.Now(),
+					}
+				}
+
+				holdUseCase.
+					EXPECT().
+					CancelHold(gomock.Any(), test.args.req.GetId()).
+					Return(hold, test.wantErr)
+			}
+
+			got, err := service.CancelHold(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, uuid1, got.GetHold().GetId())
+			assert.Equal(t, library.HoldStatus_HOLD_STATUS_CANCELLED, got.GetHold().GetStatus())
+		})
+	}
+}
@@ -8,9 +8,11 @@ import (
 	"github.com/google/uuid"
 	"github.com/project/library/generated/api/library"
 	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
 	"github.com/project/library/internal/usecase/library/mocks"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 )
 
 // Проверка ожидаемой работы
@@ -67,6 +69,104 @@ func Test_ChangeAuthorInfo(t *testing.T) {
 			mockErr,
 			false,
 		},
+		{
+			"change author info | profile",
+			args{
+				ctx,
+				&library.ChangeAuthorInfoRequest{
+					Id:        uuid.NewString(),
+					Name:      "New Name",
+					Biography: proto.String(""),
+					BirthDate: proto.String("1828-09-09"),
+					Aliases:   &library.AuthorAliases{Aliases: []string{"Leo Tolstoy"}},
+					ExternalIds: &library.AuthorExternalIds{
+						Viaf:     "96987389",
+						Wikidata: "Q7243",
+					},
+				},
+			},
+			nil,
+			true,
+		},
+		{
+			"change author info | remove aliases and date",
+			args{
+				ctx,
+				&library.ChangeAuthorInfoRequest{
+					Id:        uuid.NewString(),
+					Name:      "New Name",
+					DeathDate: proto.String(""),
+					Aliases:   &library.AuthorAliases{},
+				},
+			},
+			nil,
+			true,
+		},
+		{
+			"change author info | with invalid date",
+			args{
+				ctx,
+				&library.ChangeAuthorInfoRequest{
+					Id:        uuid.NewString(),
+					Name:      "New Name",
+					BirthDate: proto.String("09.09.1828"),
+				},
+			},
+			mockErr,
+			false,
+		},
+		{
+			"change author info | with invalid wikidata id",
+			args{
+				ctx,
+				&library.ChangeAuthorInfoRequest{
+					Id:          uuid.NewString(),
+					Name:        "New Name",
+					ExternalIds: &library.AuthorExternalIds{Wikidata: "7243"},
+				},
+			},
+			mockErr,
+			false,
+		},
+		{
+			"change author info | expected revision",
+			args{
+				ctx,
+				&library.ChangeAuthorInfoRequest{
+					Id:               uuid.NewString(),
+					Name:             "New Name",
+					ExpectedRevision: proto.Int64(2),
+				},
+			},
+			nil,
+			true,
+		},
+		{
+			"change author info | revision mismatch",
+			args{
+				ctx,
+				&library.ChangeAuthorInfoRequest{
+					Id:               uuid.NewString(),
+					Name:             "New Name",
+					ExpectedRevision: proto.Int64(2),
+				},
+			},
+			entity.ErrAuthorRevisionMismatch,
+			true,
+		},
+		{
+			"change author info | negative expected revision",
+			args{
+				ctx,
+				&library.ChangeAuthorInfoRequest{
+					Id:               uuid.NewString(),
+					Name:             "New Name",
+					ExpectedRevision: proto.Int64(-1),
+				},
+			},
+			mockErr,
+			false,
+		},
 		{
 			"change author info | usecase error",
 			args{
@@ -90,11 +190,34 @@ func Test_ChangeAuthorInfo(t *testing.T) {
 
 			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
 			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
-			service := controller.New(logger, bookUseCase, authorUseCase)
+			service := controller.New(logger, bookUseCase, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			if test.mocksUsed {
+				req := test.args.req
+				update := entity.AuthorUpdate{
+					Id:        req.GetId(),
+					Name:      req.GetName(),
+					Biography: req.Biography,
+					BirthDate: req.BirthDate,
+					DeathDate: req.DeathDate,
+
+					ExpectedRevision: req.ExpectedRevision,
+				}
+
+				if req.Aliases != nil {
+					aliases := req.GetAliases().GetAliases()
+					update.Aliases = &aliases
+				}
+
+				if req.ExternalIds != nil {
+					update.ExternalIds = &entity.AuthorExternalIds{
+						Viaf:     req.GetExternalIds().GetViaf(),
+						Wikidata: req.GetExternalIds().GetWikidata(),
+					}
+				}
+
 				authorUseCase.EXPECT().
-					ChangeAuthor(gomock.Any(), test.args.req.GetId(), test.args.req.GetName()).
+					ChangeAuthor(gomock.Any(), update).
 					Return(test.wantErr)
 			}
 
@@ -0,0 +1,146 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_CheckoutCopy(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.CheckoutCopyRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "checkout copy | valid request",
+			args: args{
+				ctx,
+				&library.CheckoutCopyRequest{
+					CopyId:   uuid1,
+					PatronId: uuid2,
+				},
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "checkout copy | already on loan",
+			args: args{
+				ctx,
+				&library.CheckoutCopyRequest{
+					CopyId:   uuid1,
+					PatronId: uuid2,
+				},
+			},
+			wantErr:   entity.ErrCopyOnLoan,
+			wantCode:  codes.FailedPrecondition,
+			mocksUsed: true,
+		},
+		{
+			name: "checkout copy | patron suspended",
+			args: args{
+				ctx,
+				&library.CheckoutCopyRequest{
+					CopyId:   uuid1,
+					PatronId: uuid2,
+				},
+			},
+			wantErr:   entity.ErrPatronSuspended,
+			wantCode:  codes.FailedPrecondition,
+			mocksUsed: true,
+		},
+		{
+			name: "checkout copy | patron not found",
+			args: args{
+				ctx,
+				&library.CheckoutCopyRequest{
+					CopyId:   uuid1,
+					PatronId: uuid2,
+				},
+			},
+			wantErr:   entity.ErrPatronNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "checkout copy | invalid patron id",
+			args: args{
+				ctx,
+				&library.CheckoutCopyRequest{
+					CopyId:   uuid1,
+					PatronId: "aboba",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			loanUseCase := mocks.NewMockLoanUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, nil, loanUseCase, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				var loan *entity.Loan
+				if test.wantErr == nil {
+					loan = &entity.Loan{
+						Id:           uuid3,
+						CopyId:       test.args.req.GetCopyId(),
+						BookId:       uuid4,
+						PatronId:     test.args.req.GetPatronId(),
+						CheckedOutAt: time.Now(),
+						DueAt:        time.Now().AddDate(0, 0, 14),
+					}
+				}
+
+				loanUseCase.
+					EXPECT().
+					CheckoutCopy(gomock.Any(), test.args.req.GetCopyId(), test.args.req.GetPatronId()).
+					Return(loan, test.wantErr)
+			}
+
+			got, err := service.CheckoutCopy(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, uuid3, got.GetLoan().GetId())
+			assert.Equal(t, uuid4, got.GetLoan().GetBookId())
+			assert.True(t, got.GetLoan().GetDueAt().AsTime().After(got.GetLoan().GetCheckedOutAt().AsTime()))
+			assert.Nil(t, got.GetLoan().GetReturnedAt())
+		})
+	}
+}
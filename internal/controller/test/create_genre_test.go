@@ -0,0 +1,155 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_CreateGenre(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+	parentId := uuid1
+
+	type args struct {
+		ctx context.Context
+		req *library.CreateGenreRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "create genre | root genre",
+			args: args{
+				ctx,
+				&library.CreateGenreRequest{
+					Name: "Fiction",
+				},
+			},
+			wantErr:   nil,
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "create genre | child genre",
+			args: args{
+				ctx,
+				&library.CreateGenreRequest{
+					Name:     "Science Fiction",
+					ParentId: &parentId,
+				},
+			},
+			wantErr:   nil,
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "create genre | empty name",
+			args: args{
+				ctx,
+				&library.CreateGenreRequest{
+					Name: "",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "create genre | invalid parent id",
+			args: args{
+				ctx,
+				&library.CreateGenreRequest{
+					Name:     "Science Fiction",
+					ParentId: new(string),
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "create genre | parent not found",
+			args: args{
+				ctx,
+				&library.CreateGenreRequest{
+					Name:     "Science Fiction",
+					ParentId: &parentId,
+				},
+			},
+			wantErr:   entity.ErrGenreNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "create genre | already exists",
+			args: args{
+				ctx,
+				&library.CreateGenreRequest{
+					Name: "Fiction",
+				},
+			},
+			wantErr:   &entity.AlreadyExistsError{Err: entity.ErrGenreAlreadyExists, Id: uuid2},
+			wantCode:  codes.AlreadyExists,
+			mocksUsed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			genreUseCase := mocks.NewMockGenreUseCase(ctrl)
+			service := controller.New(logger, nil, nil, genreUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				var genre *entity.Genre
+				if test.wantErr == nil {
+					genre = &entity.Genre{
+						Id:       uuid3,
+						Name:     test.args.req.GetName(),
+						ParentId: test.args.req.ParentId,
+					}
+				}
+
+				genreUseCase.
+					EXPECT().
+					CreateGenre(gomock.Any(), &entity.Genre{
+						Name:     test.args.req.GetName(),
+						ParentId: test.args.req.ParentId,
+					}).
+					Return(genre, test.wantErr)
+			}
+
+			got, err := service.CreateGenre(test.args.ctx, test.args.req)
+
+			if test.wantErr == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, uuid3, got.GetGenre().GetId())
+				assert.Equal(t, test.args.req.GetName(), got.GetGenre().GetName())
+				assert.Equal(t, test.args.req.ParentId, got.GetGenre().ParentId)
+			} else {
+				assert.Error(t, err)
+			}
+			assert.Equal(t, test.wantCode, status.Code(err))
+		})
+	}
+}
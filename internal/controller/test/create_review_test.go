@@ -0,0 +1,202 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_CreateReview(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.CreateReviewRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "create review | valid request",
+			args: args{
+				ctx,
+				&library.CreateReviewRequest{
+					BookId:   uuid1,
+					PatronId: uuid2,
+					Rating:   5,
+					Text:     "Great book",
+				},
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "create review | rating only",
+			args: args{
+				ctx,
+				&library.CreateReviewRequest{
+					BookId:   uuid1,
+					PatronId: uuid2,
+					Rating:   1,
+				},
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "create review | already reviewed",
+			args: args{
+				ctx,
+				&library.CreateReviewRequest{
+					BookId:   uuid1,
+					PatronId: uuid2,
+					Rating:   3,
+				},
+			},
+			wantErr:   &entity.AlreadyExistsError{Err: entity.ErrReviewAlreadyExists, Id: uuid3},
+			wantCode:  codes.AlreadyExists,
+			mocksUsed: true,
+		},
+		{
+			name: "create review | book not found",
+			args: args{
+				ctx,
+				&library.CreateReviewRequest{
+					BookId:   uuid1,
+					PatronId: uuid2,
+					Rating:   3,
+				},
+			},
+			wantErr:   entity.ErrBookNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "create review | rating too low",
+			args: args{
+				ctx,
+				&library.CreateReviewRequest{
+					BookId:   uuid1,
+					PatronId: uuid2,
+					Rating:   0,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "create review | rating too high",
+			args: args{
+				ctx,
+				&library.CreateReviewRequest{
+					BookId:   uuid1,
+					PatronId: uuid2,
+					Rating:   6,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "create review | text too long",
+			args: args{
+				ctx,
+				&library.CreateReviewRequest{
+					BookId:   uuid1,
+					PatronId: uuid2,
+					Rating:   4,
+					Text:     strings.Repeat("a", 10001),
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "create review | invalid patron uuid",
+			args: args{
+				ctx,
+				&library.CreateReviewRequest{
+					BookId:   uuid1,
+					PatronId: "aboba",
+					Rating:   4,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			reviewUseCase := mocks.NewMockReviewUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, reviewUseCase, nil)
+
+			if test.mocksUsed {
+				var review *entity.Review
+				if test.wantErr == nil {
+					review = &entity.Review{
+						Id:        uuid.NewString(),
+						BookId:    test.args.req.GetBookId(),
+						PatronId:  test.args.req.GetPatronId(),
+						Rating:    test.args.req.GetRating(),
+						Text:      test.args.req.GetText(),
+						CreatedAt: time.Now(),
+						UpdatedAt: time.Now(),
+					}
+				}
+
+				reviewUseCase.
+					EXPECT().
+					CreateReview(gomock.Any(), &entity.Review{
+						BookId:   test.args.req.GetBookId(),
+						PatronId: test.args.req.GetPatronId(),
+						Rating:   test.args.req.GetRating(),
+						Text:     test.args.req.GetText(),
+					}).
+					Return(review, test.wantErr)
+			}
+
+			got, err := service.CreateReview(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.args.req.GetBookId(), got.GetReview().GetBookId())
+			assert.Equal(t, test.args.req.GetPatronId(), got.GetReview().GetPatronId())
+			assert.Equal(t, test.args.req.GetRating(), got.GetReview().GetRating())
+			assert.Equal(t, test.args.req.GetText(), got.GetReview().GetText())
+		})
+	}
+}
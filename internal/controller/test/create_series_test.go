@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_CreateSeries(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.CreateSeriesRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "create series | valid request",
+			args: args{
+				ctx,
+				&library.CreateSeriesRequest{
+					Name: "The Lord of the Rings",
+				},
+			},
+			wantErr:   nil,
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "create series | empty name",
+			args: args{
+				ctx,
+				&library.CreateSeriesRequest{
+					Name: "",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "create series | internal error",
+			args: args{
+				ctx,
+				&library.CreateSeriesRequest{
+					Name: "The Lord of the Rings",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.Internal,
+			mocksUsed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			seriesUseCase := mocks.NewMockSeriesUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, seriesUseCase, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				var series *entity.Series
+				if test.wantErr == nil {
+					series = &entity.Series{Id: uuid3, Name: test.args.req.GetName()}
+				}
+
+				seriesUseCase.
+					EXPECT().
+					CreateSeries(gomock.Any(), test.args.req.GetName()).
+					Return(series, test.wantErr)
+			}
+
+			got, err := service.CreateSeries(test.args.ctx, test.args.req)
+
+			if test.wantErr == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, uuid3, got.GetSeries().GetId())
+				assert.Equal(t, test.args.req.GetName(), got.GetSeries().GetName())
+			} else {
+				assert.Error(t, err)
+			}
+			assert.Equal(t, test.wantCode, status.Code(err))
+		})
+	}
+}
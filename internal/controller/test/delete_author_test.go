@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_DeleteAuthor(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.DeleteAuthorRequest
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		wantPolicy entity.DeleteAuthorPolicy
+		removal    *entity.AuthorRemoval
+		wantErr    error
+		wantCode   codes.Code
+		mocksUsed  bool
+	}{
+		{
+			name: "delete author | unspecified policy is restrict",
+			args: args{
+				ctx,
+				&library.DeleteAuthorRequest{
+					Id: uuid1,
+				},
+			},
+			wantPolicy: entity.DeleteAuthorPolicyRestrict,
+			removal:    &entity.AuthorRemoval{Author: &entity.Author{Id: uuid1}},
+			wantCode:   codes.OK,
+			mocksUsed:  true,
+		},
+		{
+			name: "delete author | cascade",
+			args: args{
+				ctx,
+				&library.DeleteAuthorRequest{
+					Id:     uuid1,
+					Policy: library.DeleteAuthorPolicy_DELETE_AUTHOR_POLICY_CASCADE,
+				},
+			},
+			wantPolicy: entity.DeleteAuthorPolicyCascade,
+			removal: &entity.AuthorRemoval{
+				Author:        &entity.Author{Id: uuid1},
+				DetachedBooks: []*entity.Book{{Id: uuid2}},
+				DeletedBooks:  []*entity.Book{{Id: uuid3}},
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "delete author | restrict with books",
+			args: args{
+				ctx,
+				&library.DeleteAuthorRequest{
+					Id:     uuid1,
+					Policy: library.DeleteAuthorPolicy_DELETE_AUTHOR_POLICY_RESTRICT,
+				},
+			},
+			wantPolicy: entity.DeleteAuthorPolicyRestrict,
+			wantErr:    entity.ErrAuthorHasBooks,
+			wantCode:   codes.FailedPrecondition,
+			mocksUsed:  true,
+		},
+		{
+			name: "delete author | unknown policy",
+			args: args{
+				ctx,
+				&library.DeleteAuthorRequest{
+					Id:     uuid1,
+					Policy: 42,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "delete author | invalid uuid",
+			args: args{
+				ctx,
+				&library.DeleteAuthorRequest{
+					Id: "author",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
+			service := controller.New(logger, bookUseCase, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				authorUseCase.
+					EXPECT().
+					DeleteAuthor(gomock.Any(), test.args.req.GetId(), test.wantPolicy).
+					Return(test.removal, test.wantErr)
+			}
+
+			got, err := service.DeleteAuthor(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, got.GetDetachedBookIds(), len(test.removal.DetachedBooks))
+			assert.Len(t, got.GetDeletedBookIds(), len(test.removal.DeletedBooks))
+		})
+	}
+}
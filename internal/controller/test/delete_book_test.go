@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_DeleteBook(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.DeleteBookRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "delete book | valid request",
+			args: args{
+				ctx,
+				&library.DeleteBookRequest{
+					Id: uuid3,
+				},
+			},
+			wantErr:   nil,
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "delete book | invalid uuid",
+			args: args{
+				ctx,
+				&library.DeleteBookRequest{
+					Id: "aboba",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "delete book | book not found",
+			args: args{
+				ctx,
+				&library.DeleteBookRequest{
+					Id: uuid3,
+				},
+			},
+			wantErr:   entity.ErrBookNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
+			service := controller.New(logger, bookUseCase, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				bookUseCase.
+					EXPECT().
+					DeleteBook(gomock.Any(), test.args.req.GetId()).
+					Return(test.wantErr)
+			}
+
+			got, err := service.DeleteBook(test.args.ctx, test.args.req)
+
+			if test.wantErr == nil {
+				assert.NotNil(t, got)
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+			assert.Equal(t, test.wantCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_DeletePatron(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.DeletePatronRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "delete patron | valid request",
+			args: args{
+				ctx,
+				&library.DeletePatronRequest{
+					Id: uuid5,
+				},
+			},
+			wantErr:   nil,
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "delete patron | invalid uuid",
+			args: args{
+				ctx,
+				&library.DeletePatronRequest{
+					Id: "aboba",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "delete patron | patron not found",
+			args: args{
+				ctx,
+				&library.DeletePatronRequest{
+					Id: uuid5,
+				},
+			},
+			wantErr:   entity.ErrPatronNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			patronUseCase := mocks.NewMockPatronUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, patronUseCase, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				patronUseCase.
+					EXPECT().
+					DeletePatron(gomock.Any(), test.args.req.GetId()).
+					Return(test.wantErr)
+			}
+
+			got, err := service.DeletePatron(test.args.ctx, test.args.req)
+
+			if test.wantErr == nil {
+				assert.NoError(t, err)
+				assert.NotNil(t, got)
+			} else {
+				assert.Error(t, err)
+			}
+			assert.Equal(t, test.wantCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_DeletePublisher(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.DeletePublisherRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "delete publisher | valid request",
+			args: args{
+				ctx,
+				&library.DeletePublisherRequest{
+					Id: uuid4,
+				},
+			},
+			wantErr:   nil,
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "delete publisher | invalid uuid",
+			args: args{
+				ctx,
+				&library.DeletePublisherRequest{
+					Id: "aboba",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "delete publisher | publisher not found",
+			args: args{
+				ctx,
+				&library.DeletePublisherRequest{
+					Id: uuid4,
+				},
+			},
+			wantErr:   entity.ErrPublisherNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			publisherUseCase := mocks.NewMockPublisherUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, publisherUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				publisherUseCase.
+					EXPECT().
+					DeletePublisher(gomock.Any(), test.args.req.GetId()).
+					Return(test.wantErr)
+			}
+
+			got, err := service.DeletePublisher(test.args.ctx, test.args.req)
+
+			if test.wantErr == nil {
+				assert.NoError(t, err)
+				assert.NotNil(t, got)
+			} else {
+				assert.Error(t, err)
+			}
+			assert.Equal(t, test.wantCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_DeleteReview(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.DeleteReviewRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "delete review | valid request",
+			args: args{
+				ctx,
+				&library.DeleteReviewRequest{
+					Id: uuid1,
+				},
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "delete review | not found",
+			args: args{
+				ctx,
+				&library.DeleteReviewRequest{
+					Id: uuid1,
+				},
+			},
+			wantErr:   entity.ErrReviewNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "delete review | invalid uuid",
+			args: args{
+				ctx,
+				&library.DeleteReviewRequest{
+					Id: "aboba",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			reviewUseCase := mocks.NewMockReviewUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, reviewUseCase, nil)
+
+			if test.mocksUsed {
+				reviewUseCase.
+					EXPECT().
+					DeleteReview(gomock.Any(), test.args.req.GetId()).
+					Return(test.wantErr)
+			}
+
+			_, err := service.DeleteReview(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
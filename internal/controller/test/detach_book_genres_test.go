@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_DetachBookGenres(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.DetachBookGenresRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "detach book genres | valid request",
+			args: args{
+				ctx,
+				&library.DetachBookGenresRequest{
+					BookId:   uuid1,
+					GenreIds: []string{uuid2},
+				},
+			},
+			wantErr:   nil,
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "detach book genres | invalid book id",
+			args: args{
+				ctx,
+				&library.DetachBookGenresRequest{
+					BookId:   "aboba",
+					GenreIds: []string{uuid2},
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "detach book genres | book not found",
+			args: args{
+				ctx,
+				&library.DetachBookGenresRequest{
+					BookId:   uuid1,
+					GenreIds: []string{uuid2},
+				},
+			},
+			wantErr:   entity.ErrBookNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			genreUseCase := mocks.NewMockGenreUseCase(ctrl)
+			service := controller.New(logger, nil, nil, genreUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				genreUseCase.
+					EXPECT().
+					DetachBookGenres(gomock.Any(), test.args.req.GetBookId(), test.args.req.GetGenreIds()).
+					Return(test.wantErr)
+			}
+
+			got, err := service.DetachBookGenres(test.args.ctx, test.args.req)
+
+			if test.wantErr == nil {
+				assert.NotNil(t, got)
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+			assert.Equal(t, test.wantCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// downloadStream запоминает отправленные сообщения
+type downloadStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*library.DownloadBookCoverResponse
+}
+
+func (s *downloadStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *downloadStream) Send(resp *library.DownloadBookCoverResponse) error {
+	// Контроллер переиспользует буфер части, поэтому ее нужно скопировать
+	if chunk := resp.GetChunk(); chunk != nil {
+		resp = &library.DownloadBookCoverResponse{
+			Data: &library.DownloadBookCoverResponse_Chunk{Chunk: bytes.Clone(chunk)},
+		}
+	}
+
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func Test_DownloadBookCover(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+
+	// Больше одной части потока
+	content := bytes.Repeat([]byte{7}, 100<<10)
+
+	tests := []struct {
+		name       string
+		req        *library.DownloadBookCoverRequest
+		wantChunks int
+		wantErr    error
+		wantCode   codes.Code
+		mocksUsed  bool
+	}{
+		{
+			name:       "download book cover | valid request",
+			req:        &library.DownloadBookCoverRequest{BookId: uuid1},
+			wantChunks: 2,
+			wantCode:   codes.OK,
+			mocksUsed:  true,
+		},
+		{
+			name:      "download book cover | not found",
+			req:       &library.DownloadBookCoverRequest{BookId: uuid1},
+			wantErr:   entity.ErrCoverNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name:      "download book cover | internal error",
+			req:       &library.DownloadBookCoverRequest{BookId: uuid1},
+			wantErr:   mockErr,
+			wantCode:  codes.Internal,
+			mocksUsed: true,
+		},
+		{
+			name:     "download book cover | invalid uuid",
+			req:      &library.DownloadBookCoverRequest{BookId: "aboba"},
+			wantErr:  mockErr,
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			coverUseCase := mocks.NewMockCoverUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, coverUseCase)
+
+			if test.mocksUsed {
+				var cover *entity.BookCover
+				var reader io.ReadCloser
+				if test.wantErr == nil {
+					cover = &entity.BookCover{BookId: uuid1, ContentType: "image/png", Size: int64(len(content))}
+					reader = io.NopCloser(bytes.NewReader(content))
+				}
+
+				coverUseCase.
+					EXPECT().
+					DownloadBookCover(gomock.Any(), test.req.GetBookId()).
+					Return(cover, reader, test.wantErr)
+			}
+
+			stream := &downloadStream{ctx: t.Context()}
+			err := service.DownloadBookCover(test.req, stream)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				assert.Empty(t, stream.responses)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, stream.responses, 1+test.wantChunks)
+			assert.Equal(t, uuid1, stream.responses[0].GetInfo().GetBookId())
+
+			var received []byte
+			for _, resp := range stream.responses[1:] {
+				received = append(received, resp.GetChunk()...)
+			}
+
+			assert.Equal(t, content, received)
+		})
+	}
+}
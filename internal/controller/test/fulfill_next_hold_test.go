@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_FulfillNextHold(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.FulfillNextHoldRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "fulfill next hold | valid request",
+			args: args{
+				ctx,
+				&library.FulfillNextHoldRequest{
+					BookId: uuid1,
+				},
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "fulfill next hold | empty queue",
+			args: args{
+				ctx,
+				&library.FulfillNextHoldRequest{
+					BookId: uuid1,
+				},
+			},
+			wantErr:   entity.ErrNoWaitingHolds,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "fulfill next hold | invalid uuid",
+			args: args{
+				ctx,
+				&library.FulfillNextHoldRequest{
+					BookId: "aboba",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			holdUseCase := mocks.NewMockHoldUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, holdUseCase, nil, nil, nil)
+
+			if test.mocksUsed {
+				var hold *entity.Hold
+				if test.wantErr == nil {
+					readyAt := time.Now()
+					expiresAt := readyAt.AddDate(0, 0, 3)
+					hold = &entity.Hold{
+						Id:        uuid2,
+						BookId:    test.args.req.GetBookId(),
+						PatronId:  uuid3,
+						Status:    entity.HoldStatusReady,
+						PlacedAt:  time.Now(),
+						ReadyAt:   &readyAt,
+						ExpiresAt: &expiresAt,
+					}
+				}
+
+				holdUseCase.
+					EXPECT().
+					FulfillNextHold(gomock.Any(), test.args.req.GetBookId()).
+					Return(hold, test.wantErr)
+			}
+
+			got, err := service.FulfillNextHold(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, uuid2, got.GetHold().GetId())
+			assert.Equal(t, library.HoldStatus_HOLD_STATUS_READY, got.GetHold().GetStatus())
+			assert.True(t, got.GetHold().GetExpiresAt().AsTime().After(got.GetHold().GetReadyAt().AsTime()))
+		})
+	}
+}
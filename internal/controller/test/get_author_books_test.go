@@ -37,10 +37,12 @@ func (m *mockLibraryGetAuthorBooksServer) Context() context.Context {
 func Test_GetAuthorBooks(t *testing.T) {
 	t.Parallel()
 	logger, _ := zap.NewProduction()
+	translator := entity.ContributorRoleTranslator
 
 	tests := []struct {
 		name              string
 		req               *library.GetAuthorBooksRequest
+		wantRole          *entity.ContributorRole
 		wantUsecaseReturn []*entity.Book
 
 		wantErrCode codes.Code
@@ -80,6 +82,36 @@ func Test_GetAuthorBooks(t *testing.T) {
 			mocksUsed:         true,
 			server:            &mockLibraryGetAuthorBooksServer{},
 		},
+		{
+			name: "get author books | by role",
+			req: &library.GetAuthorBooksRequest{
+				AuthorId: uuid9,
+				Role:     library.ContributorRole_CONTRIBUTOR_ROLE_TRANSLATOR.Enum(),
+			},
+			wantRole: &translator,
+			wantUsecaseReturn: []*entity.Book{
+				{
+					Id:           uuid.NewString(),
+					Name:         "Aboba3",
+					AuthorIds:    []string{uuid10, uuid9},
+					Contributors: []entity.Contributor{{AuthorId: uuid10}, {AuthorId: uuid9, Role: translator}},
+				},
+			},
+			wantErrCode: codes.OK,
+			wantErr:     nil,
+			mocksUsed:   true,
+			server:      &mockLibraryGetAuthorBooksServer{},
+		},
+		{
+			name: "get author books | undefined role",
+			req: &library.GetAuthorBooksRequest{
+				AuthorId: uuid9,
+				Role:     library.ContributorRole(42).Enum(),
+			},
+			wantErrCode: codes.InvalidArgument,
+			mocksUsed:   false,
+			server:      &mockLibraryGetAuthorBooksServer{},
+		},
 		{
 			name: "get author books | uncorrected author id",
 			req: &library.GetAuthorBooksRequest{
@@ -102,11 +134,11 @@ func Test_GetAuthorBooks(t *testing.T) {
 
 			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
 			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
-			service := controller.New(logger, bookUseCase, authorUseCase)
+			service := controller.New(logger, bookUseCase, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			if test.mocksUsed {
 				bookUseCase.EXPECT().
-					GetAuthorBooks(gomock.Any(), test.req.GetAuthorId()).
+					GetAuthorBooks(gomock.Any(), test.req.GetAuthorId(), test.wantRole).
 					Return(test.wantUsecaseReturn, test.wantErr)
 			}
 
@@ -118,6 +150,7 @@ func Test_GetAuthorBooks(t *testing.T) {
 					assert.Equal(t, test.wantUsecaseReturn[idx].Id, book.GetId())
 					assert.Equal(t, test.wantUsecaseReturn[idx].Name, book.GetName())
 					assert.ElementsMatch(t, test.wantUsecaseReturn[idx].AuthorIds, book.GetAuthorIds())
+					assert.Len(t, book.GetContributors(), len(test.wantUsecaseReturn[idx].Contributors))
 				}
 			}
 		})
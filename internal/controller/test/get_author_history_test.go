@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	testutils "github.com/project/library/internal/usecase/library/test"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+type mockLibraryGetAuthorHistoryServer struct {
+	grpc.ServerStream
+	revisions []*library.AuthorRevision
+}
+
+func (m *mockLibraryGetAuthorHistoryServer) Send(revision *library.AuthorRevision) error {
+	m.revisions = append(m.revisions, revision)
+	return nil
+}
+
+func (m *mockLibraryGetAuthorHistoryServer) Context() context.Context {
+	return context.Background()
+}
+
+func Test_GetAuthorHistory(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	registered := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name              string
+		req               *library.GetAuthorHistoryRequest
+		wantUsecaseReturn []*entity.AuthorRevision
+		wantErr           error
+		wantErrCode       codes.Code
+		mocksUsed         bool
+	}{
+		{
+			name: "get author history | ok",
+			req:  &library.GetAuthorHistoryRequest{Id: uuid1},
+			wantUsecaseReturn: []*entity.AuthorRevision{
+				{
+					Author:    &entity.Author{Id: uuid1, Name: "Samuel Clemens"},
+					RevisedAt: registered,
+				},
+				{
+					Author: &entity.Author{
+						Id:        uuid1,
+						Name:      "Mark Twain",
+						Biography: proto.String("American writer"),
+						Aliases:   []string{"Samuel Clemens"},
+					},
+					RevisedAt: registered.Add(time.Hour),
+				},
+			},
+			wantErrCode: codes.OK,
+			mocksUsed:   true,
+		},
+		{
+			name:        "get author history | not found",
+			req:         &library.GetAuthorHistoryRequest{Id: uuid1},
+			wantErr:     entity.ErrAuthorNotFound,
+			wantErrCode: codes.NotFound,
+			mocksUsed:   true,
+		},
+		{
+			name:        "get author history | invalid uuid",
+			req:         &library.GetAuthorHistoryRequest{Id: "aboba"},
+			wantErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			service := controller.New(logger, nil, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				authorUseCase.EXPECT().
+					GetAuthorHistory(gomock.Any(), test.req.GetId()).
+					Return(test.wantUsecaseReturn, test.wantErr)
+			}
+
+			server := &mockLibraryGetAuthorHistoryServer{}
+			err := service.GetAuthorHistory(test.req, server)
+			testutils.CheckError(t, err, test.wantErrCode)
+
+			assert.Len(t, server.revisions, len(test.wantUsecaseReturn))
+			for idx, revision := range server.revisions {
+				want := test.wantUsecaseReturn[idx]
+				assert.Equal(t, want.Author.Name, revision.GetName())
+				assert.Equal(t, want.Author.Biography, revision.Biography)
+				assert.Equal(t, want.Author.Aliases, revision.GetAliases())
+				assert.Equal(t, want.RevisedAt, revision.GetRevisedAt().AsTime())
+			}
+		})
+	}
+}
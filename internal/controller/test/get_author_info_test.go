@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/project/library/generated/api/library"
@@ -12,6 +13,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func Test_GetAuthorInfo(t *testing.T) {
@@ -46,6 +49,50 @@ func Test_GetAuthorInfo(t *testing.T) {
 			wantErr:   nil,
 			mocksUsed: true,
 		},
+		{
+			name: "get author info | with profile",
+			args: args{
+				ctx,
+				&library.GetAuthorInfoRequest{
+					Id: uuid8,
+				},
+			},
+			want: &library.GetAuthorInfoResponse{
+				Id:        uuid8,
+				Name:      "Samuel Clemens",
+				CreatedAt: timestamppb.New(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+				UpdatedAt: timestamppb.New(time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC)),
+				Revision:  4,
+				Biography: proto.String("American writer"),
+				BirthDate: proto.String("1835-11-30"),
+				DeathDate: proto.String("1910-04-21"),
+				Aliases:   []string{"Mark Twain"},
+				ExternalIds: &library.AuthorExternalIds{
+					Wikidata: "Q7245",
+				},
+			},
+			wantErr:   nil,
+			mocksUsed: true,
+		},
+		{
+			name: "get author info | as of time",
+			args: args{
+				ctx,
+				&library.GetAuthorInfoRequest{
+					Id:   uuid8,
+					AsOf: timestamppb.New(time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+			want: &library.GetAuthorInfoResponse{
+				Id:        uuid8,
+				Name:      "Samuel Clemens",
+				CreatedAt: timestamppb.New(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+				UpdatedAt: timestamppb.New(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+				Revision:  1,
+			},
+			wantErr:   nil,
+			mocksUsed: true,
+		},
 		{
 			name: "get author info | invalid id",
 			args: args{
@@ -93,18 +140,39 @@ func Test_GetAuthorInfo(t *testing.T) {
 
 			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
 			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
-			service := controller.New(logger, bookUseCase, authorUseCase)
+			service := controller.New(logger, bookUseCase, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			if test.mocksUsed {
 				var auth *entity.Author
 				if test.want != nil {
-					auth = &entity.Author{Id: test.want.Id, Name: test.want.Name}
+					auth = &entity.Author{
+						Id:        test.want.GetId(),
+						Name:      test.want.GetName(),
+						CreatedAt: test.want.GetCreatedAt().AsTime(),
+						UpdatedAt: test.want.GetUpdatedAt().AsTime(),
+						Revision:  test.want.GetRevision(),
+						Biography: test.want.Biography,
+						BirthDate: test.want.BirthDate,
+						DeathDate: test.want.DeathDate,
+						Aliases:   test.want.GetAliases(),
+						ExternalIds: entity.AuthorExternalIds{
+							Viaf:     test.want.GetExternalIds().GetViaf(),
+							Wikidata: test.want.GetExternalIds().GetWikidata(),
+						},
+					}
 				}
 
-				authorUseCase.
-					EXPECT().
-					GetAuthorInfo(gomock.Any(), test.args.req.GetId()).
-					Return(auth, test.wantErr)
+				if test.args.req.AsOf != nil {
+					authorUseCase.
+						EXPECT().
+						GetAuthorInfoAsOf(gomock.Any(), test.args.req.GetId(), test.args.req.GetAsOf().AsTime()).
+						Return(auth, test.wantErr)
+				} else {
+					authorUseCase.
+						EXPECT().
+						GetAuthorInfo(gomock.Any(), test.args.req.GetId()).
+						Return(auth, test.wantErr)
+				}
 			}
 
 			got, err := service.GetAuthorInfo(test.args.ctx, test.args.req)
@@ -112,6 +180,13 @@ func Test_GetAuthorInfo(t *testing.T) {
 			if err == nil && test.want != nil {
 				assert.Equal(t, test.want.GetId(), got.GetId())
 				assert.Equal(t, test.want.GetName(), got.GetName())
+				assert.Equal(t, test.want.GetCreatedAt().AsTime(), got.GetCreatedAt().AsTime())
+				assert.Equal(t, test.want.GetUpdatedAt().AsTime(), got.GetUpdatedAt().AsTime())
+				assert.Equal(t, test.want.GetRevision(), got.GetRevision())
+				assert.Equal(t, test.want.BirthDate, got.BirthDate)
+				assert.Equal(t, test.want.DeathDate, got.DeathDate)
+				assert.Equal(t, test.want.GetAliases(), got.GetAliases())
+				assert.Equal(t, test.want.GetExternalIds().GetWikidata(), got.GetExternalIds().GetWikidata())
 			}
 
 			if test.wantErr == nil {
@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_GetBookByISBN(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.GetBookByISBNRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		book      *entity.Book
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "get book by isbn | valid request",
+			args: args{
+				ctx,
+				&library.GetBookByISBNRequest{Isbn: "978-0-306-40615-7"},
+			},
+			book: &entity.Book{
+				Id:        uuid1,
+				Name:      "book",
+				AuthorIds: []string{uuid2},
+				ISBN:      proto.String("9780306406157"),
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "get book by isbn | not found",
+			args: args{
+				ctx,
+				&library.GetBookByISBNRequest{Isbn: "9780306406157"},
+			},
+			wantErr:   entity.ErrBookNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "get book by isbn | invalid checksum",
+			args: args{
+				ctx,
+				&library.GetBookByISBNRequest{Isbn: "9780306406158"},
+			},
+			wantErr:   entity.ErrInvalidISBN,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: true,
+		},
+		{
+			name: "get book by isbn | too short",
+			args: args{
+				ctx,
+				&library.GetBookByISBNRequest{Isbn: "978"},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
+			service := controller.New(logger, bookUseCase, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				bookUseCase.
+					EXPECT().
+					GetBookByISBN(gomock.Any(), test.args.req.GetIsbn()).
+					Return(test.book, test.wantErr)
+			}
+
+			got, err := service.GetBookByISBN(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.book.Id, got.GetBook().GetId())
+			assert.Equal(t, test.book.ISBN, got.GetBook().Isbn)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	testutils "github.com/project/library/internal/usecase/library/test"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+type mockLibraryGetBookHistoryServer struct {
+	grpc.ServerStream
+	revisions []*library.BookRevision
+}
+
+func (m *mockLibraryGetBookHistoryServer) Send(revision *library.BookRevision) error {
+	m.revisions = append(m.revisions, revision)
+	return nil
+}
+
+func (m *mockLibraryGetBookHistoryServer) Context() context.Context {
+	return context.Background()
+}
+
+func Test_GetBookHistory(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	deleted := created.Add(48 * time.Hour)
+
+	tests := []struct {
+		name              string
+		req               *library.GetBookHistoryRequest
+		wantUsecaseReturn []*entity.BookRevision
+		wantErr           error
+		wantErrCode       codes.Code
+		mocksUsed         bool
+	}{
+		{
+			name: "get book history | ok",
+			req:  &library.GetBookHistoryRequest{Id: uuid1},
+			wantUsecaseReturn: []*entity.BookRevision{
+				{
+					Book:      &entity.Book{Id: uuid1, Name: "Old Name", AuthorIds: []string{uuid2}},
+					RevisedAt: created,
+				},
+				{
+					Book:      &entity.Book{Id: uuid1, Name: "New Name", AuthorIds: []string{uuid2, uuid3}},
+					RevisedAt: created.Add(time.Hour),
+				},
+				{
+					Book:      &entity.Book{Id: uuid1, Name: "New Name", AuthorIds: []string{uuid2, uuid3}, DeletedAt: &deleted},
+					RevisedAt: deleted,
+				},
+			},
+			wantErrCode: codes.OK,
+			mocksUsed:   true,
+		},
+		{
+			name:        "get book history | not found",
+			req:         &library.GetBookHistoryRequest{Id: uuid1},
+			wantErr:     entity.ErrBookNotFound,
+			wantErrCode: codes.NotFound,
+			mocksUsed:   true,
+		},
+		{
+			name:        "get book history | internal error",
+			req:         &library.GetBookHistoryRequest{Id: uuid1},
+			wantErr:     mockErr,
+			wantErrCode: codes.Internal,
+			mocksUsed:   true,
+		},
+		{
+			name:        "get book history | invalid uuid",
+			req:         &library.GetBookHistoryRequest{Id: "aboba"},
+			wantErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
+			service := controller.New(logger, bookUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				bookUseCase.EXPECT().
+					GetBookHistory(gomock.Any(), test.req.GetId()).
+					Return(test.wantUsecaseReturn, test.wantErr)
+			}
+
+			server := &mockLibraryGetBookHistoryServer{}
+			err := service.GetBookHistory(test.req, server)
+			testutils.CheckError(t, err, test.wantErrCode)
+
+			assert.Len(t, server.revisions, len(test.wantUsecaseReturn))
+			for idx, revision := range server.revisions {
+				want := test.wantUsecaseReturn[idx]
+				assert.Equal(t, want.Book.Name, revision.GetBook().GetName())
+				assert.Equal(t, want.Book.AuthorIds, revision.GetBook().GetAuthorIds())
+				assert.Equal(t, want.Book.DeletedAt != nil, revision.GetDeleted())
+				assert.Equal(t, want.RevisedAt, revision.GetRevisedAt().AsTime())
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/project/library/generated/api/library"
@@ -12,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func Test_GetBookInfo(t *testing.T) {
@@ -45,10 +47,66 @@ func Test_GetBookInfo(t *testing.T) {
 					Name:      "Test Book",
 					AuthorIds: []string{uuid.NewString(), uuid.NewString()},
 				},
+				Availability: &library.CopyAvailability{Total: 3, Available: 2},
+				Rating:       &library.BookRating{ReviewCount: 2, AverageRating: 4.5},
 			},
 			wantErr:   nil,
 			mocksUsed: true,
 		},
+		{
+			name: "get book info | with genres and series",
+			args: args{
+				ctx,
+				&library.GetBookInfoRequest{
+					Id: uuid6,
+				},
+			},
+			want: &library.GetBookInfoResponse{
+				Book: &library.Book{
+					Id:        uuid6,
+					Name:      "The Two Towers",
+					AuthorIds: []string{uuid.NewString()},
+					GenreIds:  []string{uuid7},
+					Series: []*library.BookSeries{
+						{SeriesId: uuid8, Volume: 2},
+					},
+				},
+			},
+			wantErr:   nil,
+			mocksUsed: true,
+		},
+		{
+			name: "get book info | as of time",
+			args: args{
+				ctx,
+				&library.GetBookInfoRequest{
+					Id:   uuid6,
+					AsOf: timestamppb.New(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)),
+				},
+			},
+			want: &library.GetBookInfoResponse{
+				Book: &library.Book{
+					Id:        uuid6,
+					Name:      "Old Name",
+					AuthorIds: []string{uuid7},
+				},
+			},
+			wantErr:   nil,
+			mocksUsed: true,
+		},
+		{
+			name: "get book info | not found as of time",
+			args: args{
+				ctx,
+				&library.GetBookInfoRequest{
+					Id:   uuid6,
+					AsOf: timestamppb.New(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+			want:      nil,
+			wantErr:   entity.ErrBookNotFound,
+			mocksUsed: true,
+		},
 		{
 			name: "get book info | invalid uuid",
 			args: args{
@@ -96,18 +154,37 @@ func Test_GetBookInfo(t *testing.T) {
 
 			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
 			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
-			service := controller.New(logger, bookUseCase, authorUseCase)
+			service := controller.New(logger, bookUseCase, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			if test.mocksUsed {
 				var book *entity.Book
 				if test.want != nil {
-					book = ProtoToBook(test.want.Book)
+					book = ProtoToBook(t, test.want.Book)
+					if availability := test.want.GetAvailability(); availability != nil {
+						book.Availability = &entity.CopyAvailability{
+							Total:     availability.GetTotal(),
+							Available: availability.GetAvailable(),
+						}
+					}
+					if rating := test.want.GetRating(); rating != nil {
+						book.Rating = &entity.BookRating{
+							ReviewCount: rating.GetReviewCount(),
+							RatingSum:   int64(rating.GetAverageRating() * float64(rating.GetReviewCount())),
+						}
+					}
 				}
 
-				bookUseCase.
-					EXPECT().
-					GetBook(gomock.Any(), test.args.req.GetId()).
-					Return(book, test.wantErr)
+				if test.args.req.AsOf != nil {
+					bookUseCase.
+						EXPECT().
+						GetBookAsOf(gomock.Any(), test.args.req.GetId(), test.args.req.GetAsOf().AsTime()).
+						Return(book, test.wantErr)
+				} else {
+					bookUseCase.
+						EXPECT().
+						GetBook(gomock.Any(), test.args.req.GetId()).
+						Return(book, test.wantErr)
+				}
 			}
 
 			got, err := service.GetBookInfo(test.args.ctx, test.args.req)
@@ -116,6 +193,16 @@ func Test_GetBookInfo(t *testing.T) {
 				assert.Equal(t, test.want.Book.Id, got.GetBook().GetId())
 				assert.Equal(t, test.want.Book.Name, got.GetBook().GetName())
 				assert.Equal(t, test.want.Book.AuthorIds, got.GetBook().GetAuthorIds())
+				assert.Equal(t, test.want.Book.GetGenreIds(), got.GetBook().GetGenreIds())
+				assert.Equal(t, test.want.GetAvailability().GetTotal(), got.GetAvailability().GetTotal())
+				assert.Equal(t, test.want.GetAvailability().GetAvailable(), got.GetAvailability().GetAvailable())
+				assert.Equal(t, test.want.GetRating().GetReviewCount(), got.GetRating().GetReviewCount())
+				assert.InDelta(t, test.want.GetRating().GetAverageRating(), got.GetRating().GetAverageRating(), 1e-9)
+				assert.Len(t, got.GetBook().GetSeries(), len(test.want.Book.GetSeries()))
+				for j, series := range test.want.Book.GetSeries() {
+					assert.Equal(t, series.GetSeriesId(), got.GetBook().GetSeries()[j].GetSeriesId())
+					assert.Equal(t, series.GetVolume(), got.GetBook().GetSeries()[j].GetVolume())
+				}
 			}
 
 			if test.wantErr == nil {
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_GetPatron(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+	phone := "+79991234567"
+
+	type args struct {
+		ctx context.Context
+		req *library.GetPatronRequest
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		wantPatron *entity.Patron
+		wantErr    error
+		wantCode   codes.Code
+		mocksUsed  bool
+	}{
+		{
+			name: "get patron | valid request",
+			args: args{
+				ctx,
+				&library.GetPatronRequest{
+					Id: uuid1,
+				},
+			},
+			wantPatron: &entity.Patron{
+				Id:         uuid1,
+				CardNumber: "CARD-0001",
+				Name:       "Anna",
+				Phone:      &phone,
+				Status:     entity.PatronStatusSuspended,
+				ExpiresOn:  "2027-01-01",
+				CreatedAt:  time.Now(),
+				UpdatedAt:  time.Now(),
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "get patron | not found",
+			args: args{
+				ctx,
+				&library.GetPatronRequest{
+					Id: uuid1,
+				},
+			},
+			wantErr:   entity.ErrPatronNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "get patron | invalid uuid",
+			args: args{
+				ctx,
+				&library.GetPatronRequest{
+					Id: "aboba",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			patronUseCase := mocks.NewMockPatronUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, patronUseCase, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				patronUseCase.
+					EXPECT().
+					GetPatron(gomock.Any(), test.args.req.GetId()).
+					Return(test.wantPatron, test.wantErr)
+			}
+
+			got, err := service.GetPatron(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantPatron.Id, got.GetPatron().GetId())
+			assert.Equal(t, test.wantPatron.CardNumber, got.GetPatron().GetCardNumber())
+			assert.Equal(t, test.wantPatron.Phone, got.GetPatron().Phone)
+			assert.Nil(t, got.GetPatron().Email)
+			assert.Equal(t, library.PatronStatus_PATRON_STATUS_SUSPENDED, got.GetPatron().GetStatus())
+			assert.Equal(t, test.wantPatron.ExpiresOn, got.GetPatron().GetExpiresOn())
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	testutils "github.com/project/library/internal/usecase/library/test"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Специальные заглушки для потока сообщений
+type mockLibraryGetPublisherBooksServer struct {
+	grpc.ServerStream
+	books []*library.Book
+}
+
+func (m *mockLibraryGetPublisherBooksServer) Send(book *library.Book) error {
+	m.books = append(m.books, book)
+	return nil
+}
+
+func (m *mockLibraryGetPublisherBooksServer) Context() context.Context {
+	return context.Background()
+}
+
+func Test_GetPublisherBooks(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	publisherId := uuid9
+
+	tests := []struct {
+		name              string
+		req               *library.GetPublisherBooksRequest
+		wantUsecaseReturn []*entity.Book
+
+		wantErrCode codes.Code
+		wantErr     error
+		mocksUsed   bool
+		server      *mockLibraryGetPublisherBooksServer
+	}{
+		{
+			name: "get publisher books | ok",
+			req: &library.GetPublisherBooksRequest{
+				PublisherId: publisherId,
+			},
+			wantUsecaseReturn: []*entity.Book{
+				{
+					Id:          uuid.NewString(),
+					Name:        "Aboba1",
+					AuthorIds:   []string{uuid9, uuid10},
+					PublisherId: &publisherId,
+				}, {
+					Id:          uuid.NewString(),
+					Name:        "Aboba2",
+					AuthorIds:   []string{uuid9},
+					PublisherId: &publisherId,
+				},
+			},
+			wantErrCode: codes.OK,
+			wantErr:     nil,
+			mocksUsed:   true,
+			server:      &mockLibraryGetPublisherBooksServer{},
+		},
+		{
+			name: "get publisher books | publisher books not found(without error)",
+			req: &library.GetPublisherBooksRequest{
+				PublisherId: uuid8,
+			},
+			wantUsecaseReturn: []*entity.Book{},
+			wantErrCode:       codes.OK,
+			wantErr:           nil,
+			mocksUsed:         true,
+			server:            &mockLibraryGetPublisherBooksServer{},
+		},
+		{
+			name: "get publisher books | uncorrected publisher id",
+			req: &library.GetPublisherBooksRequest{
+				PublisherId: "Aboba",
+			},
+			wantUsecaseReturn: []*entity.Book{},
+			wantErrCode:       codes.InvalidArgument,
+			wantErr:           status.Error(codes.InvalidArgument, "uncorrected publisher id"),
+			mocksUsed:         false,
+			server:            &mockLibraryGetPublisherBooksServer{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
+			service := controller.New(logger, bookUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				bookUseCase.EXPECT().
+					GetPublisherBooks(gomock.Any(), test.req.GetPublisherId()).
+					Return(test.wantUsecaseReturn, test.wantErr)
+			}
+
+			err := service.GetPublisherBooks(test.req, test.server)
+			testutils.CheckError(t, err, test.wantErrCode)
+
+			if test.mocksUsed && test.server.books != nil {
+				for idx, book := range test.server.books {
+					assert.Equal(t, test.wantUsecaseReturn[idx].Id, book.GetId())
+					assert.Equal(t, test.wantUsecaseReturn[idx].Name, book.GetName())
+					assert.ElementsMatch(t, test.wantUsecaseReturn[idx].AuthorIds, book.GetAuthorIds())
+					assert.Equal(t, test.wantUsecaseReturn[idx].PublisherId, book.PublisherId)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_GetPublisher(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.GetPublisherRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "get publisher | valid request",
+			args: args{
+				ctx,
+				&library.GetPublisherRequest{
+					Id: uuid4,
+				},
+			},
+			wantErr:   nil,
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "get publisher | invalid uuid",
+			args: args{
+				ctx,
+				&library.GetPublisherRequest{
+					Id: "aboba",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "get publisher | publisher not found",
+			args: args{
+				ctx,
+				&library.GetPublisherRequest{
+					Id: uuid4,
+				},
+			},
+			wantErr:   entity.ErrPublisherNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			publisherUseCase := mocks.NewMockPublisherUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, publisherUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				var publisher *entity.Publisher
+				if test.wantErr == nil {
+					publisher = &entity.Publisher{Id: test.args.req.GetId(), Name: "Penguin Books"}
+				}
+
+				publisherUseCase.
+					EXPECT().
+					GetPublisher(gomock.Any(), test.args.req.GetId()).
+					Return(publisher, test.wantErr)
+			}
+
+			got, err := service.GetPublisher(test.args.ctx, test.args.req)
+
+			if test.wantErr == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, test.args.req.GetId(), got.GetPublisher().GetId())
+				assert.Equal(t, "Penguin Books", got.GetPublisher().GetName())
+			} else {
+				assert.Error(t, err)
+			}
+			assert.Equal(t, test.wantCode, status.Code(err))
+		})
+	}
+}
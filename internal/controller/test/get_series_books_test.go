@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	testutils "github.com/project/library/internal/usecase/library/test"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Специальные заглушки для потока сообщений
+type mockLibraryGetSeriesBooksServer struct {
+	grpc.ServerStream
+	books []*library.Book
+}
+
+func (m *mockLibraryGetSeriesBooksServer) Send(book *library.Book) error {
+	m.books = append(m.books, book)
+	return nil
+}
+
+func (m *mockLibraryGetSeriesBooksServer) Context() context.Context {
+	return context.Background()
+}
+
+func Test_GetSeriesBooks(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	seriesId := uuid9
+
+	tests := []struct {
+		name              string
+		req               *library.GetSeriesBooksRequest
+		wantUsecaseReturn []*entity.Book
+
+		wantErrCode codes.Code
+		wantErr     error
+		mocksUsed   bool
+		server      *mockLibraryGetSeriesBooksServer
+	}{
+		{
+			name: "get series books | ok",
+			req: &library.GetSeriesBooksRequest{
+				SeriesId: seriesId,
+			},
+			wantUsecaseReturn: []*entity.Book{
+				{
+					Id:        uuid.NewString(),
+					Name:      "Aboba1",
+					AuthorIds: []string{uuid9, uuid10},
+					Series:    []entity.BookSeries{{SeriesId: seriesId, Volume: 1}},
+				}, {
+					Id:        uuid.NewString(),
+					Name:      "Aboba2",
+					AuthorIds: []string{uuid9},
+					Series:    []entity.BookSeries{{SeriesId: seriesId, Volume: 2}},
+				},
+			},
+			wantErrCode: codes.OK,
+			wantErr:     nil,
+			mocksUsed:   true,
+			server:      &mockLibraryGetSeriesBooksServer{},
+		},
+		{
+			name: "get series books | series books not found(without error)",
+			req: &library.GetSeriesBooksRequest{
+				SeriesId: uuid8,
+			},
+			wantUsecaseReturn: []*entity.Book{},
+			wantErrCode:       codes.OK,
+			wantErr:           nil,
+			mocksUsed:         true,
+			server:            &mockLibraryGetSeriesBooksServer{},
+		},
+		{
+			name: "get series books | uncorrected series id",
+			req: &library.GetSeriesBooksRequest{
+				SeriesId: "Aboba",
+			},
+			wantUsecaseReturn: []*entity.Book{},
+			wantErrCode:       codes.InvalidArgument,
+			wantErr:           status.Error(codes.InvalidArgument, "uncorrected series id"),
+			mocksUsed:         false,
+			server:            &mockLibraryGetSeriesBooksServer{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
+			service := controller.New(logger, bookUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				bookUseCase.EXPECT().
+					GetSeriesBooks(gomock.Any(), test.req.GetSeriesId()).
+					Return(test.wantUsecaseReturn, test.wantErr)
+			}
+
+			err := service.GetSeriesBooks(test.req, test.server)
+			testutils.CheckError(t, err, test.wantErrCode)
+
+			if test.mocksUsed && test.server.books != nil {
+				for idx, book := range test.server.books {
+					assert.Equal(t, test.wantUsecaseReturn[idx].Id, book.GetId())
+					assert.Equal(t, test.wantUsecaseReturn[idx].Name, book.GetName())
+					assert.ElementsMatch(t, test.wantUsecaseReturn[idx].AuthorIds, book.GetAuthorIds())
+					assert.Equal(t, test.wantUsecaseReturn[idx].Series[0].Volume, book.GetSeries()[0].GetVolume())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ListAuthors(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+	bookCount := int64(3)
+
+	type args struct {
+		ctx context.Context
+		req *library.ListAuthorsRequest
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		wantFilter entity.AuthorFilter
+		page       *entity.AuthorPage
+		wantErr    error
+		wantCode   codes.Code
+		mocksUsed  bool
+	}{
+		{
+			name: "list authors | with book count",
+			args: args{
+				ctx,
+				&library.ListAuthorsRequest{
+					NamePrefix:    "Tol",
+					WithBookCount: true,
+					PageSize:      1,
+				},
+			},
+			wantFilter: entity.AuthorFilter{
+				NamePrefix:    "Tol",
+				WithBookCount: true,
+				PageSize:      1,
+			},
+			page: &entity.AuthorPage{
+				Authors: []*entity.AuthorEntry{
+					{Author: &entity.Author{Id: uuid1, Name: "Tolstoy"}, BookCount: &bookCount},
+				},
+				NextPageToken: "next",
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "list authors | without book count",
+			args: args{
+				ctx,
+				&library.ListAuthorsRequest{},
+			},
+			wantFilter: entity.AuthorFilter{},
+			page: &entity.AuthorPage{
+				Authors: []*entity.AuthorEntry{
+					{Author: &entity.Author{Id: uuid2, Name: "Pushkin"}},
+				},
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "list authors | invalid page token",
+			args: args{
+				ctx,
+				&library.ListAuthorsRequest{
+					PageToken: "broken",
+				},
+			},
+			wantFilter: entity.AuthorFilter{PageToken: "broken"},
+			wantErr:    entity.ErrInvalidPageToken,
+			wantCode:   codes.InvalidArgument,
+			mocksUsed:  true,
+		},
+		{
+			name: "list authors | negative page size",
+			args: args{
+				ctx,
+				&library.ListAuthorsRequest{
+					PageSize: -1,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
+			service := controller.New(logger, bookUseCase, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				authorUseCase.
+					EXPECT().
+					ListAuthors(gomock.Any(), test.wantFilter).
+					Return(test.page, test.wantErr)
+			}
+
+			got, err := service.ListAuthors(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.page.NextPageToken, got.GetNextPageToken())
+			for j, entry := range test.page.Authors {
+				assert.Equal(t, entry.Author.Id, got.GetAuthors()[j].GetId())
+				assert.Equal(t, entry.Author.Name, got.GetAuthors()[j].GetName())
+				assert.Equal(t, entry.BookCount != nil, got.GetAuthors()[j].BookCount != nil)
+			}
+		})
+	}
+}
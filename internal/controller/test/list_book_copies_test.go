@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ListBookCopies(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.ListBookCopiesRequest
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		wantFilter entity.CopyFilter
+		copies     []*entity.Copy
+		wantErr    error
+		wantCode   codes.Code
+		mocksUsed  bool
+	}{
+		{
+			name: "list book copies | branch filter",
+			args: args{
+				ctx,
+				&library.ListBookCopiesRequest{
+					BookId: uuid1,
+					Branch: "central",
+				},
+			},
+			wantFilter: entity.CopyFilter{BookId: uuid1, Branch: "central"},
+			copies: []*entity.Copy{
+				{Id: uuid2, BookId: uuid1, Barcode: "0001", Branch: "central"},
+				{Id: uuid3, BookId: uuid1, Barcode: "0002", Branch: "central", Status: entity.CopyStatusLost},
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "list book copies | include retired",
+			args: args{
+				ctx,
+				&library.ListBookCopiesRequest{
+					BookId:         uuid1,
+					IncludeRetired: true,
+				},
+			},
+			wantFilter: entity.CopyFilter{BookId: uuid1, IncludeRetired: true},
+			copies:     []*entity.Copy{},
+			wantCode:   codes.OK,
+			mocksUsed:  true,
+		},
+		{
+			name: "list book copies | internal error",
+			args: args{
+				ctx,
+				&library.ListBookCopiesRequest{
+					BookId: uuid1,
+				},
+			},
+			wantFilter: entity.CopyFilter{BookId: uuid1},
+			wantErr:    errors.New("internal error"),
+			wantCode:   codes.Internal,
+			mocksUsed:  true,
+		},
+		{
+			name: "list book copies | invalid uuid",
+			args: args{
+				ctx,
+				&library.ListBookCopiesRequest{
+					BookId: "book",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			copyUseCase := mocks.NewMockCopyUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, copyUseCase, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				copyUseCase.
+					EXPECT().
+					ListBookCopies(gomock.Any(), test.wantFilter).
+					Return(test.copies, test.wantErr)
+			}
+
+			got, err := service.ListBookCopies(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, got.GetCopies(), len(test.copies))
+			for j, bookCopy := range test.copies {
+				assert.Equal(t, bookCopy.Id, got.GetCopies()[j].GetId())
+				assert.Equal(t, bookCopy.Barcode, got.GetCopies()[j].GetBarcode())
+			}
+		})
+	}
+}
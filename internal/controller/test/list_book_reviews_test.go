@@ -0,0 +1,138 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ListBookReviews(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.ListBookReviewsRequest
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		wantFilter entity.ReviewFilter
+		wantErr    error
+		wantCode   codes.Code
+		mocksUsed  bool
+	}{
+		{
+			name: "list book reviews | first page",
+			args: args{
+				ctx,
+				&library.ListBookReviewsRequest{
+					BookId:   uuid1,
+					PageSize: 2,
+				},
+			},
+			wantFilter: entity.ReviewFilter{BookId: uuid1, PageSize: 2},
+			wantCode:   codes.OK,
+			mocksUsed:  true,
+		},
+		{
+			name: "list book reviews | invalid page token",
+			args: args{
+				ctx,
+				&library.ListBookReviewsRequest{
+					BookId:    uuid1,
+					PageToken: "aboba",
+				},
+			},
+			wantFilter: entity.ReviewFilter{BookId: uuid1, PageToken: "aboba"},
+			wantErr:    entity.ErrInvalidPageToken,
+			wantCode:   codes.InvalidArgument,
+			mocksUsed:  true,
+		},
+		{
+			name: "list book reviews | page size too large",
+			args: args{
+				ctx,
+				&library.ListBookReviewsRequest{
+					BookId:   uuid1,
+					PageSize: 1001,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "list book reviews | invalid uuid",
+			args: args{
+				ctx,
+				&library.ListBookReviewsRequest{
+					BookId: "aboba",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			reviewUseCase := mocks.NewMockReviewUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, reviewUseCase, nil)
+
+			page := &entity.ReviewPage{
+				Reviews: []*entity.Review{
+					{Id: uuid2, BookId: uuid1, PatronId: uuid3, Rating: 5},
+					{Id: uuid4, BookId: uuid1, PatronId: uuid5, Rating: 3, Text: "Fine"},
+				},
+				NextPageToken: "next",
+			}
+
+			if test.mocksUsed {
+				var result *entity.ReviewPage
+				if test.wantErr == nil {
+					result = page
+				}
+
+				reviewUseCase.
+					EXPECT().
+					ListBookReviews(gomock.Any(), test.wantFilter).
+					Return(result, test.wantErr)
+			}
+
+			got, err := service.ListBookReviews(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, got.GetReviews(), len(page.Reviews))
+			for j, review := range page.Reviews {
+				assert.Equal(t, review.Id, got.GetReviews()[j].GetId())
+				assert.Equal(t, review.Rating, got.GetReviews()[j].GetRating())
+				assert.Equal(t, review.Text, got.GetReviews()[j].GetText())
+			}
+			assert.Equal(t, page.NextPageToken, got.GetNextPageToken())
+		})
+	}
+}
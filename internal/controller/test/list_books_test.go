@@ -0,0 +1,194 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_ListBooks(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+	createdAfter := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		ctx context.Context
+		req *library.ListBooksRequest
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		wantFilter entity.BookFilter
+		page       *entity.BookPage
+		wantErr    error
+		wantCode   codes.Code
+		mocksUsed  bool
+	}{
+		{
+			name: "list books | empty request",
+			args: args{
+				ctx,
+				&library.ListBooksRequest{},
+			},
+			wantFilter: entity.BookFilter{},
+			page: &entity.BookPage{
+				Books:         []*entity.Book{{Id: uuid1, Name: "First"}, {Id: uuid2, Name: "Second"}},
+				NextPageToken: "next",
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "list books | all filters",
+			args: args{
+				ctx,
+				&library.ListBooksRequest{
+					PageSize:     10,
+					PageToken:    "token",
+					NamePrefix:   "Wa",
+					AuthorIds:    []string{uuid3},
+					CreatedAfter: timestamppb.New(createdAfter),
+					OrderBy:      library.BookOrder_BOOK_ORDER_NAME_DESC,
+				},
+			},
+			wantFilter: entity.BookFilter{
+				NamePrefix:   "Wa",
+				AuthorIds:    []string{uuid3},
+				CreatedAfter: &createdAfter,
+				OrderBy:      entity.BookOrderNameDesc,
+				PageSize:     10,
+				PageToken:    "token",
+			},
+			page:      &entity.BookPage{Books: []*entity.Book{}},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "list books | year range and language",
+			args: args{
+				ctx,
+				&library.ListBooksRequest{
+					YearFrom: proto.Int32(1900),
+					YearTo:   proto.Int32(1950),
+					Language: "ru",
+				},
+			},
+			wantFilter: entity.BookFilter{
+				YearFrom: proto.Int32(1900),
+				YearTo:   proto.Int32(1950),
+				Language: "ru",
+			},
+			page:      &entity.BookPage{Books: []*entity.Book{}},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "list books | invalid language",
+			args: args{
+				ctx,
+				&library.ListBooksRequest{
+					Language: "not a language",
+				},
+			},
+			wantFilter: entity.BookFilter{Language: "not a language"},
+			wantErr:    entity.ErrInvalidLanguage,
+			wantCode:   codes.InvalidArgument,
+			mocksUsed:  true,
+		},
+		{
+			name: "list books | year out of range",
+			args: args{
+				ctx,
+				&library.ListBooksRequest{
+					YearFrom: proto.Int32(10000),
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "list books | invalid page token",
+			args: args{
+				ctx,
+				&library.ListBooksRequest{
+					PageToken: "broken",
+				},
+			},
+			wantFilter: entity.BookFilter{PageToken: "broken"},
+			wantErr:    entity.ErrInvalidPageToken,
+			wantCode:   codes.InvalidArgument,
+			mocksUsed:  true,
+		},
+		{
+			name: "list books | page size too large",
+			args: args{
+				ctx,
+				&library.ListBooksRequest{
+					PageSize: 100000,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "list books | invalid author id",
+			args: args{
+				ctx,
+				&library.ListBooksRequest{
+					AuthorIds: []string{"author"},
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
+			service := controller.New(logger, bookUseCase, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				bookUseCase.
+					EXPECT().
+					ListBooks(gomock.Any(), test.wantFilter).
+					Return(test.page, test.wantErr)
+			}
+
+			got, err := service.ListBooks(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, got.GetBooks(), len(test.page.Books))
+			assert.Equal(t, test.page.NextPageToken, got.GetNextPageToken())
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ListCharges(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+	settledAt := time.Now()
+	reason := "first offence"
+
+	charges := []*entity.Charge{
+		{Id: uuid3, PatronId: uuid1, BookId: uuid4, Amount: 250, CreatedAt: time.Now()},
+		{Id: uuid5, PatronId: uuid1, BookId: uuid6, Amount: 1000, Status: entity.ChargeStatusWaived,
+			WaiverReason: &reason, SettledAt: &settledAt, CreatedAt: time.Now()},
+	}
+
+	type args struct {
+		ctx context.Context
+		req *library.ListChargesRequest
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		wantFilter  entity.ChargeFilter
+		wantCharges []*entity.Charge
+		wantErr     error
+		wantCode    codes.Code
+		mocksUsed   bool
+	}{
+		{
+			name: "list charges | with settled",
+			args: args{
+				ctx,
+				&library.ListChargesRequest{
+					PatronId:       uuid1,
+					IncludeSettled: true,
+				},
+			},
+			wantFilter:  entity.ChargeFilter{PatronId: uuid1, IncludeSettled: true},
+			wantCharges: charges,
+			wantCode:    codes.OK,
+			mocksUsed:   true,
+		},
+		{
+			name: "list charges | by book",
+			args: args{
+				ctx,
+				&library.ListChargesRequest{
+					PatronId: uuid1,
+					BookId:   uuid2,
+				},
+			},
+			wantFilter:  entity.ChargeFilter{PatronId: uuid1, BookId: uuid2},
+			wantCharges: []*entity.Charge{},
+			wantCode:    codes.OK,
+			mocksUsed:   true,
+		},
+		{
+			name: "list charges | invalid book id",
+			args: args{
+				ctx,
+				&library.ListChargesRequest{
+					PatronId: uuid1,
+					BookId:   "aboba",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chargeUseCase := mocks.NewMockChargeUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, chargeUseCase, nil, nil)
+
+			if test.mocksUsed {
+				chargeUseCase.
+					EXPECT().
+					ListCharges(gomock.Any(), test.wantFilter).
+					Return(test.wantCharges, test.wantErr)
+			}
+
+			got, err := service.ListCharges(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, got.GetCharges(), len(test.wantCharges))
+			for j, charge := range test.wantCharges {
+				assert.Equal(t, charge.Id, got.GetCharges()[j].GetId())
+				assert.Equal(t, charge.Amount.String(), got.GetCharges()[j].GetAmount())
+				assert.Equal(t, charge.SettledAt != nil, got.GetCharges()[j].GetSettledAt() != nil)
+			}
+		})
+	}
+}
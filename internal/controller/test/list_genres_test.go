@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ListGenres(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+	parentId := uuid1
+	invalidId := "aboba"
+
+	type args struct {
+		ctx context.Context
+		req *library.ListGenresRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		genres    []*entity.Genre
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "list genres | all genres",
+			args: args{
+				ctx,
+				&library.ListGenresRequest{},
+			},
+			genres: []*entity.Genre{
+				{Id: uuid1, Name: "Fiction"},
+				{Id: uuid2, Name: "Science Fiction", ParentId: &parentId},
+			},
+			wantErr:   nil,
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "list genres | children of parent",
+			args: args{
+				ctx,
+				&library.ListGenresRequest{
+					ParentId: &parentId,
+				},
+			},
+			genres: []*entity.Genre{
+				{Id: uuid2, Name: "Science Fiction", ParentId: &parentId},
+			},
+			wantErr:   nil,
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "list genres | invalid parent id",
+			args: args{
+				ctx,
+				&library.ListGenresRequest{
+					ParentId: &invalidId,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "list genres | internal error",
+			args: args{
+				ctx,
+				&library.ListGenresRequest{},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.Internal,
+			mocksUsed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			genreUseCase := mocks.NewMockGenreUseCase(ctrl)
+			service := controller.New(logger, nil, nil, genreUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				genreUseCase.
+					EXPECT().
+					ListGenres(gomock.Any(), entity.GenreFilter{ParentId: test.args.req.ParentId}).
+					Return(test.genres, test.wantErr)
+			}
+
+			got, err := service.ListGenres(test.args.ctx, test.args.req)
+
+			if test.wantErr == nil {
+				assert.NoError(t, err)
+				assert.Len(t, got.GetGenres(), len(test.genres))
+				for j, genre := range test.genres {
+					assert.Equal(t, genre.Id, got.GetGenres()[j].GetId())
+					assert.Equal(t, genre.ParentId, got.GetGenres()[j].ParentId)
+				}
+			} else {
+				assert.Error(t, err)
+			}
+			assert.Equal(t, test.wantCode, status.Code(err))
+		})
+	}
+}
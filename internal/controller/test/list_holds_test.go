@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ListHolds(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+	readyAt := time.Now()
+	expiresAt := readyAt.AddDate(0, 0, 3)
+
+	holds := []*entity.Hold{
+		{Id: uuid3, BookId: uuid1, PatronId: uuid4, Status: entity.HoldStatusReady,
+			PlacedAt: time.Now(), ReadyAt: &readyAt, ExpiresAt: &expiresAt},
+		{Id: uuid5, BookId: uuid1, PatronId: uuid6, Status: entity.HoldStatusWaiting, PlacedAt: time.Now()},
+	}
+
+	type args struct {
+		ctx context.Context
+		req *library.ListHoldsRequest
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		wantFilter entity.HoldFilter
+		wantHolds  []*entity.Hold
+		wantErr    error
+		wantCode   codes.Code
+		mocksUsed  bool
+	}{
+		{
+			name: "list holds | by book",
+			args: args{
+				ctx,
+				&library.ListHoldsRequest{
+					BookId: uuid1,
+				},
+			},
+			wantFilter: entity.HoldFilter{BookId: uuid1},
+			wantHolds:  holds,
+			wantCode:   codes.OK,
+			mocksUsed:  true,
+		},
+		{
+			name: "list holds | by patron with closed",
+			args: args{
+				ctx,
+				&library.ListHoldsRequest{
+					PatronId:      uuid2,
+					IncludeClosed: true,
+				},
+			},
+			wantFilter: entity.HoldFilter{PatronId: uuid2, IncludeClosed: true},
+			wantHolds:  []*entity.Hold{},
+			wantCode:   codes.OK,
+			mocksUsed:  true,
+		},
+		{
+			name: "list holds | empty filter",
+			args: args{
+				ctx,
+				&library.ListHoldsRequest{},
+			},
+			wantErr:   entity.ErrInvalidHoldFilter,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: true,
+		},
+		{
+			name: "list holds | invalid uuid",
+			args: args{
+				ctx,
+				&library.ListHoldsRequest{
+					BookId: "aboba",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			holdUseCase := mocks.NewMockHoldUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, holdUseCase, nil, nil, nil)
+
+			if test.mocksUsed {
+				holdUseCase.
+					EXPECT().
+					ListHolds(gomock.Any(), test.wantFilter).
+					Return(test.wantHolds, test.wantErr)
+			}
+
+			got, err := service.ListHolds(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, got.GetHolds(), len(test.wantHolds))
+			for j, hold := range test.wantHolds {
+				assert.Equal(t, hold.Id, got.GetHolds()[j].GetId())
+				assert.Equal(t, hold.ExpiresAt != nil, got.GetHolds()[j].GetExpiresAt() != nil)
+			}
+		})
+	}
+}
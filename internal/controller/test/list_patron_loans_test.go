@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ListPatronLoans(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+	returnedAt := time.Now()
+
+	loans := []*entity.Loan{
+		{Id: uuid3, CopyId: uuid4, PatronId: uuid1, CheckedOutAt: time.Now()},
+		{Id: uuid5, CopyId: uuid6, PatronId: uuid1, CheckedOutAt: time.Now(), ReturnedAt: &returnedAt},
+	}
+
+	type args struct {
+		ctx context.Context
+		req *library.ListPatronLoansRequest
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		wantFilter entity.LoanFilter
+		wantLoans  []*entity.Loan
+		wantErr    error
+		wantCode   codes.Code
+		mocksUsed  bool
+	}{
+		{
+			name: "list patron loans | with returned",
+			args: args{
+				ctx,
+				&library.ListPatronLoansRequest{
+					PatronId:        uuid1,
+					IncludeReturned: true,
+				},
+			},
+			wantFilter: entity.LoanFilter{PatronId: uuid1, IncludeReturned: true},
+			wantLoans:  loans,
+			wantCode:   codes.OK,
+			mocksUsed:  true,
+		},
+		{
+			name: "list patron loans | no loans",
+			args: args{
+				ctx,
+				&library.ListPatronLoansRequest{
+					PatronId: uuid1,
+				},
+			},
+			wantFilter: entity.LoanFilter{PatronId: uuid1},
+			wantLoans:  []*entity.Loan{},
+			wantCode:   codes.OK,
+			mocksUsed:  true,
+		},
+		{
+			name: "list patron loans | invalid uuid",
+			args: args{
+				ctx,
+				&library.ListPatronLoansRequest{
+					PatronId: "aboba",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			loanUseCase := mocks.NewMockLoanUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, nil, loanUseCase, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				loanUseCase.
+					EXPECT().
+					ListPatronLoans(gomock.Any(), test.wantFilter).
+					Return(test.wantLoans, test.wantErr)
+			}
+
+			got, err := service.ListPatronLoans(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, got.GetLoans(), len(test.wantLoans))
+			for j, loan := range test.wantLoans {
+				assert.Equal(t, loan.Id, got.GetLoans()[j].GetId())
+				assert.Equal(t, loan.ReturnedAt != nil, got.GetLoans()[j].GetReturnedAt() != nil)
+			}
+		})
+	}
+}
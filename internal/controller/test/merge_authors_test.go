@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_MergeAuthors(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.MergeAuthorsRequest
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		merge       *entity.AuthorMerge
+		wantBookIds []string
+		wantErr     error
+		wantCode    codes.Code
+		mocksUsed   bool
+	}{
+		{
+			name: "merge authors",
+			args: args{
+				ctx,
+				&library.MergeAuthorsRequest{
+					SourceIds: []string{uuid2, uuid3},
+					TargetId:  uuid1,
+				},
+			},
+			merge: &entity.AuthorMerge{
+				Target:    &entity.Author{Id: uuid1},
+				SourceIds: []string{uuid2, uuid3},
+				Books:     []*entity.Book{{Id: uuid4}, {Id: uuid5}},
+			},
+			wantBookIds: []string{uuid4, uuid5},
+			wantCode:    codes.OK,
+			mocksUsed:   true,
+		},
+		{
+			name: "merge authors | target among sources",
+			args: args{
+				ctx,
+				&library.MergeAuthorsRequest{
+					SourceIds: []string{uuid1},
+					TargetId:  uuid1,
+				},
+			},
+			wantErr:   entity.ErrInvalidAuthorMerge,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: true,
+		},
+		{
+			name: "merge authors | source not found",
+			args: args{
+				ctx,
+				&library.MergeAuthorsRequest{
+					SourceIds: []string{uuid2},
+					TargetId:  uuid1,
+				},
+			},
+			wantErr:   entity.ErrAuthorNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "merge authors | books become duplicates",
+			args: args{
+				ctx,
+				&library.MergeAuthorsRequest{
+					SourceIds: []string{uuid2},
+					TargetId:  uuid1,
+				},
+			},
+			wantErr:   &entity.AlreadyExistsError{Err: entity.ErrBookAlreadyExists, Id: uuid4},
+			wantCode:  codes.AlreadyExists,
+			mocksUsed: true,
+		},
+		{
+			name: "merge authors | no sources",
+			args: args{
+				ctx,
+				&library.MergeAuthorsRequest{
+					TargetId: uuid1,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "merge authors | invalid source uuid",
+			args: args{
+				ctx,
+				&library.MergeAuthorsRequest{
+					SourceIds: []string{uuid2, "author"},
+					TargetId:  uuid1,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
+			service := controller.New(logger, bookUseCase, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				authorUseCase.
+					EXPECT().
+					MergeAuthors(gomock.Any(), test.args.req.GetSourceIds(), test.args.req.GetTargetId()).
+					Return(test.merge, test.wantErr)
+			}
+
+			got, err := service.MergeAuthors(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantBookIds, got.GetBookIds())
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_PayCharge(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.PayChargeRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "pay charge | valid request",
+			args: args{
+				ctx,
+				&library.PayChargeRequest{
+					Id: uuid1,
+				},
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "pay charge | not found",
+			args: args{
+				ctx,
+				&library.PayChargeRequest{
+					Id: uuid1,
+				},
+			},
+			wantErr:   entity.ErrChargeNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "pay charge | already settled",
+			args: args{
+				ctx,
+				&library.PayChargeRequest{
+					Id: uuid1,
+				},
+			},
+			wantErr:   entity.ErrChargeSettled,
+			wantCode:  codes.FailedPrecondition,
+			mocksUsed: true,
+		},
+		{
+			name: "pay charge | invalid uuid",
+			args: args{
+				ctx,
+				&library.PayChargeRequest{
+					Id: "aboba",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chargeUseCase := mocks.NewMockChargeUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, chargeUseCase, nil, nil)
+
+			if test.mocksUsed {
+				var charge *entity.Charge
+				if test.wantErr == nil {
+					settledAt := time.Now()
+					charge = &entity.Charge{
+						Id:        test.args.req.GetId(),
+						PatronId:  uuid2,
+						BookId:    uuid3,
+						Amount:    500,
+						Status:    entity.ChargeStatusPaid,
+						SettledAt: &settledAt,
+					}
+				}
+
+				chargeUseCase.
+					EXPECT().
+					PayCharge(gomock.Any(), test.args.req.GetId()).
+					Return(charge, test.wantErr)
+			}
+
+			got, err := service.PayCharge(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, uuid1, got.GetCharge().GetId())
+			assert.Equal(t, library.ChargeStatus_CHARGE_STATUS_PAID, got.GetCharge().GetStatus())
+			assert.NotNil(t, got.GetCharge().GetSettledAt())
+			assert.Nil(t, got.GetCharge().WaiverReason)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_PlaceHold(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.PlaceHoldRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "place hold | valid request",
+			args: args{
+				ctx,
+				&library.PlaceHoldRequest{
+					BookId:   uuid1,
+					PatronId: uuid2,
+				},
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "place hold | already exists",
+			args: args{
+				ctx,
+				&library.PlaceHoldRequest{
+					BookId:   uuid1,
+					PatronId: uuid2,
+				},
+			},
+			wantErr:   &entity.AlreadyExistsError{Err: entity.ErrHoldAlreadyExists, Id: uuid3},
+			wantCode:  codes.AlreadyExists,
+			mocksUsed: true,
+		},
+		{
+			name: "place hold | book not found",
+			args: args{
+				ctx,
+				&library.PlaceHoldRequest{
+					BookId:   uuid1,
+					PatronId: uuid2,
+				},
+			},
+			wantErr:   entity.ErrBookNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "place hold | invalid book id",
+			args: args{
+				ctx,
+				&library.PlaceHoldRequest{
+					BookId:   "aboba",
+					PatronId: uuid2,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			holdUseCase := mocks.NewMockHoldUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, holdUseCase, nil, nil, nil)
+
+			if test.mocksUsed {
+				var hold *entity.Hold
+				if test.wantErr == nil {
+					hold = &entity.Hold{
+						Id:       uuid3,
+						BookId:   test.args.req.GetBookId(),
+						PatronId: test.args.req.GetPatronId(),
+						Status:   entity.HoldStatusWaiting,
+						PlacedAt: time.Now(),
+					}
+				}
+
+				holdUseCase.
+					EXPECT().
+					PlaceHold(gomock.Any(), test.args.req.GetBookId(), test.args.req.GetPatronId()).
+					Return(hold, test.wantErr)
+			}
+
+			got, err := service.PlaceHold(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, uuid3, got.GetHold().GetId())
+			assert.Equal(t, library.HoldStatus_HOLD_STATUS_WAITING, got.GetHold().GetStatus())
+			assert.Nil(t, got.GetHold().GetReadyAt())
+		})
+	}
+}
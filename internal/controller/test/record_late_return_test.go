@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_RecordLateReturn(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+	dueAt := time.Date(2025, time.March, 1, 18, 0, 0, 0, time.UTC)
+	returnedAt := dueAt.AddDate(0, 0, 4)
+
+	type args struct {
+		ctx context.Context
+		req *library.RecordLateReturnRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "record late return | valid request",
+			args: args{
+				ctx,
+				&library.RecordLateReturnRequest{
+					PatronId:   uuid1,
+					BookId:     uuid2,
+					DueAt:      timestamppb.New(dueAt),
+					ReturnedAt: timestamppb.New(returnedAt),
+				},
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "record late return | already charged",
+			args: args{
+				ctx,
+				&library.RecordLateReturnRequest{
+					PatronId:   uuid1,
+					BookId:     uuid2,
+					DueAt:      timestamppb.New(dueAt),
+					ReturnedAt: timestamppb.New(returnedAt),
+				},
+			},
+			wantErr:   &entity.AlreadyExistsError{Err: entity.ErrChargeAlreadyExists, Id: uuid3},
+			wantCode:  codes.AlreadyExists,
+			mocksUsed: true,
+		},
+		{
+			name: "record late return | within grace period",
+			args: args{
+				ctx,
+				&library.RecordLateReturnRequest{
+					PatronId:   uuid1,
+					BookId:     uuid2,
+					DueAt:      timestamppb.New(dueAt),
+					ReturnedAt: timestamppb.New(dueAt.Add(time.Hour)),
+				},
+			},
+			wantErr:   entity.ErrReturnWithinGrace,
+			wantCode:  codes.FailedPrecondition,
+			mocksUsed: true,
+		},
+		{
+			name: "record late return | returned before due date",
+			args: args{
+				ctx,
+				&library.RecordLateReturnRequest{
+					PatronId:   uuid1,
+					BookId:     uuid2,
+					DueAt:      timestamppb.New(returnedAt),
+					ReturnedAt: timestamppb.New(dueAt),
+				},
+			},
+			wantErr:   entity.ErrReturnNotLate,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: true,
+		},
+		{
+			name: "record late return | missing due date",
+			args: args{
+				ctx,
+				&library.RecordLateReturnRequest{
+					PatronId:   uuid1,
+					BookId:     uuid2,
+					ReturnedAt: timestamppb.New(returnedAt),
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chargeUseCase := mocks.NewMockChargeUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, chargeUseCase, nil, nil)
+
+			if test.mocksUsed {
+				lateReturn := entity.LateReturn{
+					PatronId:   test.args.req.GetPatronId(),
+					BookId:     test.args.req.GetBookId(),
+					DueAt:      test.args.req.GetDueAt().AsTime(),
+					ReturnedAt: test.args.req.GetReturnedAt().AsTime(),
+				}
+
+				var charge *entity.Charge
+				if test.wantErr == nil {
+					charge = &entity.Charge{
+						Id:         uuid3,
+						PatronId:   lateReturn.PatronId,
+						BookId:     lateReturn.BookId,
+						DueAt:      lateReturn.DueAt,
+						ReturnedAt: lateReturn.ReturnedAt,
+						DaysLate:   4,
+						Amount:     75,
+						CreatedAt:  time.Now(),
+					}
+				}
+
+				chargeUseCase.
+					EXPECT().
+					RecordLateReturn(gomock.Any(), lateReturn).
+					Return(charge, test.wantErr)
+			}
+
+			got, err := service.RecordLateReturn(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, uuid3, got.GetCharge().GetId())
+			assert.Equal(t, "0.75", got.GetCharge().GetAmount())
+			assert.Equal(t, int32(4), got.GetCharge().GetDaysLate())
+			assert.Equal(t, library.ChargeStatus_CHARGE_STATUS_OUTSTANDING, got.GetCharge().GetStatus())
+		})
+	}
+}
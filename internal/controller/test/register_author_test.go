@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 )
 
 // Тесту проверяющему наличие автора стоило бы существовать, но два автора с одним именем могут существовать
@@ -58,6 +59,120 @@ func Test_RegisterAuthor(t *testing.T) {
 			wantErr:   mockErr,
 			mocksUsed: false,
 		},
+		{
+			name: "register author | with profile",
+			args: args{
+				ctx,
+				&library.RegisterAuthorRequest{
+					Name:      "Samuel Clemens",
+					Biography: proto.String("American writer"),
+					BirthDate: proto.String("1835-11-30"),
+					DeathDate: proto.String("1910-04-21"),
+					Aliases:   []string{"Mark Twain"},
+					ExternalIds: &library.AuthorExternalIds{
+						Viaf:     "50566653",
+						Wikidata: "Q7245",
+					},
+				},
+			},
+			want: &library.RegisterAuthorResponse{
+				Id: uuid2,
+			},
+			wantErr:   nil,
+			mocksUsed: true,
+		},
+		{
+			name: "register author | cyrillic name",
+			args: args{
+				ctx,
+				&library.RegisterAuthorRequest{
+					Name: "Фёдор Достоевский",
+				},
+			},
+			want: &library.RegisterAuthorResponse{
+				Id: uuid3,
+			},
+			wantErr:   nil,
+			mocksUsed: true,
+		},
+		{
+			name: "register author | initials and punctuation",
+			args: args{
+				ctx,
+				&library.RegisterAuthorRequest{
+					Name: "J.R.R. Tolkien",
+				},
+			},
+			want: &library.RegisterAuthorResponse{
+				Id: uuid4,
+			},
+			wantErr:   nil,
+			mocksUsed: true,
+		},
+		{
+			name: "register author | hyphen and apostrophe",
+			args: args{
+				ctx,
+				&library.RegisterAuthorRequest{
+					Name: "Jean-Paul O'Brien Jr.",
+				},
+			},
+			want: &library.RegisterAuthorResponse{
+				Id: uuid5,
+			},
+			wantErr:   nil,
+			mocksUsed: true,
+		},
+		{
+			name: "register author | double space",
+			args: args{
+				ctx,
+				&library.RegisterAuthorRequest{
+					Name: "J.R.R.  Tolkien",
+				},
+			},
+			want:      nil,
+			wantErr:   mockErr,
+			mocksUsed: false,
+		},
+		{
+			name: "register author | leading punctuation",
+			args: args{
+				ctx,
+				&library.RegisterAuthorRequest{
+					Name: "-Tolkien",
+				},
+			},
+			want:      nil,
+			wantErr:   mockErr,
+			mocksUsed: false,
+		},
+		{
+			name: "register author | invalid birth date",
+			args: args{
+				ctx,
+				&library.RegisterAuthorRequest{
+					Name:      "NameAbobs",
+					BirthDate: proto.String("1835/11/30"),
+				},
+			},
+			want:      nil,
+			wantErr:   mockErr,
+			mocksUsed: false,
+		},
+		{
+			name: "register author | empty alias",
+			args: args{
+				ctx,
+				&library.RegisterAuthorRequest{
+					Name:    "NameAbobs",
+					Aliases: []string{""},
+				},
+			},
+			want:      nil,
+			wantErr:   mockErr,
+			mocksUsed: false,
+		},
 		{
 			name: "register author | usecase error",
 			args: args{
@@ -81,7 +196,7 @@ func Test_RegisterAuthor(t *testing.T) {
 
 			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
 			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
-			service := controller.New(logger, bookUseCase, authorUseCase)
+			service := controller.New(logger, bookUseCase, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			if test.mocksUsed {
 				var auth *entity.Author
@@ -91,7 +206,17 @@ func Test_RegisterAuthor(t *testing.T) {
 
 				authorUseCase.
 					EXPECT().
-					RegisterAuthor(gomock.Any(), test.args.req.GetName()).
+					RegisterAuthor(gomock.Any(), &entity.Author{
+						Name:      test.args.req.GetName(),
+						Biography: test.args.req.Biography,
+						BirthDate: test.args.req.BirthDate,
+						DeathDate: test.args.req.DeathDate,
+						Aliases:   test.args.req.GetAliases(),
+						ExternalIds: entity.AuthorExternalIds{
+							Viaf:     test.args.req.GetExternalIds().GetViaf(),
+							Wikidata: test.args.req.GetExternalIds().GetWikidata(),
+						},
+					}).
 					Return(auth, test.wantErr)
 			}
 
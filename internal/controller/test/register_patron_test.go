@@ -0,0 +1,219 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_RegisterPatron(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+	email := "reader@example.com"
+	phone := "+79991234567"
+	badEmail := "reader"
+	badPhone := "89991234567"
+
+	type args struct {
+		ctx context.Context
+		req *library.RegisterPatronRequest
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		wantPatron *entity.Patron
+		wantErr    error
+		wantCode   codes.Code
+		mocksUsed  bool
+	}{
+		{
+			name: "register patron | valid request",
+			args: args{
+				ctx,
+				&library.RegisterPatronRequest{
+					CardNumber: "CARD-0001",
+					Name:       "Иван Петров",
+					Email:      &email,
+					Phone:      &phone,
+					ExpiresOn:  "2027-12-31",
+				},
+			},
+			wantPatron: &entity.Patron{
+				CardNumber: "CARD-0001",
+				Name:       "Иван Петров",
+				Email:      &email,
+				Phone:      &phone,
+				ExpiresOn:  "2027-12-31",
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "register patron | without contacts",
+			args: args{
+				ctx,
+				&library.RegisterPatronRequest{
+					CardNumber: "0002",
+					Name:       "Anna",
+					ExpiresOn:  "2027-01-01",
+				},
+			},
+			wantPatron: &entity.Patron{
+				CardNumber: "0002",
+				Name:       "Anna",
+				ExpiresOn:  "2027-01-01",
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "register patron | card number taken",
+			args: args{
+				ctx,
+				&library.RegisterPatronRequest{
+					CardNumber: "0002",
+					Name:       "Anna",
+					ExpiresOn:  "2027-01-01",
+				},
+			},
+			wantPatron: &entity.Patron{
+				CardNumber: "0002",
+				Name:       "Anna",
+				ExpiresOn:  "2027-01-01",
+			},
+			wantErr:   &entity.AlreadyExistsError{Err: entity.ErrPatronAlreadyExists, Id: uuid2},
+			wantCode:  codes.AlreadyExists,
+			mocksUsed: true,
+		},
+		{
+			name: "register patron | nonexistent date",
+			args: args{
+				ctx,
+				&library.RegisterPatronRequest{
+					CardNumber: "0002",
+					Name:       "Anna",
+					ExpiresOn:  "2027-02-30",
+				},
+			},
+			wantPatron: &entity.Patron{
+				CardNumber: "0002",
+				Name:       "Anna",
+				ExpiresOn:  "2027-02-30",
+			},
+			wantErr:   entity.ErrInvalidDate,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: true,
+		},
+		{
+			name: "register patron | invalid email",
+			args: args{
+				ctx,
+				&library.RegisterPatronRequest{
+					CardNumber: "0002",
+					Name:       "Anna",
+					Email:      &badEmail,
+					ExpiresOn:  "2027-01-01",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "register patron | phone not in E.164",
+			args: args{
+				ctx,
+				&library.RegisterPatronRequest{
+					CardNumber: "0002",
+					Name:       "Anna",
+					Phone:      &badPhone,
+					ExpiresOn:  "2027-01-01",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "register patron | missing expiry",
+			args: args{
+				ctx,
+				&library.RegisterPatronRequest{
+					CardNumber: "0002",
+					Name:       "Anna",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "register patron | empty name",
+			args: args{
+				ctx,
+				&library.RegisterPatronRequest{
+					CardNumber: "0002",
+					ExpiresOn:  "2027-01-01",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			patronUseCase := mocks.NewMockPatronUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, patronUseCase, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				var registered *entity.Patron
+				if test.wantErr == nil {
+					registered = &entity.Patron{}
+					*registered = *test.wantPatron
+					registered.Id = uuid3
+					registered.CreatedAt = time.Now()
+					registered.UpdatedAt = registered.CreatedAt
+				}
+
+				patronUseCase.
+					EXPECT().
+					RegisterPatron(gomock.Any(), test.wantPatron).
+					Return(registered, test.wantErr)
+			}
+
+			got, err := service.RegisterPatron(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, uuid3, got.GetPatron().GetId())
+			assert.Equal(t, test.args.req.GetCardNumber(), got.GetPatron().GetCardNumber())
+			assert.Equal(t, test.args.req.Email, got.GetPatron().Email)
+			assert.Equal(t, test.args.req.GetExpiresOn(), got.GetPatron().GetExpiresOn())
+			assert.Equal(t, library.PatronStatus_PATRON_STATUS_ACTIVE, got.GetPatron().GetStatus())
+		})
+	}
+}
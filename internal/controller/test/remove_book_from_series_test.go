@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_RemoveBookFromSeries(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.RemoveBookFromSeriesRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "remove book from series | valid request",
+			args: args{
+				ctx,
+				&library.RemoveBookFromSeriesRequest{
+					SeriesId: uuid1,
+					BookId:   uuid2,
+				},
+			},
+			wantErr:   nil,
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "remove book from series | invalid book id",
+			args: args{
+				ctx,
+				&library.RemoveBookFromSeriesRequest{
+					SeriesId: uuid1,
+					BookId:   "aboba",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "remove book from series | book not in series",
+			args: args{
+				ctx,
+				&library.RemoveBookFromSeriesRequest{
+					SeriesId: uuid1,
+					BookId:   uuid2,
+				},
+			},
+			wantErr:   entity.ErrBookNotInSeries,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			seriesUseCase := mocks.NewMockSeriesUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, seriesUseCase, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				seriesUseCase.
+					EXPECT().
+					RemoveBookFromSeries(gomock.Any(), test.args.req.GetSeriesId(), test.args.req.GetBookId()).
+					Return(test.wantErr)
+			}
+
+			got, err := service.RemoveBookFromSeries(test.args.ctx, test.args.req)
+
+			if test.wantErr == nil {
+				assert.NoError(t, err)
+				assert.NotNil(t, got)
+			} else {
+				assert.Error(t, err)
+			}
+			assert.Equal(t, test.wantCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_RenewLoan(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.RenewLoanRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "renew loan | valid request",
+			args: args{
+				ctx,
+				&library.RenewLoanRequest{
+					Id: uuid1,
+				},
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "renew loan | already returned",
+			args: args{
+				ctx,
+				&library.RenewLoanRequest{
+					Id: uuid1,
+				},
+			},
+			wantErr:   entity.ErrLoanReturned,
+			wantCode:  codes.FailedPrecondition,
+			mocksUsed: true,
+		},
+		{
+			name: "renew loan | card expired",
+			args: args{
+				ctx,
+				&library.RenewLoanRequest{
+					Id: uuid1,
+				},
+			},
+			wantErr:   entity.ErrPatronCardExpired,
+			wantCode:  codes.FailedPrecondition,
+			mocksUsed: true,
+		},
+		{
+			name: "renew loan | not found",
+			args: args{
+				ctx,
+				&library.RenewLoanRequest{
+					Id: uuid1,
+				},
+			},
+			wantErr:   entity.ErrLoanNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "renew loan | invalid uuid",
+			args: args{
+				ctx,
+				&library.RenewLoanRequest{
+					Id: "aboba",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			loanUseCase := mocks.NewMockLoanUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, nil, loanUseCase, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				var loan *entity.Loan
+				if test.wantErr == nil {
+					loan = &entity.Loan{
+						Id:       test.args.req.GetId(),
+						DueAt:    time.Now().AddDate(0, 0, 14),
+						Renewals: 1,
+					}
+				}
+
+				loanUseCase.
+					EXPECT().
+					RenewLoan(gomock.Any(), test.args.req.GetId()).
+					Return(loan, test.wantErr)
+			}
+
+			got, err := service.RenewLoan(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, int32(1), got.GetLoan().GetRenewals())
+		})
+	}
+}
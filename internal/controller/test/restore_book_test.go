@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func Test_RestoreBook(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.RestoreBookRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		want      *library.RestoreBookResponse
+		wantErr   error
+		mocksUsed bool
+	}{
+		{
+			name: "restore book | valid request",
+			args: args{
+				ctx,
+				&library.RestoreBookRequest{
+					Id: uuid7,
+				},
+			},
+			want: &library.RestoreBookResponse{
+				Book: &library.Book{
+					Id:        uuid7,
+					Name:      "Restored Book",
+					AuthorIds: []string{uuid8},
+				},
+			},
+			wantErr:   nil,
+			mocksUsed: true,
+		},
+		{
+			name: "restore book | invalid uuid",
+			args: args{
+				ctx,
+				&library.RestoreBookRequest{
+					Id: "restore-me",
+				},
+			},
+			want:      nil,
+			wantErr:   mockErr,
+			mocksUsed: false,
+		},
+		{
+			name: "restore book | book not deleted",
+			args: args{
+				ctx,
+				&library.RestoreBookRequest{
+					Id: uuid7,
+				},
+			},
+			want:      nil,
+			wantErr:   entity.ErrBookNotFound,
+			mocksUsed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
+			service := controller.New(logger, bookUseCase, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				var book *entity.Book
+				if test.want != nil {
+					book = ProtoToBook(t, test.want.Book)
+				}
+
+				bookUseCase.
+					EXPECT().
+					RestoreBook(gomock.Any(), test.args.req.GetId()).
+					Return(book, test.wantErr)
+			}
+
+			got, err := service.RestoreBook(test.args.ctx, test.args.req)
+
+			if err == nil && test.want != nil {
+				assert.Equal(t, test.want.Book.Id, got.GetBook().GetId())
+				assert.Equal(t, test.want.Book.Name, got.GetBook().GetName())
+				assert.Equal(t, test.want.Book.AuthorIds, got.GetBook().GetAuthorIds())
+			}
+
+			if test.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
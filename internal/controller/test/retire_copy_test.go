@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_RetireCopy(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+	retiredAt := time.Now()
+
+	type args struct {
+		ctx context.Context
+		req *library.RetireCopyRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		retired   *entity.Copy
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "retire copy | valid request",
+			args: args{
+				ctx,
+				&library.RetireCopyRequest{
+					Id: uuid1,
+				},
+			},
+			retired: &entity.Copy{
+				Id:        uuid1,
+				BookId:    uuid2,
+				Barcode:   "0001",
+				Branch:    "north",
+				Status:    entity.CopyStatusRetired,
+				RetiredAt: &retiredAt,
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "retire copy | already retired",
+			args: args{
+				ctx,
+				&library.RetireCopyRequest{
+					Id: uuid1,
+				},
+			},
+			wantErr:   entity.ErrCopyRetired,
+			wantCode:  codes.FailedPrecondition,
+			mocksUsed: true,
+		},
+		{
+			name: "retire copy | not found",
+			args: args{
+				ctx,
+				&library.RetireCopyRequest{
+					Id: uuid1,
+				},
+			},
+			wantErr:   entity.ErrCopyNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "retire copy | invalid uuid",
+			args: args{
+				ctx,
+				&library.RetireCopyRequest{
+					Id: "copy",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			copyUseCase := mocks.NewMockCopyUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, copyUseCase, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				copyUseCase.
+					EXPECT().
+					RetireCopy(gomock.Any(), test.args.req.GetId()).
+					Return(test.retired, test.wantErr)
+			}
+
+			got, err := service.RetireCopy(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, library.CopyStatus_COPY_STATUS_RETIRED, got.GetCopy().GetStatus())
+			assert.True(t, retiredAt.Equal(got.GetCopy().GetRetiredAt().AsTime()))
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_ReturnCopy(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.ReturnCopyRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "return copy | valid request",
+			args: args{
+				ctx,
+				&library.ReturnCopyRequest{
+					CopyId: uuid1,
+				},
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "return copy | not on loan",
+			args: args{
+				ctx,
+				&library.ReturnCopyRequest{
+					CopyId: uuid1,
+				},
+			},
+			wantErr:   entity.ErrCopyNotOnLoan,
+			wantCode:  codes.FailedPrecondition,
+			mocksUsed: true,
+		},
+		{
+			name: "return copy | copy not found",
+			args: args{
+				ctx,
+				&library.ReturnCopyRequest{
+					CopyId: uuid1,
+				},
+			},
+			wantErr:   entity.ErrCopyNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "return copy | invalid uuid",
+			args: args{
+				ctx,
+				&library.ReturnCopyRequest{
+					CopyId: "aboba",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			loanUseCase := mocks.NewMockLoanUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, nil, loanUseCase, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				var loan *entity.Loan
+				if test.wantErr == nil {
+					returnedAt := time.Now()
+					loan = &entity.Loan{
+						Id:         uuid2,
+						CopyId:     test.args.req.GetCopyId(),
+						ReturnedAt: &returnedAt,
+					}
+				}
+
+				loanUseCase.
+					EXPECT().
+					ReturnCopy(gomock.Any(), test.args.req.GetCopyId()).
+					Return(loan, test.wantErr)
+			}
+
+			got, err := service.ReturnCopy(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, uuid2, got.GetLoan().GetId())
+			assert.NotNil(t, got.GetLoan().GetReturnedAt())
+		})
+	}
+}
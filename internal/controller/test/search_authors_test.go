@@ -0,0 +1,154 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_SearchAuthors(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.SearchAuthorsRequest
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		wantSearch entity.AuthorSearch
+		matches    []*entity.AuthorMatch
+		wantErr    error
+		wantCode   codes.Code
+		mocksUsed  bool
+	}{
+		{
+			name: "search authors | valid request",
+			args: args{
+				ctx,
+				&library.SearchAuthorsRequest{
+					Query:     "Достоевкий",
+					Threshold: 0.4,
+					Limit:     5,
+				},
+			},
+			wantSearch: entity.AuthorSearch{Query: "Достоевкий", Threshold: 0.4, Limit: 5},
+			matches: []*entity.AuthorMatch{
+				{Author: &entity.Author{Id: uuid1, Name: "Достоевский"}, Similarity: 0.7},
+				{Author: &entity.Author{Id: uuid2, Name: "Достоевская"}, Similarity: 0.5},
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "search authors | default threshold",
+			args: args{
+				ctx,
+				&library.SearchAuthorsRequest{
+					Query: "Tolstoy",
+				},
+			},
+			wantSearch: entity.AuthorSearch{Query: "Tolstoy"},
+			matches:    []*entity.AuthorMatch{},
+			wantCode:   codes.OK,
+			mocksUsed:  true,
+		},
+		{
+			name: "search authors | repository error",
+			args: args{
+				ctx,
+				&library.SearchAuthorsRequest{
+					Query: "Tolstoy",
+				},
+			},
+			wantSearch: entity.AuthorSearch{Query: "Tolstoy"},
+			wantErr:    mockErr,
+			wantCode:   codes.Internal,
+			mocksUsed:  true,
+		},
+		{
+			name: "search authors | empty query",
+			args: args{
+				ctx,
+				&library.SearchAuthorsRequest{},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "search authors | threshold out of range",
+			args: args{
+				ctx,
+				&library.SearchAuthorsRequest{
+					Query:     "Tolstoy",
+					Threshold: 1.5,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "search authors | limit too large",
+			args: args{
+				ctx,
+				&library.SearchAuthorsRequest{
+					Query: "Tolstoy",
+					Limit: 1000,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
+			service := controller.New(logger, bookUseCase, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				authorUseCase.
+					EXPECT().
+					SearchAuthors(gomock.Any(), test.wantSearch).
+					Return(test.matches, test.wantErr)
+			}
+
+			got, err := service.SearchAuthors(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, got.GetAuthors(), len(test.matches))
+			for j, match := range test.matches {
+				assert.Equal(t, match.Author.Id, got.GetAuthors()[j].GetId())
+				assert.Equal(t, match.Author.Name, got.GetAuthors()[j].GetName())
+				assert.Equal(t, match.Similarity, got.GetAuthors()[j].GetSimilarity())
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_SearchBooks(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.SearchBooksRequest
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		wantSearch entity.BookSearch
+		page       *entity.BookSearchPage
+		wantErr    error
+		wantCode   codes.Code
+		mocksUsed  bool
+	}{
+		{
+			name: "search books | valid request",
+			args: args{
+				ctx,
+				&library.SearchBooksRequest{
+					Query:     "война мир",
+					PageSize:  1,
+					PageToken: "token",
+				},
+			},
+			wantSearch: entity.BookSearch{Query: "война мир", PageSize: 1, PageToken: "token"},
+			page: &entity.BookSearchPage{
+				Hits: []*entity.BookSearchHit{{
+					Book:    &entity.Book{Id: uuid1, Name: "Война и мир"},
+					Rank:    0.1,
+					Snippet: "<b>Война</b> и <b>мир</b>",
+				}},
+				NextPageToken: "next",
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "search books | invalid page token",
+			args: args{
+				ctx,
+				&library.SearchBooksRequest{
+					Query:     "мир",
+					PageToken: "broken",
+				},
+			},
+			wantSearch: entity.BookSearch{Query: "мир", PageToken: "broken"},
+			wantErr:    entity.ErrInvalidPageToken,
+			wantCode:   codes.InvalidArgument,
+			mocksUsed:  true,
+		},
+		{
+			name: "search books | empty query",
+			args: args{
+				ctx,
+				&library.SearchBooksRequest{},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "search books | query too long",
+			args: args{
+				ctx,
+				&library.SearchBooksRequest{
+					Query: strings.Repeat("a", 513),
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
+			service := controller.New(logger, bookUseCase, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				bookUseCase.
+					EXPECT().
+					SearchBooks(gomock.Any(), test.wantSearch).
+					Return(test.page, test.wantErr)
+			}
+
+			got, err := service.SearchBooks(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, got.GetHits(), len(test.page.Hits))
+			for j, hit := range test.page.Hits {
+				assert.Equal(t, hit.Book.Id, got.GetHits()[j].GetBook().GetId())
+				assert.Equal(t, hit.Rank, got.GetHits()[j].GetRank())
+				assert.Equal(t, hit.Snippet, got.GetHits()[j].GetSnippet())
+			}
+			assert.Equal(t, test.page.NextPageToken, got.GetNextPageToken())
+		})
+	}
+}
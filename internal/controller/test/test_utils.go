@@ -1,15 +1,55 @@
 package controller
 
 import (
+	"testing"
+
 	"github.com/project/library/generated/api/library"
 	"github.com/project/library/internal/entity"
 )
 
-func ProtoToBook(pb *library.Book) *entity.Book {
+// contributorRoles сопоставляет роли API и сущности независимо от контроллера, чтобы тесты проверяли его преобразование
+var contributorRoles = map[library.ContributorRole]entity.ContributorRole{
+	library.ContributorRole_CONTRIBUTOR_ROLE_UNSPECIFIED: entity.ContributorRoleAuthor,
+	library.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR:      entity.ContributorRoleAuthor,
+	library.ContributorRole_CONTRIBUTOR_ROLE_EDITOR:      entity.ContributorRoleEditor,
+	library.ContributorRole_CONTRIBUTOR_ROLE_TRANSLATOR:  entity.ContributorRoleTranslator,
+	library.ContributorRole_CONTRIBUTOR_ROLE_ILLUSTRATOR: entity.ContributorRoleIllustrator,
+}
+
+func ProtoToBook(t *testing.T, pb *library.Book) *entity.Book {
+	t.Helper()
+
 	book := &entity.Book{
 		Id:        pb.GetId(),
 		Name:      pb.GetName(),
 		AuthorIds: pb.GetAuthorIds(),
+		ISBN:      pb.Isbn,
+		GenreIds:  pb.GetGenreIds(),
+
+		PublicationYear: pb.PublicationYear,
+		Language:        pb.Language,
+		PageCount:       pb.PageCount,
+		Description:     pb.Description,
+		PublisherId:     pb.PublisherId,
+	}
+
+	for _, series := range pb.GetSeries() {
+		book.Series = append(book.Series, entity.BookSeries{
+			SeriesId: series.GetSeriesId(),
+			Volume:   series.GetVolume(),
+		})
+	}
+
+	for _, contributor := range pb.GetContributors() {
+		role, ok := contributorRoles[contributor.GetRole()]
+		if !ok {
+			t.Fatalf("unknown contributor role %v", contributor.GetRole())
+		}
+
+		book.Contributors = append(book.Contributors, entity.Contributor{
+			AuthorId: contributor.GetAuthorId(),
+			Role:     role,
+		})
 	}
 
 	book.CreatedAt = pb.GetCreatedAt().AsTime()
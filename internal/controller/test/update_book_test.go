@@ -6,10 +6,12 @@ import (
 
 	"github.com/project/library/generated/api/library"
 	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
 	"github.com/project/library/internal/usecase/library/mocks"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 )
 
 func Test_UpdateBook(t *testing.T) {
@@ -23,10 +25,11 @@ func Test_UpdateBook(t *testing.T) {
 	}
 
 	tests := []struct {
-		name      string
-		args      args
-		wantErr   error
-		mocksUsed bool
+		name             string
+		args             args
+		wantContributors []entity.Contributor
+		wantErr          error
+		mocksUsed        bool
 	}{
 		{
 			name: "update book | valid request with name and authors",
@@ -41,6 +44,26 @@ func Test_UpdateBook(t *testing.T) {
 			wantErr:   nil,
 			mocksUsed: true,
 		},
+		{
+			name: "update book | contributors",
+			args: args{
+				ctx,
+				&library.UpdateBookRequest{
+					Id:   uuid4,
+					Name: "New name",
+					Contributors: []*library.Contributor{
+						{AuthorId: uuid6, Role: library.ContributorRole_CONTRIBUTOR_ROLE_EDITOR},
+						{AuthorId: uuid5},
+					},
+				},
+			},
+			wantContributors: []entity.Contributor{
+				{AuthorId: uuid6, Role: entity.ContributorRoleEditor},
+				{AuthorId: uuid5, Role: entity.ContributorRoleAuthor},
+			},
+			wantErr:   nil,
+			mocksUsed: true,
+		},
 		{
 			name: "update book | valid request with name only",
 			args: args{
@@ -53,6 +76,73 @@ func Test_UpdateBook(t *testing.T) {
 			wantErr:   nil,
 			mocksUsed: true,
 		},
+		{
+			name: "update book | clear isbn",
+			args: args{
+				ctx,
+				&library.UpdateBookRequest{
+					Id:   uuid8,
+					Name: "New name",
+					Isbn: proto.String(""),
+				},
+			},
+			wantErr:   nil,
+			mocksUsed: true,
+		},
+		{
+			name: "update book | metadata",
+			args: args{
+				ctx,
+				&library.UpdateBookRequest{
+					Id:              uuid8,
+					Name:            "New name",
+					PublicationYear: proto.Int32(0),
+					Language:        proto.String("en-US"),
+					PageCount:       proto.Int32(320),
+				},
+			},
+			wantErr:   nil,
+			mocksUsed: true,
+		},
+		{
+			name: "update book | detach publisher",
+			args: args{
+				ctx,
+				&library.UpdateBookRequest{
+					Id:          uuid8,
+					Name:        "New name",
+					PublisherId: proto.String(""),
+				},
+			},
+			wantErr:   nil,
+			mocksUsed: true,
+		},
+		{
+			name: "update book | invalid publisher",
+			args: args{
+				ctx,
+				&library.UpdateBookRequest{
+					Id:          uuid8,
+					Name:        "New name",
+					PublisherId: proto.String("aboba"),
+				},
+			},
+			wantErr:   mockErr,
+			mocksUsed: false,
+		},
+		{
+			name: "update book | negative page count",
+			args: args{
+				ctx,
+				&library.UpdateBookRequest{
+					Id:        uuid8,
+					Name:      "New name",
+					PageCount: proto.Int32(-1),
+				},
+			},
+			wantErr:   mockErr,
+			mocksUsed: false,
+		},
 		{
 			name: "update book | invalid request with authors only",
 			args: args{
@@ -87,6 +177,45 @@ func Test_UpdateBook(t *testing.T) {
 			wantErr:   mockErr,
 			mocksUsed: false,
 		},
+		{
+			name: "update book | expected revision",
+			args: args{
+				ctx,
+				&library.UpdateBookRequest{
+					Id:               uuid8,
+					Name:             "New name",
+					ExpectedRevision: proto.Int64(3),
+				},
+			},
+			wantErr:   nil,
+			mocksUsed: true,
+		},
+		{
+			name: "update book | revision mismatch",
+			args: args{
+				ctx,
+				&library.UpdateBookRequest{
+					Id:               uuid8,
+					Name:             "New name",
+					ExpectedRevision: proto.Int64(3),
+				},
+			},
+			wantErr:   entity.ErrBookRevisionMismatch,
+			mocksUsed: true,
+		},
+		{
+			name: "update book | zero expected revision",
+			args: args{
+				ctx,
+				&library.UpdateBookRequest{
+					Id:               uuid8,
+					Name:             "New name",
+					ExpectedRevision: proto.Int64(0),
+				},
+			},
+			wantErr:   mockErr,
+			mocksUsed: false,
+		},
 		{
 			name: "update book | book not found",
 			args: args{
@@ -110,12 +239,26 @@ func Test_UpdateBook(t *testing.T) {
 
 			authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
 			bookUseCase := mocks.NewMockBooksUseCase(ctrl)
-			service := controller.New(logger, bookUseCase, authorUseCase)
+			service := controller.New(logger, bookUseCase, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			if test.mocksUsed {
 				bookUseCase.
 					EXPECT().
-					UpdateBook(gomock.Any(), test.args.req.GetId(), test.args.req.GetName(), test.args.req.GetAuthorIds()).
+					UpdateBook(gomock.Any(), entity.BookUpdate{
+						Id:        test.args.req.GetId(),
+						Name:      test.args.req.GetName(),
+						AuthorIds: test.args.req.GetAuthorIds(),
+						ISBN:      test.args.req.Isbn,
+
+						PublicationYear: test.args.req.PublicationYear,
+						Language:        test.args.req.Language,
+						PageCount:       test.args.req.PageCount,
+						Description:     test.args.req.Description,
+						PublisherId:     test.args.req.PublisherId,
+						Contributors:    test.wantContributors,
+
+						ExpectedRevision: test.args.req.ExpectedRevision,
+					}).
 					Return(test.wantErr)
 			}
 
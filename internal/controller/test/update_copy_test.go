@@ -0,0 +1,171 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_UpdateCopy(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+	branch := "south"
+	noLocation := ""
+	inRepair := library.CopyStatus_COPY_STATUS_IN_REPAIR
+	retired := library.CopyStatus_COPY_STATUS_RETIRED
+	poor := library.CopyCondition_COPY_CONDITION_POOR
+	unknownStatus := library.CopyStatus(42)
+
+	entityInRepair := entity.CopyStatusInRepair
+	entityRetired := entity.CopyStatusRetired
+	entityPoor := entity.CopyConditionPoor
+
+	type args struct {
+		ctx context.Context
+		req *library.UpdateCopyRequest
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		wantUpdate entity.CopyUpdate
+		wantErr    error
+		wantCode   codes.Code
+		mocksUsed  bool
+	}{
+		{
+			name: "update copy | move to another branch",
+			args: args{
+				ctx,
+				&library.UpdateCopyRequest{
+					Id:       uuid1,
+					Branch:   &branch,
+					Location: &noLocation,
+				},
+			},
+			wantUpdate: entity.CopyUpdate{Id: uuid1, Branch: &branch, Location: &noLocation},
+			wantCode:   codes.OK,
+			mocksUsed:  true,
+		},
+		{
+			name: "update copy | condition and status",
+			args: args{
+				ctx,
+				&library.UpdateCopyRequest{
+					Id:        uuid1,
+					Condition: &poor,
+					Status:    &inRepair,
+				},
+			},
+			wantUpdate: entity.CopyUpdate{Id: uuid1, Condition: &entityPoor, Status: &entityInRepair},
+			wantCode:   codes.OK,
+			mocksUsed:  true,
+		},
+		{
+			name: "update copy | retired status",
+			args: args{
+				ctx,
+				&library.UpdateCopyRequest{
+					Id:     uuid1,
+					Status: &retired,
+				},
+			},
+			wantUpdate: entity.CopyUpdate{Id: uuid1, Status: &entityRetired},
+			wantErr:    entity.ErrInvalidCopyStatus,
+			wantCode:   codes.InvalidArgument,
+			mocksUsed:  true,
+		},
+		{
+			name: "update copy | copy retired",
+			args: args{
+				ctx,
+				&library.UpdateCopyRequest{
+					Id:     uuid1,
+					Branch: &branch,
+				},
+			},
+			wantUpdate: entity.CopyUpdate{Id: uuid1, Branch: &branch},
+			wantErr:    entity.ErrCopyRetired,
+			wantCode:   codes.FailedPrecondition,
+			mocksUsed:  true,
+		},
+		{
+			name: "update copy | not found",
+			args: args{
+				ctx,
+				&library.UpdateCopyRequest{
+					Id: uuid1,
+				},
+			},
+			wantUpdate: entity.CopyUpdate{Id: uuid1},
+			wantErr:    entity.ErrCopyNotFound,
+			wantCode:   codes.NotFound,
+			mocksUsed:  true,
+		},
+		{
+			name: "update copy | empty branch",
+			args: args{
+				ctx,
+				&library.UpdateCopyRequest{
+					Id:     uuid1,
+					Branch: &noLocation,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "update copy | unknown status",
+			args: args{
+				ctx,
+				&library.UpdateCopyRequest{
+					Id:     uuid1,
+					Status: &unknownStatus,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			copyUseCase := mocks.NewMockCopyUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, copyUseCase, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				copyUseCase.
+					EXPECT().
+					UpdateCopy(gomock.Any(), test.wantUpdate).
+					Return(test.wantErr)
+			}
+
+			got, err := service.UpdateCopy(test.args.ctx, test.args.req)
+
+			if test.wantErr == nil {
+				assert.NoError(t, err)
+				assert.NotNil(t, got)
+			} else {
+				assert.Error(t, err)
+			}
+			assert.Equal(t, test.wantCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_UpdatePatron(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+	name := "Anna Karenina"
+	empty := ""
+	expiresOn := "2028-06-30"
+	badEmail := "reader@"
+	suspended := library.PatronStatus_PATRON_STATUS_SUSPENDED
+	unknownStatus := library.PatronStatus(42)
+
+	entitySuspended := entity.PatronStatusSuspended
+
+	type args struct {
+		ctx context.Context
+		req *library.UpdatePatronRequest
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		wantUpdate entity.PatronUpdate
+		wantErr    error
+		wantCode   codes.Code
+		mocksUsed  bool
+	}{
+		{
+			name: "update patron | rename and extend",
+			args: args{
+				ctx,
+				&library.UpdatePatronRequest{
+					Id:        uuid1,
+					Name:      &name,
+					ExpiresOn: &expiresOn,
+				},
+			},
+			wantUpdate: entity.PatronUpdate{Id: uuid1, Name: &name, ExpiresOn: &expiresOn},
+			wantCode:   codes.OK,
+			mocksUsed:  true,
+		},
+		{
+			name: "update patron | suspend and remove contacts",
+			args: args{
+				ctx,
+				&library.UpdatePatronRequest{
+					Id:     uuid1,
+					Email:  &empty,
+					Phone:  &empty,
+					Status: &suspended,
+				},
+			},
+			wantUpdate: entity.PatronUpdate{Id: uuid1, Email: &empty, Phone: &empty, Status: &entitySuspended},
+			wantCode:   codes.OK,
+			mocksUsed:  true,
+		},
+		{
+			name: "update patron | not found",
+			args: args{
+				ctx,
+				&library.UpdatePatronRequest{
+					Id: uuid1,
+				},
+			},
+			wantUpdate: entity.PatronUpdate{Id: uuid1},
+			wantErr:    entity.ErrPatronNotFound,
+			wantCode:   codes.NotFound,
+			mocksUsed:  true,
+		},
+		{
+			name: "update patron | empty name",
+			args: args{
+				ctx,
+				&library.UpdatePatronRequest{
+					Id:   uuid1,
+					Name: &empty,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "update patron | invalid email",
+			args: args{
+				ctx,
+				&library.UpdatePatronRequest{
+					Id:    uuid1,
+					Email: &badEmail,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "update patron | unknown status",
+			args: args{
+				ctx,
+				&library.UpdatePatronRequest{
+					Id:     uuid1,
+					Status: &unknownStatus,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			patronUseCase := mocks.NewMockPatronUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, patronUseCase, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				patronUseCase.
+					EXPECT().
+					UpdatePatron(gomock.Any(), test.wantUpdate).
+					Return(test.wantErr)
+			}
+
+			got, err := service.UpdatePatron(test.args.ctx, test.args.req)
+
+			if test.wantErr == nil {
+				assert.NoError(t, err)
+				assert.NotNil(t, got)
+			} else {
+				assert.Error(t, err)
+			}
+			assert.Equal(t, test.wantCode, status.Code(err))
+		})
+	}
+}
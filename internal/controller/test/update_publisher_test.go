@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_UpdatePublisher(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.UpdatePublisherRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "update publisher | valid request",
+			args: args{
+				ctx,
+				&library.UpdatePublisherRequest{
+					Id:   uuid4,
+					Name: "Penguin Random House",
+				},
+			},
+			wantErr:   nil,
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "update publisher | empty name",
+			args: args{
+				ctx,
+				&library.UpdatePublisherRequest{
+					Id:   uuid4,
+					Name: "",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "update publisher | publisher not found",
+			args: args{
+				ctx,
+				&library.UpdatePublisherRequest{
+					Id:   uuid4,
+					Name: "Penguin Random House",
+				},
+			},
+			wantErr:   entity.ErrPublisherNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			publisherUseCase := mocks.NewMockPublisherUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, publisherUseCase, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			if test.mocksUsed {
+				publisherUseCase.
+					EXPECT().
+					UpdatePublisher(gomock.Any(), test.args.req.GetId(), test.args.req.GetName()).
+					Return(test.wantErr)
+			}
+
+			got, err := service.UpdatePublisher(test.args.ctx, test.args.req)
+
+			if test.wantErr == nil {
+				assert.NoError(t, err)
+				assert.NotNil(t, got)
+			} else {
+				assert.Error(t, err)
+			}
+			assert.Equal(t, test.wantCode, status.Code(err))
+		})
+	}
+}
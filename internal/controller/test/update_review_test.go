@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_UpdateReview(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.UpdateReviewRequest
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		wantUpdate entity.ReviewUpdate
+		wantErr    error
+		wantCode   codes.Code
+		mocksUsed  bool
+	}{
+		{
+			name: "update review | rating and text",
+			args: args{
+				ctx,
+				&library.UpdateReviewRequest{
+					Id:     uuid1,
+					Rating: proto.Int32(2),
+					Text:   proto.String("Changed my mind"),
+				},
+			},
+			wantUpdate: entity.ReviewUpdate{
+				Id:     uuid1,
+				Rating: proto.Int32(2),
+				Text:   proto.String("Changed my mind"),
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "update review | text only",
+			args: args{
+				ctx,
+				&library.UpdateReviewRequest{
+					Id:   uuid1,
+					Text: proto.String(""),
+				},
+			},
+			wantUpdate: entity.ReviewUpdate{
+				Id:   uuid1,
+				Text: proto.String(""),
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "update review | not found",
+			args: args{
+				ctx,
+				&library.UpdateReviewRequest{
+					Id:     uuid1,
+					Rating: proto.Int32(4),
+				},
+			},
+			wantUpdate: entity.ReviewUpdate{
+				Id:     uuid1,
+				Rating: proto.Int32(4),
+			},
+			wantErr:   entity.ErrReviewNotFound,
+			wantCode:  codes.NotFound,
+			mocksUsed: true,
+		},
+		{
+			name: "update review | invalid rating",
+			args: args{
+				ctx,
+				&library.UpdateReviewRequest{
+					Id:     uuid1,
+					Rating: proto.Int32(0),
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+		{
+			name: "update review | invalid uuid",
+			args: args{
+				ctx,
+				&library.UpdateReviewRequest{
+					Id: "aboba",
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			reviewUseCase := mocks.NewMockReviewUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, reviewUseCase, nil)
+
+			if test.mocksUsed {
+				var review *entity.Review
+				if test.wantErr == nil {
+					review = &entity.Review{
+						Id:       test.args.req.GetId(),
+						BookId:   uuid2,
+						PatronId: uuid3,
+						Rating:   test.args.req.GetRating(),
+						Text:     test.args.req.GetText(),
+					}
+				}
+
+				reviewUseCase.
+					EXPECT().
+					UpdateReview(gomock.Any(), test.wantUpdate).
+					Return(review, test.wantErr)
+			}
+
+			got, err := service.UpdateReview(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, uuid1, got.GetReview().GetId())
+			assert.Equal(t, test.args.req.GetText(), got.GetReview().GetText())
+		})
+	}
+}
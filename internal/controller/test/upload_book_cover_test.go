@@ -0,0 +1,175 @@
+package controller
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// uploadStream отдает заранее заданные сообщения и запоминает ответ
+type uploadStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*library.UploadBookCoverRequest
+	response *library.UploadBookCoverResponse
+}
+
+func (s *uploadStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *uploadStream) Recv() (*library.UploadBookCoverRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+
+	return req, nil
+}
+
+func (s *uploadStream) SendAndClose(resp *library.UploadBookCoverResponse) error {
+	s.response = resp
+	return nil
+}
+
+func coverInfo(bookId string) *library.UploadBookCoverRequest {
+	return &library.UploadBookCoverRequest{
+		Data: &library.UploadBookCoverRequest_Info{Info: &library.UploadBookCoverInfo{
+			BookId:      bookId,
+			ContentType: "image/png",
+		}},
+	}
+}
+
+func coverChunk(chunk []byte) *library.UploadBookCoverRequest {
+	return &library.UploadBookCoverRequest{
+		Data: &library.UploadBookCoverRequest_Chunk{Chunk: chunk},
+	}
+}
+
+func Test_UploadBookCover(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+
+	tests := []struct {
+		name        string
+		requests    []*library.UploadBookCoverRequest
+		wantContent []byte
+		wantErr     error
+		wantCode    codes.Code
+		mocksUsed   bool
+	}{
+		{
+			name:        "upload book cover | valid request",
+			requests:    []*library.UploadBookCoverRequest{coverInfo(uuid1), coverChunk([]byte("abc")), coverChunk([]byte("def"))},
+			wantContent: []byte("abcdef"),
+			wantCode:    codes.OK,
+			mocksUsed:   true,
+		},
+		{
+			name:        "upload book cover | book not found",
+			requests:    []*library.UploadBookCoverRequest{coverInfo(uuid1), coverChunk([]byte("abc"))},
+			wantContent: []byte("abc"),
+			wantErr:     entity.ErrBookNotFound,
+			wantCode:    codes.NotFound,
+			mocksUsed:   true,
+		},
+		{
+			name:        "upload book cover | too large",
+			requests:    []*library.UploadBookCoverRequest{coverInfo(uuid1), coverChunk([]byte("abc"))},
+			wantContent: []byte("abc"),
+			wantErr:     entity.ErrCoverTooLarge,
+			wantCode:    codes.InvalidArgument,
+			mocksUsed:   true,
+		},
+		{
+			name:     "upload book cover | empty stream",
+			wantErr:  mockErr,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "upload book cover | chunk before info",
+			requests: []*library.UploadBookCoverRequest{coverChunk([]byte("abc"))},
+			wantErr:  mockErr,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "upload book cover | invalid uuid",
+			requests: []*library.UploadBookCoverRequest{coverInfo("aboba")},
+			wantErr:  mockErr,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:        "upload book cover | repeated info",
+			requests:    []*library.UploadBookCoverRequest{coverInfo(uuid1), coverChunk([]byte("abc")), coverInfo(uuid1)},
+			wantContent: []byte("abc"),
+			wantErr:     mockErr,
+			wantCode:    codes.InvalidArgument,
+			mocksUsed:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			coverUseCase := mocks.NewMockCoverUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, coverUseCase)
+
+			if test.mocksUsed {
+				coverUseCase.
+					EXPECT().
+					UploadBookCover(gomock.Any(), entity.CoverUpload{BookId: uuid1, ContentType: "image/png"}, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ entity.CoverUpload, content io.Reader) (*entity.BookCover, error) {
+						read, err := io.ReadAll(content)
+						assert.Equal(t, test.wantContent, read)
+
+						if err != nil {
+							return nil, err
+						}
+
+						if test.wantErr != nil {
+							return nil, test.wantErr
+						}
+
+						return &entity.BookCover{
+							BookId:      uuid1,
+							ContentType: "image/png",
+							Size:        int64(len(read)),
+						}, nil
+					})
+			}
+
+			stream := &uploadStream{ctx: t.Context(), requests: test.requests}
+			err := service.UploadBookCover(stream)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				assert.Nil(t, stream.response)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, uuid1, stream.response.GetCover().GetBookId())
+			assert.Equal(t, int64(len(test.wantContent)), stream.response.GetCover().GetSize())
+		})
+	}
+}
@@ -2,11 +2,13 @@ package controller
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -24,7 +26,7 @@ func TestConvertErr(t *testing.T) {
 	logger, _ := zap.NewProduction()
 	authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
 	bookUseCase := mocks.NewMockBooksUseCase(ctrl)
-	service := service_.New(logger, bookUseCase, authorUseCase)
+	service := service_.New(logger, bookUseCase, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	tests := []struct {
 		name     string
@@ -41,6 +43,26 @@ func TestConvertErr(t *testing.T) {
 			inputErr: entity.ErrBookNotFound,
 			wantCode: codes.NotFound,
 		},
+		{
+			name:     "AuthorHasBooks",
+			inputErr: entity.ErrAuthorHasBooks,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "InvalidPageToken",
+			inputErr: entity.ErrInvalidPageToken,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "AuthorAlreadyExists",
+			inputErr: entity.ErrAuthorAlreadyExists,
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:     "BookAlreadyExists with existing id",
+			inputErr: fmt.Errorf("wrapped: %w", &entity.AlreadyExistsError{Err: entity.ErrBookAlreadyExists, Id: uuid1}),
+			wantCode: codes.AlreadyExists,
+		},
 		{
 			name:     "InternalError",
 			inputErr: errors.New("some internal error"),
@@ -64,3 +86,27 @@ func TestConvertErr(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertErrAlreadyExistsDetails(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	logger, _ := zap.NewProduction()
+	authorUseCase := mocks.NewMockAuthorUseCase(ctrl)
+	bookUseCase := mocks.NewMockBooksUseCase(ctrl)
+	service := service_.New(logger, bookUseCase, authorUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	err := service.ConvertErr(&entity.AlreadyExistsError{Err: entity.ErrAuthorAlreadyExists, Id: uuid2})
+	s, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.AlreadyExists, s.Code())
+	assert.Contains(t, s.Message(), uuid2)
+
+	details := s.Details()
+	assert.Len(t, details, 1)
+
+	info, ok := details[0].(*errdetails.ResourceInfo)
+	assert.True(t, ok)
+	assert.Equal(t, "author", info.GetResourceType())
+	assert.Equal(t, uuid2, info.GetResourceName())
+}
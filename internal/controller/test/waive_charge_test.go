@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/project/library/generated/api/library"
+	"github.com/project/library/internal/controller"
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_WaiveCharge(t *testing.T) {
+	t.Parallel()
+	logger, _ := zap.NewProduction()
+	ctx := t.Context()
+
+	type args struct {
+		ctx context.Context
+		req *library.WaiveChargeRequest
+	}
+
+	tests := []struct {
+		name      string
+		args      args
+		wantErr   error
+		wantCode  codes.Code
+		mocksUsed bool
+	}{
+		{
+			name: "waive charge | valid request",
+			args: args{
+				ctx,
+				&library.WaiveChargeRequest{
+					Id:     uuid1,
+					Reason: "book was returned to another branch",
+				},
+			},
+			wantCode:  codes.OK,
+			mocksUsed: true,
+		},
+		{
+			name: "waive charge | already settled",
+			args: args{
+				ctx,
+				&library.WaiveChargeRequest{
+					Id:     uuid1,
+					Reason: "duplicate",
+				},
+			},
+			wantErr:   entity.ErrChargeSettled,
+			wantCode:  codes.FailedPrecondition,
+			mocksUsed: true,
+		},
+		{
+			name: "waive charge | empty reason",
+			args: args{
+				ctx,
+				&library.WaiveChargeRequest{
+					Id: uuid1,
+				},
+			},
+			wantErr:   mockErr,
+			wantCode:  codes.InvalidArgument,
+			mocksUsed: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			chargeUseCase := mocks.NewMockChargeUseCase(ctrl)
+			service := controller.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, chargeUseCase, nil, nil)
+
+			if test.mocksUsed {
+				var charge *entity.Charge
+				if test.wantErr == nil {
+					settledAt := time.Now()
+					reason := test.args.req.GetReason()
+					charge = &entity.Charge{
+						Id:           test.args.req.GetId(),
+						PatronId:     uuid2,
+						BookId:       uuid3,
+						Amount:       500,
+						Status:       entity.ChargeStatusWaived,
+						WaiverReason: &reason,
+						SettledAt:    &settledAt,
+					}
+				}
+
+				chargeUseCase.
+					EXPECT().
+					WaiveCharge(gomock.Any(), test.args.req.GetId(), test.args.req.GetReason()).
+					Return(charge, test.wantErr)
+			}
+
+			got, err := service.WaiveCharge(test.args.ctx, test.args.req)
+			assert.Equal(t, test.wantCode, status.Code(err))
+
+			if test.wantErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, library.ChargeStatus_CHARGE_STATUS_WAIVED, got.GetCharge().GetStatus())
+			assert.Equal(t, test.args.req.GetReason(), got.GetCharge().GetWaiverReason())
+		})
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"time"
 
 	"github.com/project/library/generated/api/library"
 )
@@ -49,7 +48,21 @@ func (i *impl) UpdateBook(ctx context.Context, req *library.UpdateBookRequest) (
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err := i.booksUseCase.UpdateBook(ctx, req.GetId(), req.GetName(), req.GetAuthorIds())
+	err := i.booksUseCase.UpdateBook(ctx, entity.BookUpdate{
+		Id:        req.GetId(),
+		Name:      req.GetName(),
+		AuthorIds: req.GetAuthorIds(),
+		ISBN:      req.Isbn,
+
+		PublicationYear: req.PublicationYear,
+		Language:        req.Language,
+		PageCount:       req.PageCount,
+		Description:     req.Description,
+		PublisherId:     req.PublisherId,
+		Contributors:    convertContributorsFromProto(req.GetContributors()),
+
+		ExpectedRevision: req.ExpectedRevision,
+	})
 
 	if err != nil {
 		SendSpanStatusLoggerError(i.logger, ctx, "Failed to update book.", err, codes.Internal)
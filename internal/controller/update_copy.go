@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	UpdateCopyDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_update_copy_duration_ms",
+		Help:    "Duration of UpdateCopy in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	UpdateCopyRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_update_copy_requests_total",
+		Help: "Total number of UpdateCopy requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(UpdateCopyDuration)
+	prometheus.MustRegister(UpdateCopyRequests)
+}
+
+func (i *impl) UpdateCopy(ctx context.Context, req *library.UpdateCopyRequest) (*library.UpdateCopyResponse, error) {
+	UpdateCopyRequests.Inc()
+	start := time.Now()
+	defer func() {
+		UpdateCopyDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "UpdateCopy")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received UpdateCopy request.",
+		layerCont, "copy_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid UpdateCopy request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	update := entity.CopyUpdate{
+		Id:       req.GetId(),
+		Branch:   req.Branch,
+		Location: req.Location,
+	}
+	if req.Condition != nil {
+		condition := convertCopyCondition(req.GetCondition())
+		update.Condition = &condition
+	}
+	if req.Status != nil {
+		copyStatus := convertCopyStatus(req.GetStatus())
+		update.Status = &copyStatus
+	}
+
+	err := i.copyUseCase.UpdateCopy(ctx, update)
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to update copy.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.UpdateCopyResponse{}, nil
+}
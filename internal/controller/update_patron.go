@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	UpdatePatronDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_update_patron_duration_ms",
+		Help:    "Duration of UpdatePatron in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	UpdatePatronRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_update_patron_requests_total",
+		Help: "Total number of UpdatePatron requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(UpdatePatronDuration)
+	prometheus.MustRegister(UpdatePatronRequests)
+}
+
+func (i *impl) UpdatePatron(ctx context.Context, req *library.UpdatePatronRequest) (*library.UpdatePatronResponse, error) {
+	UpdatePatronRequests.Inc()
+	start := time.Now()
+	defer func() {
+		UpdatePatronDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "UpdatePatron")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received UpdatePatron request.",
+		layerCont, "patron_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid UpdatePatron request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	update := entity.PatronUpdate{
+		Id:        req.GetId(),
+		Name:      req.Name,
+		Email:     req.Email,
+		Phone:     req.Phone,
+		ExpiresOn: req.ExpiresOn,
+	}
+	if req.Status != nil {
+		patronStatus := convertPatronStatus(req.GetStatus())
+		update.Status = &patronStatus
+	}
+
+	err := i.patronUseCase.UpdatePatron(ctx, update)
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to update patron.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.UpdatePatronResponse{}, nil
+}
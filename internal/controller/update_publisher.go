@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	UpdatePublisherDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_update_publisher_duration_ms",
+		Help:    "Duration of UpdatePublisher in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	UpdatePublisherRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_update_publisher_requests_total",
+		Help: "Total number of UpdatePublisher requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(UpdatePublisherDuration)
+	prometheus.MustRegister(UpdatePublisherRequests)
+}
+
+func (i *impl) UpdatePublisher(ctx context.Context, req *library.UpdatePublisherRequest) (*library.UpdatePublisherResponse, error) {
+	UpdatePublisherRequests.Inc()
+	start := time.Now()
+	defer func() {
+		UpdatePublisherDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "UpdatePublisher")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received UpdatePublisher request.",
+		layerCont, "publisher_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid UpdatePublisher request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err := i.publisherUseCase.UpdatePublisher(ctx, req.GetId(), req.GetName())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to update publisher.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.UpdatePublisherResponse{}, nil
+}
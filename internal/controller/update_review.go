@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	UpdateReviewDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_update_review_duration_ms",
+		Help:    "Duration of UpdateReview in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	UpdateReviewRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_update_review_requests_total",
+		Help: "Total number of UpdateReview requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(UpdateReviewDuration)
+	prometheus.MustRegister(UpdateReviewRequests)
+}
+
+func (i *impl) UpdateReview(ctx context.Context, req *library.UpdateReviewRequest) (*library.UpdateReviewResponse, error) {
+	UpdateReviewRequests.Inc()
+	start := time.Now()
+	defer func() {
+		UpdateReviewDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "UpdateReview")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received UpdateReview request.",
+		layerCont, "review_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid UpdateReview request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	review, err := i.reviewUseCase.UpdateReview(ctx, entity.ReviewUpdate{
+		Id:     req.GetId(),
+		Rating: req.Rating,
+		Text:   req.Text,
+	})
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to update review.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.UpdateReviewResponse{
+		Review: convertReviewToProto(review),
+	}, nil
+}
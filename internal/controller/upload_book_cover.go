@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	UploadBookCoverDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_upload_book_cover_duration_ms",
+		Help:    "Duration of UploadBookCover in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	UploadBookCoverRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_upload_book_cover_requests_total",
+		Help: "Total number of UploadBookCover requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(UploadBookCoverDuration)
+	prometheus.MustRegister(UploadBookCoverRequests)
+}
+
+var (
+	errCoverInfoRequired = status.Error(codes.InvalidArgument, "first message must contain cover info")
+	errCoverInfoRepeated = status.Error(codes.InvalidArgument, "cover info must be sent only in the first message")
+)
+
+type uploadBookCoverStream = grpc.ClientStreamingServer[library.UploadBookCoverRequest, library.UploadBookCoverResponse]
+
+func (i *impl) UploadBookCover(stream uploadBookCoverStream) error {
+	UploadBookCoverRequests.Inc()
+	start := time.Now()
+	defer func() {
+		UploadBookCoverDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(stream.Context(), "UploadBookCover")
+	defer span.End()
+
+	req, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		SendSpanStatusLoggerError(i.logger, ctx, "Empty UploadBookCover stream.", errCoverInfoRequired, codes.InvalidArgument)
+		return errCoverInfoRequired
+	}
+
+	if err != nil {
+		return err
+	}
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received UploadBookCover request.",
+		layerCont, "book_id", req.GetInfo().GetBookId())
+
+	if err = req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid UploadBookCover request.", err, codes.InvalidArgument)
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	info := req.GetInfo()
+	if info == nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid UploadBookCover request.", errCoverInfoRequired, codes.InvalidArgument)
+		return errCoverInfoRequired
+	}
+
+	content := &coverChunkReader{stream: stream}
+	cover, err := i.coverUseCase.UploadBookCover(ctx, entity.CoverUpload{
+		BookId:      info.GetBookId(),
+		ContentType: info.GetContentType(),
+	}, content)
+
+	// Ошибка чтения потока относится к клиенту, а не к сохранению обложки
+	if content.err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to receive book cover.", content.err, codes.InvalidArgument)
+		return content.err
+	}
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to upload book cover.", err, codes.Internal)
+		return i.ConvertErr(err)
+	}
+
+	return stream.SendAndClose(&library.UploadBookCoverResponse{
+		Cover: convertBookCoverToProto(cover),
+	})
+}
+
+// coverChunkReader читает содержимое обложки из сообщений потока после первого
+type coverChunkReader struct {
+	stream uploadBookCoverStream
+	chunk  []byte
+	err    error // Ошибка потока или сообщения, io.EOF не сохраняется
+}
+
+func (r *coverChunkReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return 0, io.EOF
+		}
+
+		if err != nil {
+			r.err = err
+			return 0, err
+		}
+
+		if err = req.ValidateAll(); err != nil {
+			r.err = status.Error(codes.InvalidArgument, err.Error())
+			return 0, r.err
+		}
+
+		if req.GetInfo() != nil {
+			r.err = errCoverInfoRepeated
+			return 0, r.err
+		}
+
+		r.chunk = req.GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+
+	return n, nil
+}
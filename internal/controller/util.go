@@ -3,15 +3,19 @@ package controller
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	otelCodes "go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/project/library/generated/api/library"
 	"github.com/project/library/internal/entity"
 )
 
@@ -20,16 +24,466 @@ func (i *impl) ConvertErr(err error) error {
 		return nil
 	}
 
+	var existsErr *entity.AlreadyExistsError
+
 	switch {
+	case errors.As(err, &existsErr):
+		return alreadyExistsStatus(existsErr)
+	case errors.Is(err, entity.ErrAuthorAlreadyExists), errors.Is(err, entity.ErrBookAlreadyExists),
+		errors.Is(err, entity.ErrGenreAlreadyExists), errors.Is(err, entity.ErrSeriesVolumeTaken),
+		errors.Is(err, entity.ErrCopyAlreadyExists), errors.Is(err, entity.ErrPatronAlreadyExists),
+		errors.Is(err, entity.ErrHoldAlreadyExists), errors.Is(err, entity.ErrChargeAlreadyExists),
+		errors.Is(err, entity.ErrReviewAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
 	case errors.Is(err, entity.ErrAuthorNotFound):
 		return status.Error(codes.NotFound, err.Error())
 	case errors.Is(err, entity.ErrBookNotFound):
 		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrGenreNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrPublisherNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrSeriesNotFound), errors.Is(err, entity.ErrBookNotInSeries):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrCopyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrPatronNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrLoanNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrHoldNotFound), errors.Is(err, entity.ErrNoWaitingHolds):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrChargeNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrReviewNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrCoverNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrAuthorHasBooks), errors.Is(err, entity.ErrCopyRetired),
+		errors.Is(err, entity.ErrCopyOnLoan), errors.Is(err, entity.ErrCopyNotOnLoan),
+		errors.Is(err, entity.ErrCopyUnavailable), errors.Is(err, entity.ErrLoanReturned),
+		errors.Is(err, entity.ErrPatronSuspended), errors.Is(err, entity.ErrPatronCardExpired),
+		errors.Is(err, entity.ErrPatronHasLoans), errors.Is(err, entity.ErrHoldClosed),
+		errors.Is(err, entity.ErrChargeSettled), errors.Is(err, entity.ErrReturnWithinGrace),
+		errors.Is(err, entity.ErrPatronHasCharges):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrBookRevisionMismatch), errors.Is(err, entity.ErrAuthorRevisionMismatch):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, entity.ErrInvalidPageToken), errors.Is(err, entity.ErrInvalidISBN),
+		errors.Is(err, entity.ErrInvalidLanguage), errors.Is(err, entity.ErrInvalidContributors),
+		errors.Is(err, entity.ErrInvalidDate), errors.Is(err, entity.ErrInvalidAuthorDates),
+		errors.Is(err, entity.ErrInvalidAuthorMerge), errors.Is(err, entity.ErrInvalidCopyStatus),
+		errors.Is(err, entity.ErrInvalidHoldFilter), errors.Is(err, entity.ErrReturnNotLate),
+		errors.Is(err, entity.ErrEmptyCover), errors.Is(err, entity.ErrCoverTooLarge),
+		errors.Is(err, entity.ErrInvalidCoverContentType), errors.Is(err, entity.ErrCoverContentTypeMismatch):
+		return status.Error(codes.InvalidArgument, err.Error())
 	default:
 		return status.Error(codes.Internal, err.Error())
 	}
 }
 
+// alreadyExistsStatus передает id существующей записи в деталях ошибки
+func alreadyExistsStatus(err *entity.AlreadyExistsError) error {
+	resourceType, _ := entity.AlreadyExistsResource(err.Err)
+
+	st, detailsErr := status.New(codes.AlreadyExists, err.Error()).
+		WithDetails(&errdetails.ResourceInfo{
+			ResourceType: resourceType,
+			ResourceName: err.Id,
+		})
+	if detailsErr != nil {
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	return st.Err()
+}
+
+func convertBookToProto(book *entity.Book) *library.Book {
+	return &library.Book{
+		Id:        book.Id,
+		Name:      book.Name,
+		AuthorIds: book.AuthorIds,
+		CreatedAt: timestamppb.New(book.CreatedAt),
+		UpdatedAt: timestamppb.New(book.UpdatedAt),
+		Isbn:      book.ISBN,
+
+		PublicationYear: book.PublicationYear,
+		Language:        book.Language,
+		PageCount:       book.PageCount,
+		Description:     book.Description,
+		GenreIds:        book.GenreIds,
+		PublisherId:     book.PublisherId,
+		Revision:        book.Revision,
+		Series:          convertBookSeriesToProto(book.Series),
+		Contributors:    convertContributorsToProto(book.Contributors),
+	}
+}
+
+func convertBookRevisionToProto(revision *entity.BookRevision) *library.BookRevision {
+	return &library.BookRevision{
+		Book:      convertBookToProto(revision.Book),
+		Deleted:   revision.Book.DeletedAt != nil,
+		RevisedAt: timestamppb.New(revision.RevisedAt),
+	}
+}
+
+func convertAuthorRevisionToProto(revision *entity.AuthorRevision) *library.AuthorRevision {
+	author := revision.Author
+
+	return &library.AuthorRevision{
+		Id:        author.Id,
+		Name:      author.Name,
+		SortName:  author.SortName,
+		Biography: author.Biography,
+		BirthDate: author.BirthDate,
+		DeathDate: author.DeathDate,
+		Aliases:   author.Aliases,
+		ExternalIds: &library.AuthorExternalIds{
+			Viaf:     author.ExternalIds.Viaf,
+			Wikidata: author.ExternalIds.Wikidata,
+		},
+		Revision:  author.Revision,
+		RevisedAt: timestamppb.New(revision.RevisedAt),
+	}
+}
+
+func convertAuthorExternalIds(ids *library.AuthorExternalIds) entity.AuthorExternalIds {
+	return entity.AuthorExternalIds{
+		Viaf:     ids.GetViaf(),
+		Wikidata: ids.GetWikidata(),
+	}
+}
+
+func convertContributorsToProto(contributors []entity.Contributor) []*library.Contributor {
+	result := make([]*library.Contributor, len(contributors))
+	for i, contributor := range contributors {
+		result[i] = &library.Contributor{
+			AuthorId: contributor.AuthorId,
+			Role:     convertContributorRoleToProto(contributor.Role),
+		}
+	}
+
+	return result
+}
+
+// convertContributorsFromProto возвращает nil, если участники не заданы и книга описана через author_ids
+func convertContributorsFromProto(contributors []*library.Contributor) []entity.Contributor {
+	if len(contributors) == 0 {
+		return nil
+	}
+
+	result := make([]entity.Contributor, len(contributors))
+	for i, contributor := range contributors {
+		result[i] = entity.Contributor{
+			AuthorId: contributor.GetAuthorId(),
+			Role:     convertContributorRole(contributor.GetRole()),
+		}
+	}
+
+	return result
+}
+
+func convertContributorRole(role library.ContributorRole) entity.ContributorRole {
+	switch role {
+	case library.ContributorRole_CONTRIBUTOR_ROLE_EDITOR:
+		return entity.ContributorRoleEditor
+	case library.ContributorRole_CONTRIBUTOR_ROLE_TRANSLATOR:
+		return entity.ContributorRoleTranslator
+	case library.ContributorRole_CONTRIBUTOR_ROLE_ILLUSTRATOR:
+		return entity.ContributorRoleIllustrator
+	default:
+		return entity.ContributorRoleAuthor
+	}
+}
+
+func convertContributorRoleToProto(role entity.ContributorRole) library.ContributorRole {
+	switch role {
+	case entity.ContributorRoleEditor:
+		return library.ContributorRole_CONTRIBUTOR_ROLE_EDITOR
+	case entity.ContributorRoleTranslator:
+		return library.ContributorRole_CONTRIBUTOR_ROLE_TRANSLATOR
+	case entity.ContributorRoleIllustrator:
+		return library.ContributorRole_CONTRIBUTOR_ROLE_ILLUSTRATOR
+	default:
+		return library.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR
+	}
+}
+
+func convertBookSeriesToProto(series []entity.BookSeries) []*library.BookSeries {
+	result := make([]*library.BookSeries, len(series))
+	for i, entry := range series {
+		result[i] = &library.BookSeries{
+			SeriesId: entry.SeriesId,
+			Volume:   entry.Volume,
+		}
+	}
+
+	return result
+}
+
+func convertSeriesToProto(series *entity.Series) *library.Series {
+	return &library.Series{
+		Id:        series.Id,
+		Name:      series.Name,
+		CreatedAt: timestamppb.New(series.CreatedAt),
+	}
+}
+
+func convertPublisherToProto(publisher *entity.Publisher) *library.Publisher {
+	return &library.Publisher{
+		Id:        publisher.Id,
+		Name:      publisher.Name,
+		CreatedAt: timestamppb.New(publisher.CreatedAt),
+		UpdatedAt: timestamppb.New(publisher.UpdatedAt),
+	}
+}
+
+func convertGenreToProto(genre *entity.Genre) *library.Genre {
+	return &library.Genre{
+		Id:        genre.Id,
+		Name:      genre.Name,
+		ParentId:  genre.ParentId,
+		CreatedAt: timestamppb.New(genre.CreatedAt),
+	}
+}
+
+func convertCopyToProto(bookCopy *entity.Copy) *library.Copy {
+	result := &library.Copy{
+		Id:        bookCopy.Id,
+		BookId:    bookCopy.BookId,
+		Barcode:   bookCopy.Barcode,
+		Branch:    bookCopy.Branch,
+		Location:  bookCopy.Location,
+		Condition: convertCopyConditionToProto(bookCopy.Condition),
+		Status:    convertCopyStatusToProto(bookCopy.Status),
+		CreatedAt: timestamppb.New(bookCopy.CreatedAt),
+		UpdatedAt: timestamppb.New(bookCopy.UpdatedAt),
+	}
+	if bookCopy.RetiredAt != nil {
+		result.RetiredAt = timestamppb.New(*bookCopy.RetiredAt)
+	}
+
+	return result
+}
+
+func convertCopyAvailabilityToProto(availability *entity.CopyAvailability) *library.CopyAvailability {
+	if availability == nil {
+		return nil
+	}
+
+	return &library.CopyAvailability{
+		Total:     availability.Total,
+		Available: availability.Available,
+	}
+}
+
+func convertBookCoverToProto(cover *entity.BookCover) *library.BookCover {
+	return &library.BookCover{
+		BookId:      cover.BookId,
+		ContentType: cover.ContentType,
+		Size:        cover.Size,
+		Sha256:      cover.SHA256,
+		UpdatedAt:   timestamppb.New(cover.UpdatedAt),
+	}
+}
+
+func convertBookRatingToProto(rating *entity.BookRating) *library.BookRating {
+	if rating == nil {
+		return nil
+	}
+
+	return &library.BookRating{
+		ReviewCount:   rating.ReviewCount,
+		AverageRating: rating.Average(),
+	}
+}
+
+func convertCopyCondition(condition library.CopyCondition) entity.CopyCondition {
+	switch condition {
+	case library.CopyCondition_COPY_CONDITION_NEW:
+		return entity.CopyConditionNew
+	case library.CopyCondition_COPY_CONDITION_FAIR:
+		return entity.CopyConditionFair
+	case library.CopyCondition_COPY_CONDITION_POOR:
+		return entity.CopyConditionPoor
+	default:
+		return entity.CopyConditionGood
+	}
+}
+
+func convertCopyConditionToProto(condition entity.CopyCondition) library.CopyCondition {
+	switch condition {
+	case entity.CopyConditionNew:
+		return library.CopyCondition_COPY_CONDITION_NEW
+	case entity.CopyConditionFair:
+		return library.CopyCondition_COPY_CONDITION_FAIR
+	case entity.CopyConditionPoor:
+		return library.CopyCondition_COPY_CONDITION_POOR
+	default:
+		return library.CopyCondition_COPY_CONDITION_GOOD
+	}
+}
+
+func convertCopyStatus(copyStatus library.CopyStatus) entity.CopyStatus {
+	switch copyStatus {
+	case library.CopyStatus_COPY_STATUS_IN_REPAIR:
+		return entity.CopyStatusInRepair
+	case library.CopyStatus_COPY_STATUS_LOST:
+		return entity.CopyStatusLost
+	case library.CopyStatus_COPY_STATUS_RETIRED:
+		return entity.CopyStatusRetired
+	default:
+		return entity.CopyStatusAvailable
+	}
+}
+
+func convertCopyStatusToProto(copyStatus entity.CopyStatus) library.CopyStatus {
+	switch copyStatus {
+	case entity.CopyStatusInRepair:
+		return library.CopyStatus_COPY_STATUS_IN_REPAIR
+	case entity.CopyStatusLost:
+		return library.CopyStatus_COPY_STATUS_LOST
+	case entity.CopyStatusRetired:
+		return library.CopyStatus_COPY_STATUS_RETIRED
+	default:
+		return library.CopyStatus_COPY_STATUS_AVAILABLE
+	}
+}
+
+func convertPatronToProto(patron *entity.Patron) *library.Patron {
+	return &library.Patron{
+		Id:         patron.Id,
+		CardNumber: patron.CardNumber,
+		Name:       patron.Name,
+		Email:      patron.Email,
+		Phone:      patron.Phone,
+		Status:     convertPatronStatusToProto(patron.Status),
+		ExpiresOn:  patron.ExpiresOn,
+		CreatedAt:  timestamppb.New(patron.CreatedAt),
+		UpdatedAt:  timestamppb.New(patron.UpdatedAt),
+	}
+}
+
+func convertPatronStatus(patronStatus library.PatronStatus) entity.PatronStatus {
+	if patronStatus == library.PatronStatus_PATRON_STATUS_SUSPENDED {
+		return entity.PatronStatusSuspended
+	}
+
+	return entity.PatronStatusActive
+}
+
+func convertPatronStatusToProto(patronStatus entity.PatronStatus) library.PatronStatus {
+	if patronStatus == entity.PatronStatusSuspended {
+		return library.PatronStatus_PATRON_STATUS_SUSPENDED
+	}
+
+	return library.PatronStatus_PATRON_STATUS_ACTIVE
+}
+
+func convertLoanToProto(loan *entity.Loan) *library.Loan {
+	result := &library.Loan{
+		Id:           loan.Id,
+		CopyId:       loan.CopyId,
+		BookId:       loan.BookId,
+		PatronId:     loan.PatronId,
+		CheckedOutAt: timestamppb.New(loan.CheckedOutAt),
+		DueAt:        timestamppb.New(loan.DueAt),
+		Renewals:     loan.Renewals,
+	}
+	if loan.ReturnedAt != nil {
+		result.ReturnedAt = timestamppb.New(*loan.ReturnedAt)
+	}
+
+	return result
+}
+
+func convertHoldToProto(hold *entity.Hold) *library.Hold {
+	result := &library.Hold{
+		Id:       hold.Id,
+		BookId:   hold.BookId,
+		PatronId: hold.PatronId,
+		Status:   convertHoldStatusToProto(hold.Status),
+		PlacedAt: timestamppb.New(hold.PlacedAt),
+	}
+	if hold.ReadyAt != nil {
+		result.ReadyAt = timestamppb.New(*hold.ReadyAt)
+	}
+
+	if hold.ExpiresAt != nil {
+		result.ExpiresAt = timestamppb.New(*hold.ExpiresAt)
+	}
+
+	return result
+}
+
+func convertHoldStatusToProto(holdStatus entity.HoldStatus) library.HoldStatus {
+	switch holdStatus {
+	case entity.HoldStatusReady:
+		return library.HoldStatus_HOLD_STATUS_READY
+	case entity.HoldStatusFulfilled:
+		return library.HoldStatus_HOLD_STATUS_FULFILLED
+	case entity.HoldStatusCancelled:
+		return library.HoldStatus_HOLD_STATUS_CANCELLED
+	case entity.HoldStatusExpired:
+		return library.HoldStatus_HOLD_STATUS_EXPIRED
+	default:
+		return library.HoldStatus_HOLD_STATUS_WAITING
+	}
+}
+
+func convertChargeToProto(charge *entity.Charge) *library.Charge {
+	result := &library.Charge{
+		Id:           charge.Id,
+		PatronId:     charge.PatronId,
+		BookId:       charge.BookId,
+		DueAt:        timestamppb.New(charge.DueAt),
+		ReturnedAt:   timestamppb.New(charge.ReturnedAt),
+		DaysLate:     charge.DaysLate,
+		Amount:       charge.Amount.String(),
+		Status:       convertChargeStatusToProto(charge.Status),
+		WaiverReason: charge.WaiverReason,
+		CreatedAt:    timestamppb.New(charge.CreatedAt),
+	}
+	if charge.SettledAt != nil {
+		result.SettledAt = timestamppb.New(*charge.SettledAt)
+	}
+
+	return result
+}
+
+func convertReviewToProto(review *entity.Review) *library.Review {
+	return &library.Review{
+		Id:        review.Id,
+		BookId:    review.BookId,
+		PatronId:  review.PatronId,
+		Rating:    review.Rating,
+		Text:      review.Text,
+		CreatedAt: timestamppb.New(review.CreatedAt),
+		UpdatedAt: timestamppb.New(review.UpdatedAt),
+	}
+}
+
+func convertChargeStatusToProto(chargeStatus entity.ChargeStatus) library.ChargeStatus {
+	switch chargeStatus {
+	case entity.ChargeStatusPaid:
+		return library.ChargeStatus_CHARGE_STATUS_PAID
+	case entity.ChargeStatusWaived:
+		return library.ChargeStatus_CHARGE_STATUS_WAIVED
+	default:
+		return library.ChargeStatus_CHARGE_STATUS_OUTSTANDING
+	}
+}
+
+// optionalTime возвращает nil для незаданного в запросе времени
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+	return &t
+}
+
 func SendAddBookLoggerInfo(logger *zap.Logger, ctx context.Context, message, arg1, arg2 string, strings []string) {
 	logger.Info(message,
 		zap.String("trace_id", trace.SpanFromContext(ctx).SpanContext().TraceID().String()),
@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/generated/api/library"
+)
+
+var (
+	WaiveChargeDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "library_waive_charge_duration_ms",
+		Help:    "Duration of WaiveCharge in ms",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	WaiveChargeRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "library_waive_charge_requests_total",
+		Help: "Total number of WaiveCharge requests",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(WaiveChargeDuration)
+	prometheus.MustRegister(WaiveChargeRequests)
+}
+
+func (i *impl) WaiveCharge(ctx context.Context, req *library.WaiveChargeRequest) (*library.WaiveChargeResponse, error) {
+	WaiveChargeRequests.Inc()
+	start := time.Now()
+	defer func() {
+		WaiveChargeDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	ctx, span := CreateTracerSpan(ctx, "WaiveCharge")
+	defer span.End()
+
+	entity.SendLoggerInfoWithCondition(i.logger, ctx, "Received WaiveCharge request.",
+		layerCont, "charge_id", req.GetId())
+
+	if err := req.ValidateAll(); err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Invalid WaiveCharge request.", err, codes.InvalidArgument)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	charge, err := i.chargeUseCase.WaiveCharge(ctx, req.GetId(), req.GetReason())
+
+	if err != nil {
+		SendSpanStatusLoggerError(i.logger, ctx, "Failed to waive charge.", err, codes.Internal)
+		return nil, i.ConvertErr(err)
+	}
+
+	return &library.WaiveChargeResponse{
+		Charge: convertChargeToProto(charge),
+	}, nil
+}
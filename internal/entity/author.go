@@ -1,16 +1,126 @@
 package entity
 
 import (
+	"time"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type Author struct {
+	Id        string
+	Name      string // В форме NFC
+	SortName  string // Ключ сортировки списков: "Tolkien, J.R.R."
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Revision  int64 // Растет при каждом изменении автора
+
+	Biography   *string
+	BirthDate   *string  // Дата в формате YYYY-MM-DD
+	DeathDate   *string  // Не раньше даты рождения
+	Aliases     []string // Псевдонимы, SearchAuthors ищет и по ним
+	ExternalIds AuthorExternalIds
+}
+
+// AuthorExternalIds - идентификаторы автора во внешних каталогах, пустая строка - не задан
+type AuthorExternalIds struct {
+	Viaf     string `json:"viaf,omitempty"`
+	Wikidata string `json:"wikidata,omitempty"` // QID, например Q42
+}
+
+// AuthorUpdate описывает изменение автора: имя заменяется всегда,
+// остальные поля со значением nil не меняются
+type AuthorUpdate struct {
 	Id   string
 	Name string
+
+	Biography   *string            // Пустая строка удаляет биографию
+	BirthDate   *string            // Пустая строка удаляет дату
+	DeathDate   *string            // Пустая строка удаляет дату
+	Aliases     *[]string          // Заменяют псевдонимы целиком, пустой список удаляет все
+	ExternalIds *AuthorExternalIds // Заменяют идентификаторы целиком
+
+	ExpectedRevision *int64 // Задано - автор меняется, только если его Revision совпадает
+}
+
+// AuthorFilter задает выборку ListAuthors
+type AuthorFilter struct {
+	NamePrefix    string
+	WithBookCount bool
+	PageSize      int
+	PageToken     string
+}
+
+type AuthorEntry struct {
+	Author    *Author
+	BookCount *int64 // nil, если количество книг не запрашивалось
+}
+
+type AuthorPage struct {
+	Authors       []*AuthorEntry
+	NextPageToken string
+}
+
+// AuthorSearch задает нечеткий поиск авторов по имени
+type AuthorSearch struct {
+	Query     string
+	Threshold float32 // 0 - порог из конфигурации
+	Limit     int     // 0 - значение по умолчанию
+}
+
+type AuthorMatch struct {
+	Author     *Author
+	Similarity float32
+}
+
+// DeleteAuthorPolicy определяет судьбу книг удаляемого автора
+type DeleteAuthorPolicy int
+
+const (
+	DeleteAuthorPolicyRestrict DeleteAuthorPolicy = iota
+	DeleteAuthorPolicyDetach
+	DeleteAuthorPolicyCascade
+)
+
+// AuthorRemoval описывает последствия удаления автора
+type AuthorRemoval struct {
+	Author        *Author
+	DetachedBooks []*Book // Книги, у которых остались другие авторы
+	DeletedBooks  []*Book // Книги, оставшиеся без авторов и удаленные по CASCADE
+}
+
+// AuthorMerge описывает результат слияния дубликатов с основной записью автора
+type AuthorMerge struct {
+	Target    *Author  // Получил книги и, как псевдонимы, имена дубликатов
+	SourceIds []string // Удалены, GetAuthorInfo по ним возвращает Target
+	Books     []*Book  // Книги, связи которых перенесены на Target
+	MergedAt  time.Time
+}
+
+// AuthorRedirect - событие outbox о слиянии: автор SourceId теперь доступен как TargetId
+type AuthorRedirect struct {
+	SourceId string
+	TargetId string
 }
 
 var (
-	ErrAuthorNotFound      = status.Error(codes.NotFound, "author not found")
-	ErrAuthorAlreadyExists = status.Error(codes.AlreadyExists, "author already exists")
+	ErrAuthorNotFound         = status.Error(codes.NotFound, "author not found")
+	ErrAuthorAlreadyExists    = status.Error(codes.AlreadyExists, "author already exists")
+	ErrAuthorHasBooks         = status.Error(codes.FailedPrecondition, "author still has books")
+	ErrAuthorRevisionMismatch = status.Error(codes.Aborted, "author was changed, revision does not match expected_revision")
+	ErrInvalidDate            = status.Error(codes.InvalidArgument, "invalid date, expected YYYY-MM-DD")
+	ErrInvalidAuthorDates     = status.Error(codes.InvalidArgument, "death date is before birth date")
+	ErrInvalidAuthorMerge     = status.Error(codes.InvalidArgument, "author cannot be merged into itself")
 )
+
+const dateLayout = "2006-01-02"
+
+// NormalizeDate проверяет дату в формате YYYY-MM-DD: "2023-02-30" не существует
+func NormalizeDate(date string) (string, error) {
+	parsed, err := time.Parse(dateLayout, date)
+	if err != nil {
+		return "", ErrInvalidDate
+	}
+
+	return parsed.Format(dateLayout), nil
+}
@@ -13,9 +13,108 @@ type Book struct {
 	AuthorIds []string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt *time.Time
+	Revision  int64   // Растет при каждом изменении книги
+	ISBN      *string // ISBN-13, nil - не задан
+	GenreIds  []string
+	Series    []BookSeries // Серии книги и номера томов в них
+
+	// Участники в порядке ordinal, первый - основной автор. AuthorIds содержит их id в том же порядке
+	Contributors []Contributor
+
+	PublicationYear *int32
+	Language        *string // Тег BCP-47 в канонической записи
+	PageCount       *int32
+	Description     *string
+	PublisherId     *string
+
+	Availability *CopyAvailability // Заполняется только GetBook
+	Rating       *BookRating       // Заполняется только GetBook
+}
+
+// BookUpdate описывает изменение книги: название и авторы заменяются целиком,
+// необязательные поля со значением nil не меняются
+type BookUpdate struct {
+	Id        string
+	Name      string
+	AuthorIds []string
+	ISBN      *string // Пустая строка удаляет ISBN
+
+	Contributors []Contributor // Заменяют AuthorIds, если заданы
+
+	PublicationYear *int32  // 0 удаляет год
+	Language        *string // Пустая строка удаляет язык
+	PageCount       *int32  // 0 удаляет количество страниц
+	Description     *string // Пустая строка удаляет описание
+	PublisherId     *string // Пустая строка отвязывает издательство
+
+	ExpectedRevision *int64 // Задано - книга меняется, только если ее Revision совпадает
+}
+
+type BookOrder int
+
+const (
+	BookOrderCreatedAtAsc BookOrder = iota
+	BookOrderCreatedAtDesc
+	BookOrderNameAsc
+	BookOrderNameDesc
+)
+
+func (o BookOrder) String() string {
+	switch o {
+	case BookOrderCreatedAtDesc:
+		return "created_at_desc"
+	case BookOrderNameAsc:
+		return "name_asc"
+	case BookOrderNameDesc:
+		return "name_desc"
+	default:
+		return "created_at_asc"
+	}
+}
+
+// BookFilter задает выборку ListBooks. Пустые поля не ограничивают выдачу.
+type BookFilter struct {
+	NamePrefix    string
+	AuthorIds     []string // Книга должна иметь хотя бы одного из авторов
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	UpdatedAfter  *time.Time
+	UpdatedBefore *time.Time
+	YearFrom      *int32 // Включительно
+	YearTo        *int32 // Включительно
+	Language      string
+	GenreIds      []string // Книга должна иметь хотя бы один из жанров или их поджанров
+	OrderBy       BookOrder
+	PageSize      int
+	PageToken     string
+}
+
+type BookPage struct {
+	Books         []*Book
+	NextPageToken string
+}
+
+// BookSearch задает полнотекстовый поиск по названиям книг
+type BookSearch struct {
+	Query     string
+	PageSize  int
+	PageToken string
+}
+
+type BookSearchHit struct {
+	Book    *Book
+	Rank    float32
+	Snippet string
+}
+
+type BookSearchPage struct {
+	Hits          []*BookSearchHit
+	NextPageToken string
 }
 
 var (
-	ErrBookNotFound      = status.Error(codes.NotFound, "book not found")
-	ErrBookAlreadyExists = status.Error(codes.AlreadyExists, "book already exists")
+	ErrBookNotFound         = status.Error(codes.NotFound, "book not found")
+	ErrBookAlreadyExists    = status.Error(codes.AlreadyExists, "book already exists")
+	ErrBookRevisionMismatch = status.Error(codes.Aborted, "book was changed, revision does not match expected_revision")
 )
@@ -0,0 +1,159 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/project/library/internal/money"
+)
+
+// Charge - запись журнала штрафов читателя за просроченный возврат книги
+type Charge struct {
+	Id           string
+	PatronId     string
+	BookId       string
+	DueAt        time.Time
+	ReturnedAt   time.Time
+	DaysLate     int32
+	Amount       Money
+	Status       ChargeStatus
+	WaiverReason *string    // Задана только у списанного штрафа
+	SettledAt    *time.Time // Когда штраф оплачен или списан
+	RemindedAt   *time.Time // Последнее напоминание об оплате
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// ChargeStatus описывает состояние штрафа
+type ChargeStatus int
+
+const (
+	ChargeStatusOutstanding ChargeStatus = iota
+	ChargeStatusPaid
+	ChargeStatusWaived
+)
+
+// String возвращает значение, которое хранится в charge.status
+func (s ChargeStatus) String() string {
+	switch s {
+	case ChargeStatusPaid:
+		return "paid"
+	case ChargeStatusWaived:
+		return "waived"
+	default:
+		return "outstanding"
+	}
+}
+
+// MarshalText сериализует статус строкой в сообщениях outbox
+func (s ChargeStatus) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText разбирает строку из сообщения outbox
+func (s *ChargeStatus) UnmarshalText(text []byte) error {
+	*s = ParseChargeStatus(string(text))
+	return nil
+}
+
+// ParseChargeStatus разбирает значение charge.status
+func ParseChargeStatus(status string) ChargeStatus {
+	switch status {
+	case "paid":
+		return ChargeStatusPaid
+	case "waived":
+		return ChargeStatusWaived
+	default:
+		return ChargeStatusOutstanding
+	}
+}
+
+// Money - неотрицательная сумма в сотых долях денежной единицы. Штрафы считаются в целых числах без округлений
+type Money int64
+
+// String возвращает сумму с двумя знаками после точки: 1250 -> "12.50"
+func (m Money) String() string {
+	return fmt.Sprintf("%d.%02d", m/100, m%100)
+}
+
+// MarshalText сериализует сумму десятичной строкой, чтобы получатели outbox не теряли точность
+func (m Money) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText разбирает сумму из сообщения outbox
+func (m *Money) UnmarshalText(text []byte) error {
+	amount, err := ParseMoney(string(text))
+	if err != nil {
+		return err
+	}
+
+	*m = amount
+	return nil
+}
+
+// ParseMoney разбирает сумму с точностью до сотых: "12.5" -> 1250
+func ParseMoney(s string) (Money, error) {
+	amount, err := money.Parse(s)
+	return Money(amount), err
+}
+
+// MaxMoney - наибольшая сумма, которую вмещает charge.amount NUMERIC(12,2)
+const MaxMoney Money = 999_999_999_999
+
+// FinePolicy - правила начисления штрафа за просрочку
+type FinePolicy struct {
+	DailyRate Money
+	GraceDays int
+	MaxAmount Money // 0 - не больше MaxMoney
+}
+
+// Fine считает дни просрочки и штраф. Начатый день просрочки считается целым,
+// первые GraceDays дней не оплачиваются, штраф не превышает MaxAmount и MaxMoney
+func (p FinePolicy) Fine(dueAt time.Time, returnedAt time.Time) (int32, Money) {
+	overdue := returnedAt.Sub(dueAt)
+	if overdue <= 0 {
+		return 0, 0
+	}
+
+	daysLate := int64((overdue + 24*time.Hour - 1) / (24 * time.Hour))
+
+	chargeableDays := daysLate - int64(p.GraceDays)
+	if chargeableDays <= 0 {
+		return int32(daysLate), 0
+	}
+
+	maxAmount := p.MaxAmount
+	if maxAmount == 0 || maxAmount > MaxMoney {
+		maxAmount = MaxMoney
+	}
+
+	return int32(daysLate), min(Money(chargeableDays)*p.DailyRate, maxAmount)
+}
+
+// LateReturn описывает просроченный возврат, за который начисляется штраф
+type LateReturn struct {
+	PatronId   string
+	BookId     string
+	DueAt      time.Time
+	ReturnedAt time.Time
+}
+
+// ChargeFilter задает выборку ListCharges
+type ChargeFilter struct {
+	PatronId       string
+	BookId         string // Пустая строка - все книги
+	IncludeSettled bool   // Включать оплаченные и списанные штрафы
+}
+
+var (
+	ErrChargeNotFound      = status.Error(codes.NotFound, "charge not found")
+	ErrChargeAlreadyExists = status.Error(codes.AlreadyExists, "late return is already charged")
+	ErrChargeSettled       = status.Error(codes.FailedPrecondition, "charge is already paid or waived")
+	ErrReturnNotLate       = status.Error(codes.InvalidArgument, "returned_at must be after due_at")
+	ErrReturnWithinGrace   = status.Error(codes.FailedPrecondition, "return is within grace period, no fine is due")
+	ErrPatronHasCharges    = status.Error(codes.FailedPrecondition, "patron has outstanding charges")
+)
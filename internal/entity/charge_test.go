@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinePolicyFine(t *testing.T) {
+	t.Parallel()
+
+	dueAt := time.Date(2025, time.March, 1, 18, 0, 0, 0, time.UTC)
+	policy := FinePolicy{DailyRate: 25, GraceDays: 1, MaxAmount: 1000}
+
+	tests := []struct {
+		name         string
+		policy       FinePolicy
+		returnedAt   time.Time
+		wantDaysLate int32
+		wantAmount   Money
+	}{
+		{
+			name:       "returned on time",
+			policy:     policy,
+			returnedAt: dueAt,
+		},
+		{
+			name:         "within grace period",
+			policy:       policy,
+			returnedAt:   dueAt.Add(time.Hour),
+			wantDaysLate: 1,
+		},
+		{
+			name:         "started day counts as whole",
+			policy:       policy,
+			returnedAt:   dueAt.Add(48*time.Hour + time.Minute),
+			wantDaysLate: 3,
+			wantAmount:   50,
+		},
+		{
+			name:         "capped",
+			policy:       policy,
+			returnedAt:   dueAt.AddDate(0, 3, 0),
+			wantDaysLate: 92,
+			wantAmount:   1000,
+		},
+		{
+			name:         "no cap",
+			policy:       FinePolicy{DailyRate: 10},
+			returnedAt:   dueAt.AddDate(1, 0, 0),
+			wantDaysLate: 365,
+			wantAmount:   3650,
+		},
+		{
+			name:         "no cap | limited by column",
+			policy:       FinePolicy{DailyRate: 429_496_729_599},
+			returnedAt:   dueAt.AddDate(0, 0, 3),
+			wantDaysLate: 3,
+			wantAmount:   MaxMoney,
+		},
+		{
+			name:         "cap above column limit",
+			policy:       FinePolicy{DailyRate: 429_496_729_599, MaxAmount: MaxMoney + 1},
+			returnedAt:   dueAt.AddDate(0, 0, 3),
+			wantDaysLate: 3,
+			wantAmount:   MaxMoney,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			daysLate, amount := test.policy.Fine(dueAt, test.returnedAt)
+			assert.Equal(t, test.wantDaysLate, daysLate)
+			assert.Equal(t, test.wantAmount, amount)
+		})
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value   string
+		want    Money
+		wantErr bool
+	}{
+		{value: "12.50", want: 1250},
+		{value: "0.1", want: 10},
+		{value: "7", want: 700},
+		{value: "0.125", wantErr: true},
+		{value: "1.", wantErr: true},
+		{value: "-1.00", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			t.Parallel()
+
+			amount, err := ParseMoney(test.value)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.want, amount)
+		})
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "12.50", Money(1250).String())
+	assert.Equal(t, "0.05", Money(5).String())
+	assert.Equal(t, "0.00", Money(0).String())
+}
@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ContributorRole описывает участие автора в книге
+type ContributorRole int
+
+const (
+	ContributorRoleAuthor ContributorRole = iota
+	ContributorRoleEditor
+	ContributorRoleTranslator
+	ContributorRoleIllustrator
+)
+
+// String возвращает значение, которое хранится в author_book.role
+func (r ContributorRole) String() string {
+	switch r {
+	case ContributorRoleEditor:
+		return "editor"
+	case ContributorRoleTranslator:
+		return "translator"
+	case ContributorRoleIllustrator:
+		return "illustrator"
+	default:
+		return "author"
+	}
+}
+
+// MarshalText сериализует роль строкой, как в сообщениях outbox
+func (r ContributorRole) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText разбирает строку из сообщения outbox
+func (r *ContributorRole) UnmarshalText(text []byte) error {
+	*r = ParseContributorRole(string(text))
+	return nil
+}
+
+// ParseContributorRole разбирает значение author_book.role
+func ParseContributorRole(role string) ContributorRole {
+	switch role {
+	case "editor":
+		return ContributorRoleEditor
+	case "translator":
+		return ContributorRoleTranslator
+	case "illustrator":
+		return ContributorRoleIllustrator
+	default:
+		return ContributorRoleAuthor
+	}
+}
+
+type Contributor struct {
+	AuthorId string
+	Role     ContributorRole
+}
+
+var (
+	ErrInvalidContributors = status.Error(codes.InvalidArgument,
+		"author_ids and contributors can not be combined, each author must be listed once")
+)
+
+// ResolveContributors приводит авторов книги к списку участников.
+// author_ids сохранены для обратной совместимости и означают участников с ролью автора.
+func ResolveContributors(authorIds []string, contributors []Contributor) ([]Contributor, error) {
+	if len(contributors) > 0 && len(authorIds) > 0 {
+		return nil, ErrInvalidContributors
+	}
+
+	if len(contributors) == 0 {
+		contributors = make([]Contributor, len(authorIds))
+		for i, authorId := range authorIds {
+			contributors[i] = Contributor{AuthorId: authorId, Role: ContributorRoleAuthor}
+		}
+	}
+
+	seen := make(map[string]struct{}, len(contributors))
+	for _, contributor := range contributors {
+		key := strings.ToLower(contributor.AuthorId)
+		if _, ok := seen[key]; ok {
+			return nil, ErrInvalidContributors
+		}
+		seen[key] = struct{}{}
+	}
+
+	return contributors, nil
+}
+
+// ContributorIds возвращает id участников в порядке списка
+func ContributorIds(contributors []Contributor) []string {
+	ids := make([]string, len(contributors))
+	for i, contributor := range contributors {
+		ids[i] = contributor.AuthorId
+	}
+
+	return ids
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	// Книга уходит в outbox как JSON, обработчик должен прочитать роли обратно
+	book := Book{
+		Id:        "7c5a3b1e-4a0f-4a55-8d4b-2f0d6f3b8e11",
+		Name:      "The Lord of the Rings",
+		AuthorIds: []string{"1b9d6bcd-bbfd-4b2d-9b5d-ab8dfbbd4bed", "6ec0bd7f-11c0-43da-975e-2a8ad9ebae0b"},
+		CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		UpdatedAt: time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC),
+		Contributors: []Contributor{
+			{AuthorId: "1b9d6bcd-bbfd-4b2d-9b5d-ab8dfbbd4bed", Role: ContributorRoleAuthor},
+			{AuthorId: "6ec0bd7f-11c0-43da-975e-2a8ad9ebae0b", Role: ContributorRoleTranslator},
+		},
+	}
+
+	data, err := json.Marshal(book)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"Role":"translator"`)
+
+	var got Book
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, book, got)
+}
@@ -0,0 +1,127 @@
+package entity
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Copy - экземпляр книги на полке филиала
+type Copy struct {
+	Id        string
+	BookId    string
+	Barcode   string // Уникален среди всех экземпляров, в том числе списанных
+	Branch    string // Код филиала
+	Location  *string
+	Condition CopyCondition
+	Status    CopyStatus
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	RetiredAt *time.Time
+}
+
+// CopyCondition описывает физическое состояние экземпляра
+type CopyCondition int
+
+const (
+	CopyConditionGood CopyCondition = iota
+	CopyConditionNew
+	CopyConditionFair
+	CopyConditionPoor
+)
+
+// String возвращает значение, которое хранится в book_copy.condition
+func (c CopyCondition) String() string {
+	switch c {
+	case CopyConditionNew:
+		return "new"
+	case CopyConditionFair:
+		return "fair"
+	case CopyConditionPoor:
+		return "poor"
+	default:
+		return "good"
+	}
+}
+
+// ParseCopyCondition разбирает значение book_copy.condition
+func ParseCopyCondition(condition string) CopyCondition {
+	switch condition {
+	case "new":
+		return CopyConditionNew
+	case "fair":
+		return CopyConditionFair
+	case "poor":
+		return CopyConditionPoor
+	default:
+		return CopyConditionGood
+	}
+}
+
+// CopyStatus описывает доступность экземпляра
+type CopyStatus int
+
+const (
+	CopyStatusAvailable CopyStatus = iota
+	CopyStatusInRepair
+	CopyStatusLost
+	CopyStatusRetired // Устанавливается только RetireCopy
+)
+
+// String возвращает значение, которое хранится в book_copy.status
+func (s CopyStatus) String() string {
+	switch s {
+	case CopyStatusInRepair:
+		return "in_repair"
+	case CopyStatusLost:
+		return "lost"
+	case CopyStatusRetired:
+		return "retired"
+	default:
+		return "available"
+	}
+}
+
+// ParseCopyStatus разбирает значение book_copy.status
+func ParseCopyStatus(status string) CopyStatus {
+	switch status {
+	case "in_repair":
+		return CopyStatusInRepair
+	case "lost":
+		return CopyStatusLost
+	case "retired":
+		return CopyStatusRetired
+	default:
+		return CopyStatusAvailable
+	}
+}
+
+// CopyUpdate описывает изменение экземпляра, поля со значением nil не меняются
+type CopyUpdate struct {
+	Id        string
+	Branch    *string
+	Location  *string // Пустая строка удаляет расположение
+	Condition *CopyCondition
+	Status    *CopyStatus // RETIRED недопустим, для списания есть RetireCopy
+}
+
+// CopyFilter задает выборку ListBookCopies
+type CopyFilter struct {
+	BookId         string
+	Branch         string // Пустая строка - все филиалы
+	IncludeRetired bool
+}
+
+// CopyAvailability - сводка по экземплярам книги, списанные не учитываются, выданные не считаются доступными
+type CopyAvailability struct {
+	Total     int64
+	Available int64
+}
+
+var (
+	ErrCopyNotFound      = status.Error(codes.NotFound, "copy not found")
+	ErrCopyAlreadyExists = status.Error(codes.AlreadyExists, "copy with this barcode already exists")
+	ErrCopyRetired       = status.Error(codes.FailedPrecondition, "copy is retired")
+	ErrInvalidCopyStatus = status.Error(codes.InvalidArgument, "copy can be retired only by RetireCopy")
+)
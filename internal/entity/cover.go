@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	MaxCoverSize = 5 << 20 // 5 МиБ
+
+	// Столько байт начала содержимого использует http.DetectContentType
+	coverSniffLen = 512
+)
+
+// Типы обложек, которые распознает http.DetectContentType
+var coverContentTypes = []string{"image/jpeg", "image/png", "image/webp"}
+
+// BookCover описывает обложку книги, содержимое хранится в BlobStorage по SHA-256
+type BookCover struct {
+	BookId      string
+	ContentType string
+	Size        int64
+	SHA256      string // В hex
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// CoverUpload - описание обложки, которое клиент передает перед содержимым
+type CoverUpload struct {
+	BookId      string
+	ContentType string
+}
+
+// Blob - сохраненное содержимое, одинаковое содержимое хранится один раз
+type Blob struct {
+	SHA256 string // В hex
+	Size   int64
+}
+
+var (
+	ErrCoverNotFound            = status.Error(codes.NotFound, "book cover not found")
+	ErrBlobNotFound             = errors.New("blob not found")
+	ErrEmptyCover               = status.Error(codes.InvalidArgument, "book cover is empty")
+	ErrCoverTooLarge            = status.Error(codes.InvalidArgument, "book cover is too large")
+	ErrInvalidCoverContentType  = status.Error(codes.InvalidArgument, "unsupported book cover content type")
+	ErrCoverContentTypeMismatch = status.Error(codes.InvalidArgument, "book cover content does not match content type")
+)
+
+// CheckCoverContent сверяет начало содержимого с заявленным типом и возвращает reader,
+// который читает содержимое целиком, но не больше MaxCoverSize
+func CheckCoverContent(content io.Reader, contentType string) (io.Reader, error) {
+	if !slices.Contains(coverContentTypes, contentType) {
+		return nil, ErrInvalidCoverContentType
+	}
+
+	buffered := bufio.NewReaderSize(content, coverSniffLen)
+	head, err := buffered.Peek(coverSniffLen)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	if len(head) == 0 {
+		return nil, ErrEmptyCover
+	}
+
+	if http.DetectContentType(head) != contentType {
+		return nil, ErrCoverContentTypeMismatch
+	}
+
+	return &coverSizeLimiter{reader: buffered}, nil
+}
+
+// coverSizeLimiter возвращает ErrCoverTooLarge, как только прочитано больше MaxCoverSize байт
+type coverSizeLimiter struct {
+	reader io.Reader
+	read   int64
+}
+
+func (c *coverSizeLimiter) Read(p []byte) (int, error) {
+	n, err := c.reader.Read(p)
+	c.read += int64(n)
+	if c.read > MaxCoverSize {
+		return n, ErrCoverTooLarge
+	}
+
+	return n, err
+}
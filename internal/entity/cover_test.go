@@ -0,0 +1,88 @@
+package entity
+
+import (
+	"bytes"
+	"io"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n")
+
+func TestCheckCoverContent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		content     []byte
+		contentType string
+		wantErr     error
+		wantReadErr error
+	}{
+		{
+			name:        "png",
+			content:     slices.Concat(pngHeader, bytes.Repeat([]byte{1}, 2048)),
+			contentType: "image/png",
+		},
+		{
+			name:        "jpeg",
+			content:     []byte("\xFF\xD8\xFF\xE0 jpeg"),
+			contentType: "image/jpeg",
+		},
+		{
+			name:        "unsupported content type",
+			content:     []byte("GIF89a"),
+			contentType: "image/gif",
+			wantErr:     ErrInvalidCoverContentType,
+		},
+		{
+			name:        "content does not match content type",
+			content:     pngHeader,
+			contentType: "image/jpeg",
+			wantErr:     ErrCoverContentTypeMismatch,
+		},
+		{
+			name:        "not an image",
+			content:     []byte("<html></html>"),
+			contentType: "image/png",
+			wantErr:     ErrCoverContentTypeMismatch,
+		},
+		{
+			name:        "empty",
+			contentType: "image/png",
+			wantErr:     ErrEmptyCover,
+		},
+		{
+			name:        "too large",
+			content:     slices.Concat(pngHeader, make([]byte, MaxCoverSize)),
+			contentType: "image/png",
+			wantReadErr: ErrCoverTooLarge,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			reader, err := CheckCoverContent(bytes.NewReader(test.content), test.contentType)
+			if test.wantErr != nil {
+				require.ErrorIs(t, err, test.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			read, err := io.ReadAll(reader)
+			if test.wantReadErr != nil {
+				require.ErrorIs(t, err, test.wantReadErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.content, read)
+		})
+	}
+}
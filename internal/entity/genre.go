@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Genre struct {
+	Id        string
+	Name      string
+	ParentId  *string // nil у корневого жанра
+	CreatedAt time.Time
+}
+
+// GenreFilter задает выборку ListGenres
+type GenreFilter struct {
+	ParentId *string // nil - все жанры, иначе только прямые поджанры
+}
+
+// BookGenre описывает привязку жанра к книге
+type BookGenre struct {
+	BookId     string
+	GenreId    string
+	AttachedAt time.Time
+}
+
+var (
+	ErrGenreNotFound      = status.Error(codes.NotFound, "genre not found")
+	ErrGenreAlreadyExists = status.Error(codes.AlreadyExists, "genre already exists")
+)
@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Hold - место читателя в очереди на книгу
+type Hold struct {
+	Id        string
+	BookId    string
+	PatronId  string
+	Status    HoldStatus
+	PlacedAt  time.Time  // Очередь книги упорядочена по этому времени
+	ReadyAt   *time.Time // Когда бронь стала готовой к выдаче
+	ExpiresAt *time.Time // До какого момента готовая бронь ждет читателя
+	UpdatedAt time.Time
+}
+
+// HoldStatus описывает состояние брони
+type HoldStatus int
+
+const (
+	HoldStatusWaiting HoldStatus = iota
+	HoldStatusReady
+	HoldStatusFulfilled // Читатель получил книгу
+	HoldStatusCancelled
+	HoldStatusExpired // Читатель не забрал книгу вовремя
+)
+
+// String возвращает значение, которое хранится в book_hold.status
+func (s HoldStatus) String() string {
+	switch s {
+	case HoldStatusReady:
+		return "ready"
+	case HoldStatusFulfilled:
+		return "fulfilled"
+	case HoldStatusCancelled:
+		return "cancelled"
+	case HoldStatusExpired:
+		return "expired"
+	default:
+		return "waiting"
+	}
+}
+
+// MarshalText сериализует статус строкой, как в сообщениях outbox
+func (s HoldStatus) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText разбирает строку из сообщения outbox
+func (s *HoldStatus) UnmarshalText(text []byte) error {
+	*s = ParseHoldStatus(string(text))
+	return nil
+}
+
+// ParseHoldStatus разбирает значение book_hold.status
+func ParseHoldStatus(status string) HoldStatus {
+	switch status {
+	case "ready":
+		return HoldStatusReady
+	case "fulfilled":
+		return HoldStatusFulfilled
+	case "cancelled":
+		return HoldStatusCancelled
+	case "expired":
+		return HoldStatusExpired
+	default:
+		return HoldStatusWaiting
+	}
+}
+
+// HoldFilter задает выборку ListHolds, должна быть задана книга или читатель
+type HoldFilter struct {
+	BookId        string
+	PatronId      string
+	IncludeClosed bool // Включать выполненные, отмененные и истекшие брони
+}
+
+var (
+	ErrHoldNotFound      = status.Error(codes.NotFound, "hold not found")
+	ErrHoldAlreadyExists = status.Error(codes.AlreadyExists, "patron already holds this book")
+	ErrHoldClosed        = status.Error(codes.FailedPrecondition, "hold is already closed")
+	ErrNoWaitingHolds    = status.Error(codes.NotFound, "book has no waiting holds")
+	ErrInvalidHoldFilter = status.Error(codes.InvalidArgument, "book_id or patron_id must be set")
+)
@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHoldJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	// Бронь уходит в outbox как JSON, обработчик должен прочитать статус обратно
+	readyAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	expiresAt := readyAt.Add(72 * time.Hour)
+	hold := Hold{
+		Id:        "7c5a3b1e-4a0f-4a55-8d4b-2f0d6f3b8e11",
+		BookId:    "1b9d6bcd-bbfd-4b2d-9b5d-ab8dfbbd4bed",
+		PatronId:  "6ec0bd7f-11c0-43da-975e-2a8ad9ebae0b",
+		Status:    HoldStatusReady,
+		PlacedAt:  time.Date(2024, 2, 20, 9, 0, 0, 0, time.UTC),
+		ReadyAt:   &readyAt,
+		ExpiresAt: &expiresAt,
+		UpdatedAt: readyAt,
+	}
+
+	data, err := json.Marshal(hold)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"Status":"ready"`)
+
+	var got Hold
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, hold, got)
+}
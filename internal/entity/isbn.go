@@ -0,0 +1,88 @@
+package entity
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var ErrInvalidISBN = status.Error(codes.InvalidArgument, "invalid isbn")
+
+// NormalizeISBN проверяет контрольную цифру ISBN-10 или ISBN-13 и возвращает ISBN-13 без разделителей.
+// Допускаются дефисы и пробелы между группами цифр.
+func NormalizeISBN(raw string) (string, error) {
+	isbn := strings.NewReplacer("-", "", " ", "").Replace(raw)
+
+	switch len(isbn) {
+	case 10:
+		if !validISBN10(isbn) {
+			return "", ErrInvalidISBN
+		}
+
+		// ISBN-10 соответствует ISBN-13 с префиксом 978 и пересчитанной контрольной цифрой
+		isbn13 := "978" + isbn[:9]
+		return isbn13 + string(isbn13CheckDigit(isbn13)), nil
+	case 13:
+		if !validISBN13(isbn) {
+			return "", ErrInvalidISBN
+		}
+
+		return isbn, nil
+	default:
+		return "", ErrInvalidISBN
+	}
+}
+
+func validISBN10(isbn string) bool {
+	sum := 0
+	for i, r := range isbn {
+		var digit int
+
+		switch {
+		case r >= '0' && r <= '9':
+			digit = int(r - '0')
+		case (r == 'X' || r == 'x') && i == 9:
+			digit = 10
+		default:
+			return false
+		}
+
+		sum += (10 - i) * digit
+	}
+
+	return sum%11 == 0
+}
+
+func validISBN13(isbn string) bool {
+	if !isDigits(isbn) || !(strings.HasPrefix(isbn, "978") || strings.HasPrefix(isbn, "979")) {
+		return false
+	}
+
+	return isbn13CheckDigit(isbn[:12]) == isbn[12]
+}
+
+// isbn13CheckDigit считает контрольную цифру по первым 12 цифрам ISBN-13
+func isbn13CheckDigit(digits string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+
+		sum += weight * int(digits[i]-'0')
+	}
+
+	return byte('0' + (10-sum%10)%10)
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var ErrInvalidLanguage = status.Error(codes.InvalidArgument, "invalid language tag")
+
+// NormalizeLanguage проверяет тег BCP-47 и приводит его к канонической записи: "EN-us" -> "en-US"
+func NormalizeLanguage(tag string) (string, error) {
+	parsed, err := language.Parse(tag)
+	if err != nil {
+		return "", ErrInvalidLanguage
+	}
+
+	return parsed.String(), nil
+}
@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Loan - выдача экземпляра читателю
+type Loan struct {
+	Id           string
+	CopyId       string
+	BookId       string
+	PatronId     string
+	CheckedOutAt time.Time
+	DueAt        time.Time // Срок возврата, считается от периода выдачи из конфигурации
+	Renewals     int32
+	ReturnedAt   *time.Time // nil, пока экземпляр у читателя
+	UpdatedAt    time.Time
+}
+
+// LoanFilter задает выборку ListPatronLoans
+type LoanFilter struct {
+	PatronId        string
+	IncludeReturned bool
+}
+
+var (
+	ErrLoanNotFound      = status.Error(codes.NotFound, "loan not found")
+	ErrLoanReturned      = status.Error(codes.FailedPrecondition, "loan is already returned")
+	ErrCopyOnLoan        = status.Error(codes.FailedPrecondition, "copy is already on loan")
+	ErrCopyNotOnLoan     = status.Error(codes.FailedPrecondition, "copy is not on loan")
+	ErrCopyUnavailable   = status.Error(codes.FailedPrecondition, "copy is not available for loan")
+	ErrPatronSuspended   = status.Error(codes.FailedPrecondition, "patron is suspended")
+	ErrPatronCardExpired = status.Error(codes.FailedPrecondition, "patron card is expired")
+	ErrPatronHasLoans    = status.Error(codes.FailedPrecondition, "patron has copies on loan")
+)
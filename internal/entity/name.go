@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Суффиксы, которые остаются в конце ключа сортировки: "Martin Luther King Jr." -> "King, Martin Luther, Jr."
+var nameSuffixes = []string{"Jr.", "Sr."}
+
+// Транслитерация кириллицы для ключа поиска, буквы без пары удаляются
+var cyrillicToLatin = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya", 'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g",
+}
+
+// NormalizeAuthorName приводит имя автора к форме NFC, в которой оно хранится
+func NormalizeAuthorName(name string) string {
+	return norm.NFC.String(name)
+}
+
+// SortName строит ключ сортировки автора: последнее слово (фамилия) выносится вперед.
+// "J.R.R. Tolkien" -> "Tolkien, J.R.R.", имя из одного слова не меняется
+func SortName(name string) string {
+	words := strings.Fields(name)
+	if len(words) < 2 {
+		return strings.Join(words, " ")
+	}
+
+	suffix := ""
+	if len(words) > 2 && slices.Contains(nameSuffixes, words[len(words)-1]) {
+		suffix = ", " + words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+
+	last := len(words) - 1
+	return words[last] + ", " + strings.Join(words[:last], " ") + suffix
+}
+
+// NameSearchKey - ключ нечеткого поиска по имени: нижний регистр, кириллица транслитерирована,
+// диакритика удалена. "Достоевский" -> "dostoevskiy", "Gabriel García Márquez" -> "gabriel garcia marquez"
+func NameSearchKey(name string) string {
+	var transliterated strings.Builder
+	for _, r := range norm.NFC.String(strings.ToLower(name)) {
+		if latin, ok := cyrillicToLatin[r]; ok {
+			transliterated.WriteString(latin)
+		} else {
+			transliterated.WriteRune(r)
+		}
+	}
+
+	var key strings.Builder
+	for _, r := range norm.NFD.String(transliterated.String()) {
+		if !unicode.Is(unicode.Mn, r) {
+			key.WriteRune(r)
+		}
+	}
+
+	return strings.Join(strings.Fields(key.String()), " ")
+}
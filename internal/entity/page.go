@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 1000
+)
+
+// Cursor указывает на последнюю выданную запись при keyset-пагинации.
+// Клиент получает его в виде непрозрачного page_token.
+type Cursor struct {
+	Order string `json:"o"`  // Сортировка, для которой выдан курсор
+	Value string `json:"v"`  // Значение ключа сортировки последней записи
+	Id    string `json:"id"` // Id последней записи, разрешает равенство ключей
+}
+
+var ErrInvalidPageToken = status.Error(codes.InvalidArgument, "invalid page token")
+
+func EncodeCursor(cursor Cursor) string {
+	raw, _ := json.Marshal(cursor) // Сериализация строковых полей не может завершиться ошибкой
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCursor разбирает page_token. Пустой токен означает первую страницу.
+func DecodeCursor(token string, order string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var cursor Cursor
+	if err = json.Unmarshal(raw, &cursor); err != nil || cursor.Order != order || cursor.Id == "" {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &cursor, nil
+}
+
+// PageSize приводит запрошенный размер страницы к допустимому
+func PageSize(requested int) int {
+	if requested <= 0 {
+		return DefaultPageSize
+	}
+
+	return min(requested, MaxPageSize)
+}
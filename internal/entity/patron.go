@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Patron - читатель библиотеки
+type Patron struct {
+	Id         string
+	CardNumber string // Номер читательского билета, уникален
+	Name       string
+	Email      *string
+	Phone      *string // В формате E.164
+	Status     PatronStatus
+	ExpiresOn  string // Дата окончания действия билета в формате YYYY-MM-DD
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// PatronStatus определяет, может ли читатель пользоваться библиотекой
+type PatronStatus int
+
+const (
+	PatronStatusActive PatronStatus = iota
+	PatronStatusSuspended
+)
+
+// String возвращает значение, которое хранится в patron.status
+func (s PatronStatus) String() string {
+	switch s {
+	case PatronStatusSuspended:
+		return "suspended"
+	default:
+		return "active"
+	}
+}
+
+// MarshalText сериализует статус строкой, как в сообщениях outbox
+func (s PatronStatus) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText разбирает строку из сообщения outbox
+func (s *PatronStatus) UnmarshalText(text []byte) error {
+	*s = ParsePatronStatus(string(text))
+	return nil
+}
+
+// ParsePatronStatus разбирает значение patron.status
+func ParsePatronStatus(status string) PatronStatus {
+	if status == "suspended" {
+		return PatronStatusSuspended
+	}
+
+	return PatronStatusActive
+}
+
+// PatronUpdate описывает изменение читателя, поля со значением nil не меняются
+type PatronUpdate struct {
+	Id        string
+	Name      *string
+	Email     *string // Пустая строка удаляет email
+	Phone     *string // Пустая строка удаляет телефон
+	Status    *PatronStatus
+	ExpiresOn *string
+}
+
+var (
+	ErrPatronNotFound      = status.Error(codes.NotFound, "patron not found")
+	ErrPatronAlreadyExists = status.Error(codes.AlreadyExists, "patron with this card number already exists")
+)
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatronJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	// Читатель уходит в outbox как JSON, обработчик должен прочитать статус обратно
+	email := "reader@example.com"
+	patron := Patron{
+		Id:         "7c5a3b1e-4a0f-4a55-8d4b-2f0d6f3b8e11",
+		CardNumber: "A-0001",
+		Name:       "Ivan Petrov",
+		Email:      &email,
+		Status:     PatronStatusSuspended,
+		ExpiresOn:  "2026-12-31",
+		CreatedAt:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		UpdatedAt:  time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC),
+	}
+
+	data, err := json.Marshal(patron)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"Status":"suspended"`)
+
+	var got Patron
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, patron, got)
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Publisher struct {
+	Id        string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+var (
+	ErrPublisherNotFound = status.Error(codes.NotFound, "publisher not found")
+)
@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Review - рецензия читателя на книгу, у читателя одна рецензия на книгу
+type Review struct {
+	Id        string
+	BookId    string
+	PatronId  string
+	Rating    int32 // От 1 до 5
+	Text      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ReviewUpdate описывает изменение рецензии, поля со значением nil не меняются
+type ReviewUpdate struct {
+	Id     string
+	Rating *int32
+	Text   *string
+}
+
+// ReviewFilter задает выборку ListBookReviews
+type ReviewFilter struct {
+	BookId    string
+	PageSize  int
+	PageToken string
+}
+
+type ReviewPage struct {
+	Reviews       []*Review
+	NextPageToken string
+}
+
+// BookRating - агрегат рецензий, который хранится в книге
+type BookRating struct {
+	ReviewCount int64
+	RatingSum   int64
+}
+
+// Average возвращает среднюю оценку, 0 - рецензий нет
+func (r BookRating) Average() float64 {
+	if r.ReviewCount == 0 {
+		return 0
+	}
+
+	return float64(r.RatingSum) / float64(r.ReviewCount)
+}
+
+var (
+	ErrReviewNotFound      = status.Error(codes.NotFound, "review not found")
+	ErrReviewAlreadyExists = status.Error(codes.AlreadyExists, "patron already reviewed this book")
+)
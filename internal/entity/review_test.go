@@ -0,0 +1,15 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookRatingAverage(t *testing.T) {
+	t.Parallel()
+
+	assert.InDelta(t, 0, BookRating{}.Average(), 0)
+	assert.InDelta(t, 4.5, BookRating{ReviewCount: 2, RatingSum: 9}.Average(), 1e-9)
+	assert.InDelta(t, 11.0/3, BookRating{ReviewCount: 3, RatingSum: 11}.Average(), 1e-9)
+}
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// BookRevision - состояние книги после одного изменения. Снимок содержит поля книги и участников,
+// жанры, серии, наличие и рейтинг не версионируются
+type BookRevision struct {
+	Book      *Book // DeletedAt задано, если изменение удалило книгу
+	RevisedAt time.Time
+}
+
+// AuthorRevision - профиль автора после одного изменения
+type AuthorRevision struct {
+	Author    *Author
+	RevisedAt time.Time
+}
@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Series struct {
+	Id        string
+	Name      string
+	CreatedAt time.Time
+}
+
+// BookSeries описывает место книги в серии. Теги совпадают с ключами, которые собирает запрос книги
+type BookSeries struct {
+	SeriesId string `json:"series_id"`
+	Volume   int32  `json:"volume"`
+}
+
+var (
+	ErrSeriesNotFound    = status.Error(codes.NotFound, "series not found")
+	ErrBookNotInSeries   = status.Error(codes.NotFound, "book not found in series")
+	ErrSeriesVolumeTaken = status.Error(codes.AlreadyExists, "series volume already taken")
+)
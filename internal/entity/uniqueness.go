@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// AlreadyExistsError сообщает id записи, с которой конфликтует добавляемая или изменяемая
+type AlreadyExistsError struct {
+	Err error // Одна из ошибок alreadyExistsResources
+	Id  string
+}
+
+func (e *AlreadyExistsError) Error() string {
+	return e.Err.Error() + ": " + e.Id
+}
+
+func (e *AlreadyExistsError) Unwrap() error {
+	return e.Err
+}
+
+// alreadyExistsResources - конфликты, для которых можно найти существующую запись, и тип этой записи
+var alreadyExistsResources = []struct {
+	err      error
+	resource string
+}{
+	{ErrAuthorAlreadyExists, "author"},
+	{ErrBookAlreadyExists, "book"},
+	{ErrGenreAlreadyExists, "genre"},
+	{ErrCopyAlreadyExists, "copy"},
+	{ErrPatronAlreadyExists, "patron"},
+	{ErrHoldAlreadyExists, "hold"},
+	{ErrChargeAlreadyExists, "charge"},
+	{ErrReviewAlreadyExists, "review"},
+}
+
+// AlreadyExistsResource возвращает тип существующей записи, с которой конфликтует err.
+// false - err не конфликт или id существующей записи не передается.
+func AlreadyExistsResource(err error) (string, bool) {
+	for _, known := range alreadyExistsResources {
+		if errors.Is(err, known.err) {
+			return known.resource, true
+		}
+	}
+
+	return "", false
+}
+
+// NormalizeName приводит имя к виду, в котором сравниваются имена в режиме уникальности:
+// регистр, лишние пробелы и способ записи составных символов Unicode не учитываются
+func NormalizeName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(norm.NFC.String(name))), " ")
+}
+
+// BookUniqueKey - ключ уникальности книги: нормализованное название и набор авторов без учета порядка.
+// Хэш ограничивает размер ключа при большом числе авторов.
+func BookUniqueKey(name string, authorIds []string) string {
+	ids := make([]string, len(authorIds))
+	for i, id := range authorIds {
+		ids[i] = strings.ToLower(id)
+	}
+
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	sum := sha256.Sum256([]byte(NormalizeName(name) + "|" + strings.Join(ids, ",")))
+	return hex.EncodeToString(sum[:])
+}
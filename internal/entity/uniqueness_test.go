@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlreadyExistsResource(t *testing.T) {
+	t.Parallel()
+
+	resource, ok := AlreadyExistsResource(&AlreadyExistsError{Err: ErrAuthorAlreadyExists, Id: "id"})
+	assert.True(t, ok)
+	assert.Equal(t, "author", resource)
+
+	resource, ok = AlreadyExistsResource(fmt.Errorf("add review: %w", ErrReviewAlreadyExists))
+	assert.True(t, ok)
+	assert.Equal(t, "review", resource)
+
+	// Занятый том серии - конфликт, но без существующей записи
+	_, ok = AlreadyExistsResource(ErrSeriesVolumeTaken)
+	assert.False(t, ok)
+
+	_, ok = AlreadyExistsResource(errors.New("boom"))
+	assert.False(t, ok)
+
+	_, ok = AlreadyExistsResource(nil)
+	assert.False(t, ok)
+}
@@ -0,0 +1,28 @@
+// Package money разбирает денежные суммы одинаково для конфигурации и сущностей
+package money
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parse разбирает неотрицательную сумму с точностью до сотых и возвращает ее в сотых долях: "12.5" -> 1250
+func Parse(s string) (int64, error) {
+	units, fraction, hasFraction := strings.Cut(s, ".")
+	if hasFraction && (fraction == "" || len(fraction) > 2) {
+		return 0, fmt.Errorf("invalid amount: %s", s)
+	}
+
+	wholePart, err := strconv.ParseUint(units, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount: %s", s)
+	}
+
+	cents, err := strconv.ParseUint(fraction+strings.Repeat("0", 2-len(fraction)), 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount: %s", s)
+	}
+
+	return int64(wholePart*100 + cents), nil
+}
@@ -3,7 +3,10 @@ package library
 import (
 	"context"
 	"encoding/json"
+	"strings"
+	"time"
 
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
@@ -11,19 +14,30 @@ import (
 	"github.com/project/library/internal/usecase/repository"
 )
 
-func (l *libraryImpl) RegisterAuthor(ctx context.Context, authorName string) (*entity.Author, error) {
+func (l *libraryImpl) RegisterAuthor(ctx context.Context, newAuthor *entity.Author) (*entity.Author, error) {
 	span := trace.SpanFromContext(ctx)
 	entity.SendLoggerInfo(l.logger, ctx, "Start to register author.", layerLib)
 
+	newAuthor.Name = entity.NormalizeAuthorName(newAuthor.Name)
+
+	var err error
+	if newAuthor.BirthDate, err = normalizeOptional(newAuthor.BirthDate, entity.NormalizeDate); err != nil {
+		return nil, err
+	}
+
+	if newAuthor.DeathDate, err = normalizeOptional(newAuthor.DeathDate, entity.NormalizeDate); err != nil {
+		return nil, err
+	}
+
+	newAuthor.Aliases = normalizeAliases(newAuthor.Name, newAuthor.Aliases)
+
 	var author *entity.Author
 
-	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+	err = l.transactor.WithTx(ctx, func(ctx context.Context) error {
 		entity.SendLoggerInfo(l.logger, ctx, "Transaction started for RegisterAuthor.", layerLib)
 
 		var txErr error
-		author, txErr = l.authorRepository.RegisterAuthor(ctx, &entity.Author{
-			Name: authorName,
-		})
+		author, txErr = l.authorRepository.RegisterAuthor(ctx, newAuthor)
 		if txErr != nil {
 			entity.SendLoggerSpanError(l.logger, ctx, "Error register author to repository.", layerLib, txErr)
 			return txErr
@@ -65,8 +79,188 @@ func (l *libraryImpl) GetAuthorInfo(ctx context.Context, authorId string) (*enti
 	return l.authorRepository.GetAuthorInfo(ctx, authorId)
 }
 
-func (l *libraryImpl) ChangeAuthor(ctx context.Context, authorId string, newAuthorName string) error {
+func (l *libraryImpl) GetAuthorInfoAsOf(ctx context.Context, authorId string, asOf time.Time) (*entity.Author, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to send author info as of time.", layerLib)
+
+	return l.authorRepository.GetAuthorInfoAsOf(ctx, authorId, asOf)
+}
+
+func (l *libraryImpl) GetAuthorHistory(ctx context.Context, authorId string) ([]*entity.AuthorRevision, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to get author history.", layerLib)
+
+	return l.authorRepository.GetAuthorHistory(ctx, authorId)
+}
+
+func (l *libraryImpl) ChangeAuthor(ctx context.Context, update entity.AuthorUpdate) error {
 	entity.SendLoggerInfo(l.logger, ctx, "Start to change author.", layerLib)
 
-	return l.authorRepository.ChangeAuthor(ctx, authorId, newAuthorName)
+	update.Name = entity.NormalizeAuthorName(update.Name)
+
+	var err error
+	if update.BirthDate, err = normalizeOptional(update.BirthDate, entity.NormalizeDate); err != nil {
+		return err
+	}
+
+	if update.DeathDate, err = normalizeOptional(update.DeathDate, entity.NormalizeDate); err != nil {
+		return err
+	}
+
+	if update.Aliases != nil {
+		aliases := normalizeAliases(update.Name, *update.Aliases)
+		update.Aliases = &aliases
+	}
+
+	return l.authorRepository.ChangeAuthor(ctx, update)
+}
+
+// normalizeAliases приводит псевдонимы к NFC, убирает пробелы по краям, повторы и псевдонимы,
+// совпадающие с именем
+func normalizeAliases(name string, aliases []string) []string {
+	normalized := make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		alias = entity.NormalizeAuthorName(strings.TrimSpace(alias))
+		if alias != "" && alias != name {
+			normalized = append(normalized, alias)
+		}
+	}
+
+	return lo.Uniq(normalized)
+}
+
+func (l *libraryImpl) ListAuthors(ctx context.Context, filter entity.AuthorFilter) (*entity.AuthorPage, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to list authors.", layerLib)
+
+	filter.NamePrefix = entity.NormalizeAuthorName(filter.NamePrefix)
+
+	return l.authorRepository.ListAuthors(ctx, filter)
+}
+
+func (l *libraryImpl) SearchAuthors(ctx context.Context, search entity.AuthorSearch) ([]*entity.AuthorMatch, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to search authors.", layerLib)
+
+	search.Query = entity.NormalizeAuthorName(search.Query)
+
+	return l.authorRepository.SearchAuthors(ctx, search)
+}
+
+func (l *libraryImpl) DeleteAuthor(
+	ctx context.Context,
+	authorId string,
+	policy entity.DeleteAuthorPolicy,
+) (*entity.AuthorRemoval, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("author_id", authorId))
+	entity.SendLoggerInfo(l.logger, ctx, "Start to delete author.", layerLib)
+
+	var removal *entity.AuthorRemoval
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		entity.SendLoggerInfo(l.logger, ctx, "Transaction started for DeleteAuthor.", layerLib)
+
+		var txErr error
+		removal, txErr = l.authorRepository.DeleteAuthor(ctx, authorId, policy)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error deleting author from repository.", layerLib, txErr)
+			return txErr
+		}
+
+		idempotencyKey := repository.OutboxKindAuthorDeleted.String() + "_" + authorId
+		txErr = l.sendToOutbox(ctx, repository.OutboxKindAuthorDeleted, idempotencyKey, removal.Author)
+		if txErr != nil {
+			return txErr
+		}
+
+		// Автор удаляется один раз, поэтому его id делает ключ уникальным
+		for _, book := range removal.DetachedBooks {
+			idempotencyKey = repository.OutboxKindBook.String() + "_" + book.Id + "_detached_" + authorId
+			txErr = l.sendToOutbox(ctx, repository.OutboxKindBook, idempotencyKey, book)
+			if txErr != nil {
+				return txErr
+			}
+		}
+
+		for _, book := range removal.DeletedBooks {
+			idempotencyKey = versionedKey(repository.OutboxKindBookDeleted, book.Id, *book.DeletedAt)
+			txErr = l.sendToOutbox(ctx, repository.OutboxKindBookDeleted, idempotencyKey, book)
+			if txErr != nil {
+				return txErr
+			}
+		}
+
+		entity.SendLoggerInfo(l.logger, ctx, "Complete send to outbox about delete author", layerLib)
+
+		return nil
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to delete author.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Author deleted.", layerLib, "author_id", authorId)
+
+	return removal, nil
+}
+
+func (l *libraryImpl) MergeAuthors(ctx context.Context, sourceIds []string, targetId string) (*entity.AuthorMerge, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("author_id", targetId))
+	entity.SendLoggerInfo(l.logger, ctx, "Start to merge authors.", layerLib)
+
+	targetId = strings.ToLower(targetId)
+	sourceIds = lo.Uniq(lo.Map(sourceIds, func(id string, _ int) string {
+		return strings.ToLower(id)
+	}))
+
+	if len(sourceIds) == 0 || lo.Contains(sourceIds, targetId) {
+		return nil, entity.ErrInvalidAuthorMerge
+	}
+
+	var merge *entity.AuthorMerge
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		entity.SendLoggerInfo(l.logger, ctx, "Transaction started for MergeAuthors.", layerLib)
+
+		var txErr error
+		merge, txErr = l.authorRepository.MergeAuthors(ctx, sourceIds, targetId)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error merging authors in repository.", layerLib, txErr)
+			return txErr
+		}
+
+		// Источник сливается один раз, поэтому его id делает ключ уникальным
+		for _, sourceId := range merge.SourceIds {
+			idempotencyKey := repository.OutboxKindAuthorMerged.String() + "_" + sourceId
+			redirect := entity.AuthorRedirect{SourceId: sourceId, TargetId: merge.Target.Id}
+			txErr = l.sendToOutbox(ctx, repository.OutboxKindAuthorMerged, idempotencyKey, redirect)
+			if txErr != nil {
+				return txErr
+			}
+		}
+
+		idempotencyKey := versionedKey(repository.OutboxKindAuthor, merge.Target.Id, merge.MergedAt)
+		txErr = l.sendToOutbox(ctx, repository.OutboxKindAuthor, idempotencyKey, merge.Target)
+		if txErr != nil {
+			return txErr
+		}
+
+		for _, book := range merge.Books {
+			idempotencyKey = versionedKey(repository.OutboxKindBook, book.Id, merge.MergedAt)
+			txErr = l.sendToOutbox(ctx, repository.OutboxKindBook, idempotencyKey, book)
+			if txErr != nil {
+				return txErr
+			}
+		}
+
+		entity.SendLoggerInfo(l.logger, ctx, "Complete send to outbox about merge authors", layerLib)
+
+		return nil
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to merge authors.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Authors merged.", layerLib, "author_id", targetId)
+
+	return merge, nil
 }
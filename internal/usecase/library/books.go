@@ -3,6 +3,7 @@ package library
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 
@@ -12,20 +13,32 @@ import (
 	"github.com/project/library/internal/usecase/repository"
 )
 
-func (l *libraryImpl) AddBook(ctx context.Context, name string, authorIds []string) (*entity.Book, error) {
+func (l *libraryImpl) AddBook(ctx context.Context, newBook *entity.Book) (*entity.Book, error) {
 	span := trace.SpanFromContext(ctx)
 	entity.SendLoggerInfo(l.logger, ctx, "Start to add book.", layerLib)
 
+	var err error
+	if newBook.ISBN, err = normalizeOptional(newBook.ISBN, entity.NormalizeISBN); err != nil {
+		return nil, err
+	}
+
+	if newBook.Language, err = normalizeOptional(newBook.Language, entity.NormalizeLanguage); err != nil {
+		return nil, err
+	}
+
+	if newBook.Contributors, err = entity.ResolveContributors(newBook.AuthorIds, newBook.Contributors); err != nil {
+		return nil, err
+	}
+
+	newBook.AuthorIds = entity.ContributorIds(newBook.Contributors)
+
 	var book *entity.Book // Замыкание
 
-	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+	err = l.transactor.WithTx(ctx, func(ctx context.Context) error {
 		entity.SendLoggerInfo(l.logger, ctx, "Transaction started for AddBook.", layerLib)
 
 		var txErr error
-		book, txErr = l.booksRepository.AddBook(ctx, &entity.Book{
-			Name:      name,
-			AuthorIds: authorIds,
-		})
+		book, txErr = l.booksRepository.AddBook(ctx, newBook)
 		if txErr != nil {
 			entity.SendLoggerSpanError(l.logger, ctx, "Error adding book to repository.", layerLib, txErr)
 			return txErr
@@ -63,17 +76,213 @@ func (l *libraryImpl) AddBook(ctx context.Context, name string, authorIds []stri
 func (l *libraryImpl) GetBook(ctx context.Context, bookId string) (*entity.Book, error) {
 	entity.SendLoggerInfo(l.logger, ctx, "Start to get book.", layerLib)
 
-	return l.booksRepository.GetBook(ctx, bookId)
+	book, err := l.booksRepository.GetBook(ctx, bookId)
+	if err != nil {
+		return nil, err
+	}
+
+	book.Availability, err = l.copyRepository.GetCopyAvailability(ctx, bookId)
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to get copy availability.", layerLib, err)
+		return nil, err
+	}
+
+	book.Rating, err = l.reviewRepository.GetBookRating(ctx, bookId)
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to get book rating.", layerLib, err)
+		return nil, err
+	}
+
+	return book, nil
 }
 
-func (l *libraryImpl) UpdateBook(ctx context.Context, bookID string, newBookName string, authorIds []string) error {
+func (l *libraryImpl) GetBookAsOf(ctx context.Context, bookId string, asOf time.Time) (*entity.Book, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to get book as of time.", layerLib)
+
+	return l.booksRepository.GetBookAsOf(ctx, bookId, asOf)
+}
+
+func (l *libraryImpl) GetBookHistory(ctx context.Context, bookId string) ([]*entity.BookRevision, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to get book history.", layerLib)
+
+	return l.booksRepository.GetBookHistory(ctx, bookId)
+}
+
+func (l *libraryImpl) GetBookByISBN(ctx context.Context, isbn string) (*entity.Book, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to get book by isbn.", layerLib)
+
+	normalized, err := entity.NormalizeISBN(isbn)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.booksRepository.GetBookByISBN(ctx, normalized)
+}
+
+func (l *libraryImpl) UpdateBook(ctx context.Context, update entity.BookUpdate) error {
 	entity.SendLoggerInfo(l.logger, ctx, "Start to update book.", layerLib)
 
-	return l.booksRepository.UpdateBook(ctx, bookID, newBookName, authorIds)
+	var err error
+	if update.ISBN, err = normalizeOptional(update.ISBN, entity.NormalizeISBN); err != nil {
+		return err
+	}
+
+	if update.Language, err = normalizeOptional(update.Language, entity.NormalizeLanguage); err != nil {
+		return err
+	}
+
+	if update.Contributors, err = entity.ResolveContributors(update.AuthorIds, update.Contributors); err != nil {
+		return err
+	}
+
+	update.AuthorIds = entity.ContributorIds(update.Contributors)
+
+	return l.booksRepository.UpdateBook(ctx, update)
 }
 
-func (l *libraryImpl) GetAuthorBooks(ctx context.Context, authorId string) ([]*entity.Book, error) {
+// normalizeOptional нормализует необязательное значение.
+// nil и пустая строка (удаление значения в UpdateBook) возвращаются как есть.
+func normalizeOptional(value *string, normalize func(string) (string, error)) (*string, error) {
+	if value == nil || *value == "" {
+		return value, nil
+	}
+
+	normalized, err := normalize(*value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &normalized, nil
+}
+
+func (l *libraryImpl) GetAuthorBooks(
+	ctx context.Context,
+	authorId string,
+	role *entity.ContributorRole,
+) ([]*entity.Book, error) {
 	entity.SendLoggerInfo(l.logger, ctx, "Start to get author books.", layerLib)
 
-	return l.booksRepository.GetAuthorBooks(ctx, authorId)
+	return l.booksRepository.GetAuthorBooks(ctx, authorId, role)
+}
+
+func (l *libraryImpl) GetPublisherBooks(ctx context.Context, publisherId string) ([]*entity.Book, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to get publisher books.", layerLib)
+
+	return l.booksRepository.GetPublisherBooks(ctx, publisherId)
+}
+
+func (l *libraryImpl) GetSeriesBooks(ctx context.Context, seriesId string) ([]*entity.Book, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to get series books.", layerLib)
+
+	return l.booksRepository.GetSeriesBooks(ctx, seriesId)
+}
+
+func (l *libraryImpl) ListBooks(ctx context.Context, filter entity.BookFilter) (*entity.BookPage, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to list books.", layerLib)
+
+	if filter.Language != "" {
+		language, err := entity.NormalizeLanguage(filter.Language)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.Language = language
+	}
+
+	return l.booksRepository.ListBooks(ctx, filter)
+}
+
+func (l *libraryImpl) SearchBooks(ctx context.Context, search entity.BookSearch) (*entity.BookSearchPage, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to search books.", layerLib)
+
+	return l.booksRepository.SearchBooks(ctx, search)
+}
+
+func (l *libraryImpl) DeleteBook(ctx context.Context, bookId string) error {
+	span := trace.SpanFromContext(ctx)
+	entity.SendLoggerInfo(l.logger, ctx, "Start to delete book.", layerLib)
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		entity.SendLoggerInfo(l.logger, ctx, "Transaction started for DeleteBook.", layerLib)
+
+		book, txErr := l.booksRepository.DeleteBook(ctx, bookId)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error deleting book from repository.", layerLib, txErr)
+			return txErr
+		}
+
+		span.SetAttributes(attribute.String("book_id", book.Id))
+
+		serialized, txErr := json.Marshal(book)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error serializing book data.", layerLib, txErr)
+			return txErr
+		}
+
+		// Книга может быть удалена повторно после восстановления, поэтому ключ включает время удаления
+		idempotencyKey := versionedKey(repository.OutboxKindBookDeleted, book.Id, *book.DeletedAt)
+		txErr = l.outboxRepository.SendMessage(ctx, idempotencyKey, repository.OutboxKindBookDeleted, serialized)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error sending message to outbox.", layerLib, txErr)
+			return txErr
+		}
+
+		entity.SendLoggerInfo(l.logger, ctx, "Complete send to outbox about delete book", layerLib)
+
+		return nil
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to delete book.", layerLib, err)
+		return err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Book deleted.", layerLib, "book_id", bookId)
+
+	return nil
+}
+
+func (l *libraryImpl) RestoreBook(ctx context.Context, bookId string) (*entity.Book, error) {
+	span := trace.SpanFromContext(ctx)
+	entity.SendLoggerInfo(l.logger, ctx, "Start to restore book.", layerLib)
+
+	var book *entity.Book
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		entity.SendLoggerInfo(l.logger, ctx, "Transaction started for RestoreBook.", layerLib)
+
+		var txErr error
+		book, txErr = l.booksRepository.RestoreBook(ctx, bookId)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error restoring book in repository.", layerLib, txErr)
+			return txErr
+		}
+
+		span.SetAttributes(attribute.String("book_id", book.Id))
+
+		serialized, txErr := json.Marshal(book)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error serializing book data.", layerLib, txErr)
+			return txErr
+		}
+
+		// Восстановленная книга снова доступна потребителям как обычная книга
+		idempotencyKey := versionedKey(repository.OutboxKindBook, book.Id, book.UpdatedAt)
+		txErr = l.outboxRepository.SendMessage(ctx, idempotencyKey, repository.OutboxKindBook, serialized)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error sending message to outbox.", layerLib, txErr)
+			return txErr
+		}
+
+		entity.SendLoggerInfo(l.logger, ctx, "Complete send to outbox about restore book", layerLib)
+
+		return nil
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to restore book.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Book restored.", layerLib, "book_id", book.Id)
+
+	return book, nil
 }
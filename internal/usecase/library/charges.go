@@ -0,0 +1,136 @@
+package library
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/repository"
+)
+
+// chargeReminderBatchSize ограничивает число напоминаний, которые отправляются за один проход SendChargeReminders
+const chargeReminderBatchSize = 100
+
+func (l *libraryImpl) RecordLateReturn(ctx context.Context, lateReturn entity.LateReturn) (*entity.Charge, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("patron_id", lateReturn.PatronId), attribute.String("book_id", lateReturn.BookId))
+	entity.SendLoggerInfo(l.logger, ctx, "Start to record late return.", layerLib)
+
+	if !lateReturn.ReturnedAt.After(lateReturn.DueAt) {
+		return nil, entity.ErrReturnNotLate
+	}
+
+	charge, err := l.changeCharge(ctx, "RecordLateReturn", func(ctx context.Context) (*entity.Charge, error) {
+		return l.chargeRepository.RecordLateReturn(ctx, lateReturn)
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to record late return.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Late return charged.", layerLib, "charge_id", charge.Id)
+
+	return charge, nil
+}
+
+func (l *libraryImpl) PayCharge(ctx context.Context, chargeId string) (*entity.Charge, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("charge_id", chargeId))
+	entity.SendLoggerInfo(l.logger, ctx, "Start to pay charge.", layerLib)
+
+	charge, err := l.changeCharge(ctx, "PayCharge", func(ctx context.Context) (*entity.Charge, error) {
+		return l.chargeRepository.PayCharge(ctx, chargeId)
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to pay charge.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Charge paid.", layerLib, "charge_id", charge.Id)
+
+	return charge, nil
+}
+
+func (l *libraryImpl) WaiveCharge(ctx context.Context, chargeId string, reason string) (*entity.Charge, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("charge_id", chargeId))
+	entity.SendLoggerInfo(l.logger, ctx, "Start to waive charge.", layerLib)
+
+	charge, err := l.changeCharge(ctx, "WaiveCharge", func(ctx context.Context) (*entity.Charge, error) {
+		return l.chargeRepository.WaiveCharge(ctx, chargeId, reason)
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to waive charge.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Charge waived.", layerLib, "charge_id", charge.Id)
+
+	return charge, nil
+}
+
+func (l *libraryImpl) ListCharges(ctx context.Context, filter entity.ChargeFilter) ([]*entity.Charge, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to list charges.", layerLib)
+
+	return l.chargeRepository.ListCharges(ctx, filter)
+}
+
+// SendChargeReminders отправляет в outbox напоминания о неоплаченных штрафах.
+// Возвращает число отправленных напоминаний
+func (l *libraryImpl) SendChargeReminders(ctx context.Context) (int, error) {
+	var sent int
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		charges, txErr := l.chargeRepository.ClaimChargeReminders(ctx, chargeReminderBatchSize)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error claiming charge reminders in repository.", layerLib, txErr)
+			return txErr
+		}
+
+		for _, charge := range charges {
+			idempotencyKey := versionedKey(repository.OutboxKindChargeReminder, charge.Id, *charge.RemindedAt)
+			if txErr = l.sendToOutbox(ctx, repository.OutboxKindChargeReminder, idempotencyKey, charge); txErr != nil {
+				return txErr
+			}
+		}
+
+		sent = len(charges)
+		return nil
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to send charge reminders.", layerLib, err)
+		return 0, err
+	}
+
+	return sent, nil
+}
+
+// changeCharge меняет штраф и отправляет его новое состояние в outbox в одной транзакции
+func (l *libraryImpl) changeCharge(
+	ctx context.Context,
+	operation string,
+	change func(ctx context.Context) (*entity.Charge, error),
+) (*entity.Charge, error) {
+	var charge *entity.Charge
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		entity.SendLoggerInfo(l.logger, ctx, "Transaction started for "+operation+".", layerLib)
+
+		var txErr error
+		charge, txErr = change(ctx)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error changing charge in repository.", layerLib, txErr)
+			return txErr
+		}
+
+		idempotencyKey := versionedKey(repository.OutboxKindCharge, charge.Id, charge.UpdatedAt)
+		return l.sendToOutbox(ctx, repository.OutboxKindCharge, idempotencyKey, charge)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return charge, nil
+}
@@ -0,0 +1,52 @@
+package library
+
+import (
+	"context"
+
+	"github.com/project/library/internal/entity"
+)
+
+func (l *libraryImpl) AddCopy(ctx context.Context, bookCopy *entity.Copy) (*entity.Copy, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to add copy.", layerLib)
+
+	added, err := l.copyRepository.AddCopy(ctx, bookCopy)
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to add copy.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Copy added.", layerLib, "copy_id", added.Id)
+
+	return added, nil
+}
+
+func (l *libraryImpl) UpdateCopy(ctx context.Context, update entity.CopyUpdate) error {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to update copy.", layerLib)
+
+	// Списание фиксирует время и запрещает дальнейшие изменения, поэтому выполняется только RetireCopy
+	if update.Status != nil && *update.Status == entity.CopyStatusRetired {
+		return entity.ErrInvalidCopyStatus
+	}
+
+	return l.copyRepository.UpdateCopy(ctx, update)
+}
+
+func (l *libraryImpl) RetireCopy(ctx context.Context, copyId string) (*entity.Copy, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to retire copy.", layerLib)
+
+	retired, err := l.copyRepository.RetireCopy(ctx, copyId)
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to retire copy.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Copy retired.", layerLib, "copy_id", retired.Id)
+
+	return retired, nil
+}
+
+func (l *libraryImpl) ListBookCopies(ctx context.Context, filter entity.CopyFilter) ([]*entity.Copy, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to list book copies.", layerLib)
+
+	return l.copyRepository.ListBookCopies(ctx, filter)
+}
@@ -0,0 +1,72 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/project/library/internal/entity"
+)
+
+func (l *libraryImpl) UploadBookCover(
+	ctx context.Context,
+	upload entity.CoverUpload,
+	content io.Reader,
+) (*entity.BookCover, error) {
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Start to upload book cover.", layerLib, "book_id", upload.BookId)
+
+	// Книга проверяется до чтения содержимого, чтобы не сохранять обложку впустую
+	if _, err := l.booksRepository.GetBook(ctx, upload.BookId); err != nil {
+		return nil, err
+	}
+
+	checked, err := entity.CheckCoverContent(content, upload.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Содержимое не удаляется при замене обложки: его могут использовать другие книги
+	blob, err := l.blobStorage.PutBlob(ctx, checked)
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to store book cover.", layerLib, err)
+		return nil, err
+	}
+
+	cover, err := l.coverRepository.SetBookCover(ctx, &entity.BookCover{
+		BookId:      upload.BookId,
+		ContentType: upload.ContentType,
+		Size:        blob.Size,
+		SHA256:      blob.SHA256,
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to set book cover.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Book cover uploaded.", layerLib, "sha256", cover.SHA256)
+
+	return cover, nil
+}
+
+func (l *libraryImpl) DownloadBookCover(ctx context.Context, bookId string) (*entity.BookCover, io.ReadCloser, error) {
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Start to download book cover.", layerLib, "book_id", bookId)
+
+	cover, err := l.coverRepository.GetBookCover(ctx, bookId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := l.blobStorage.GetBlob(ctx, cover.SHA256)
+	if errors.Is(err, entity.ErrBlobNotFound) {
+		// Описание есть, а содержимого нет: хранилище сменили или очистили
+		entity.SendLoggerSpanError(l.logger, ctx, "Book cover content is missing.", layerLib, err)
+		return nil, nil, entity.ErrCoverNotFound
+	}
+
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to get book cover content.", layerLib, err)
+		return nil, nil, err
+	}
+
+	return cover, content, nil
+}
@@ -0,0 +1,83 @@
+package library
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/repository"
+)
+
+func (l *libraryImpl) CreateGenre(ctx context.Context, genre *entity.Genre) (*entity.Genre, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to create genre.", layerLib)
+
+	created, err := l.genreRepository.CreateGenre(ctx, genre)
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to create genre.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Genre created.", layerLib, "genre_id", created.Id)
+
+	return created, nil
+}
+
+func (l *libraryImpl) ListGenres(ctx context.Context, filter entity.GenreFilter) ([]*entity.Genre, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to list genres.", layerLib)
+
+	return l.genreRepository.ListGenres(ctx, filter)
+}
+
+func (l *libraryImpl) AttachBookGenres(
+	ctx context.Context,
+	bookId string,
+	genreIds []string,
+) ([]*entity.BookGenre, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("book_id", bookId))
+	entity.SendLoggerInfo(l.logger, ctx, "Start to attach book genres.", layerLib)
+
+	var attached []*entity.BookGenre
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		entity.SendLoggerInfo(l.logger, ctx, "Transaction started for AttachBookGenres.", layerLib)
+
+		var txErr error
+		attached, txErr = l.genreRepository.AttachBookGenres(ctx, bookId, lo.Uniq(genreIds))
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error attaching book genres in repository.", layerLib, txErr)
+			return txErr
+		}
+
+		// Жанр можно отвязать и привязать снова, поэтому ключ включает время привязки
+		for _, bookGenre := range attached {
+			idempotencyKey := versionedKey(repository.OutboxKindBookGenreAdded,
+				bookGenre.BookId+"_"+bookGenre.GenreId, bookGenre.AttachedAt)
+			txErr = l.sendToOutbox(ctx, repository.OutboxKindBookGenreAdded, idempotencyKey, bookGenre)
+			if txErr != nil {
+				return txErr
+			}
+		}
+
+		entity.SendLoggerInfo(l.logger, ctx, "Complete send to outbox about attach book genres", layerLib)
+
+		return nil
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to attach book genres.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Book genres attached.", layerLib, "book_id", bookId)
+
+	return attached, nil
+}
+
+func (l *libraryImpl) DetachBookGenres(ctx context.Context, bookId string, genreIds []string) error {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to detach book genres.", layerLib)
+
+	return l.genreRepository.DetachBookGenres(ctx, bookId, genreIds)
+}
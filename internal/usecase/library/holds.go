@@ -0,0 +1,173 @@
+package library
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/repository"
+)
+
+// holdSweepBatchSize ограничивает число броней, которые истекают за один проход ExpireHolds
+const holdSweepBatchSize = 100
+
+func (l *libraryImpl) PlaceHold(ctx context.Context, bookId string, patronId string) (*entity.Hold, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("book_id", bookId), attribute.String("patron_id", patronId))
+	entity.SendLoggerInfo(l.logger, ctx, "Start to place hold.", layerLib)
+
+	var hold *entity.Hold
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		entity.SendLoggerInfo(l.logger, ctx, "Transaction started for PlaceHold.", layerLib)
+
+		var txErr error
+		hold, txErr = l.holdRepository.PlaceHold(ctx, bookId, patronId)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error placing hold in repository.", layerLib, txErr)
+			return txErr
+		}
+
+		return l.sendHoldToOutbox(ctx, hold)
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to place hold.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Hold placed.", layerLib, "hold_id", hold.Id)
+
+	return hold, nil
+}
+
+func (l *libraryImpl) CancelHold(ctx context.Context, holdId string) (*entity.Hold, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("hold_id", holdId))
+	entity.SendLoggerInfo(l.logger, ctx, "Start to cancel hold.", layerLib)
+
+	var hold *entity.Hold
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		entity.SendLoggerInfo(l.logger, ctx, "Transaction started for CancelHold.", layerLib)
+
+		var txErr error
+		hold, txErr = l.holdRepository.CancelHold(ctx, holdId)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error cancelling hold in repository.", layerLib, txErr)
+			return txErr
+		}
+
+		if txErr = l.sendHoldToOutbox(ctx, hold); txErr != nil {
+			return txErr
+		}
+
+		// Отмененная готовая бронь освобождает экземпляр для следующего в очереди
+		if hold.ReadyAt != nil {
+			return l.advanceHoldQueue(ctx, hold.BookId)
+		}
+
+		return nil
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to cancel hold.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Hold cancelled.", layerLib, "hold_id", hold.Id)
+
+	return hold, nil
+}
+
+func (l *libraryImpl) ListHolds(ctx context.Context, filter entity.HoldFilter) ([]*entity.Hold, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to list holds.", layerLib)
+
+	if filter.BookId == "" && filter.PatronId == "" {
+		return nil, entity.ErrInvalidHoldFilter
+	}
+
+	return l.holdRepository.ListHolds(ctx, filter)
+}
+
+func (l *libraryImpl) FulfillNextHold(ctx context.Context, bookId string) (*entity.Hold, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("book_id", bookId))
+	entity.SendLoggerInfo(l.logger, ctx, "Start to fulfill next hold.", layerLib)
+
+	var hold *entity.Hold
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		entity.SendLoggerInfo(l.logger, ctx, "Transaction started for FulfillNextHold.", layerLib)
+
+		var txErr error
+		hold, txErr = l.holdRepository.FulfillNextHold(ctx, bookId)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error fulfilling hold in repository.", layerLib, txErr)
+			return txErr
+		}
+
+		return l.sendHoldToOutbox(ctx, hold)
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to fulfill next hold.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Hold is ready for pickup.", layerLib, "hold_id", hold.Id)
+
+	return hold, nil
+}
+
+// ExpireHolds истекает готовые брони, которые не забрали вовремя, и передает экземпляры следующим в очереди.
+// Возвращает число истекших броней
+func (l *libraryImpl) ExpireHolds(ctx context.Context) (int, error) {
+	var expired int
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		holds, txErr := l.holdRepository.ExpireHolds(ctx, holdSweepBatchSize)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error expiring holds in repository.", layerLib, txErr)
+			return txErr
+		}
+
+		for _, hold := range holds {
+			if txErr = l.sendHoldToOutbox(ctx, hold); txErr != nil {
+				return txErr
+			}
+
+			if txErr = l.advanceHoldQueue(ctx, hold.BookId); txErr != nil {
+				return txErr
+			}
+		}
+
+		expired = len(holds)
+		return nil
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to expire holds.", layerLib, err)
+		return 0, err
+	}
+
+	return expired, nil
+}
+
+// advanceHoldQueue делает готовой следующую бронь книги, если очередь не пуста
+func (l *libraryImpl) advanceHoldQueue(ctx context.Context, bookId string) error {
+	next, err := l.holdRepository.FulfillNextHold(ctx, bookId)
+	if errors.Is(err, entity.ErrNoWaitingHolds) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return l.sendHoldToOutbox(ctx, next)
+}
+
+func (l *libraryImpl) sendHoldToOutbox(ctx context.Context, hold *entity.Hold) error {
+	idempotencyKey := versionedKey(repository.OutboxKindHold, hold.Id, hold.UpdatedAt)
+	return l.sendToOutbox(ctx, repository.OutboxKindHold, idempotencyKey, hold)
+}
@@ -2,6 +2,8 @@ package library
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/project/library/internal/entity"
 	"github.com/project/library/internal/usecase/repository"
@@ -12,44 +14,173 @@ import (
 
 var _ AuthorUseCase = (*libraryImpl)(nil)
 var _ BooksUseCase = (*libraryImpl)(nil)
+var _ GenreUseCase = (*libraryImpl)(nil)
+var _ PublisherUseCase = (*libraryImpl)(nil)
+var _ SeriesUseCase = (*libraryImpl)(nil)
+var _ CopyUseCase = (*libraryImpl)(nil)
+var _ PatronUseCase = (*libraryImpl)(nil)
+var _ LoanUseCase = (*libraryImpl)(nil)
+var _ HoldUseCase = (*libraryImpl)(nil)
+var _ ChargeUseCase = (*libraryImpl)(nil)
+var _ ReviewUseCase = (*libraryImpl)(nil)
+var _ CoverUseCase = (*libraryImpl)(nil)
 
 const layerLib = "usecase_library"
 
 type (
 	AuthorUseCase interface {
-		RegisterAuthor(ctx context.Context, authorName string) (*entity.Author, error)
+		RegisterAuthor(ctx context.Context, author *entity.Author) (*entity.Author, error)
 		GetAuthorInfo(ctx context.Context, authorId string) (*entity.Author, error)
-		ChangeAuthor(ctx context.Context, authorId string, newAuthorName string) error
+		GetAuthorInfoAsOf(ctx context.Context, authorId string, asOf time.Time) (*entity.Author, error)
+		GetAuthorHistory(ctx context.Context, authorId string) ([]*entity.AuthorRevision, error)
+		ChangeAuthor(ctx context.Context, update entity.AuthorUpdate) error
+		DeleteAuthor(ctx context.Context, authorId string, policy entity.DeleteAuthorPolicy) (*entity.AuthorRemoval, error)
+		MergeAuthors(ctx context.Context, sourceIds []string, targetId string) (*entity.AuthorMerge, error)
+		ListAuthors(ctx context.Context, filter entity.AuthorFilter) (*entity.AuthorPage, error)
+		SearchAuthors(ctx context.Context, search entity.AuthorSearch) ([]*entity.AuthorMatch, error)
 	}
 
 	BooksUseCase interface {
-		AddBook(ctx context.Context, name string, authorIDs []string) (*entity.Book, error)
+		AddBook(ctx context.Context, book *entity.Book) (*entity.Book, error)
 		GetBook(ctx context.Context, bookId string) (*entity.Book, error)
-		UpdateBook(ctx context.Context, bookId string, newBookName string, authorIds []string) error
-		GetAuthorBooks(ctx context.Context, authorId string) ([]*entity.Book, error)
+		// GetBookAsOf возвращает книгу на момент asOf без наличия и рейтинга
+		GetBookAsOf(ctx context.Context, bookId string, asOf time.Time) (*entity.Book, error)
+		GetBookHistory(ctx context.Context, bookId string) ([]*entity.BookRevision, error)
+		GetBookByISBN(ctx context.Context, isbn string) (*entity.Book, error)
+		UpdateBook(ctx context.Context, update entity.BookUpdate) error
+		GetAuthorBooks(ctx context.Context, authorId string, role *entity.ContributorRole) ([]*entity.Book, error)
+		GetPublisherBooks(ctx context.Context, publisherId string) ([]*entity.Book, error)
+		GetSeriesBooks(ctx context.Context, seriesId string) ([]*entity.Book, error)
+		DeleteBook(ctx context.Context, bookId string) error
+		RestoreBook(ctx context.Context, bookId string) (*entity.Book, error)
+		ListBooks(ctx context.Context, filter entity.BookFilter) (*entity.BookPage, error)
+		SearchBooks(ctx context.Context, search entity.BookSearch) (*entity.BookSearchPage, error)
+	}
+
+	PublisherUseCase interface {
+		CreatePublisher(ctx context.Context, name string) (*entity.Publisher, error)
+		GetPublisher(ctx context.Context, publisherId string) (*entity.Publisher, error)
+		UpdatePublisher(ctx context.Context, publisherId string, newName string) error
+		DeletePublisher(ctx context.Context, publisherId string) error
+	}
+
+	SeriesUseCase interface {
+		CreateSeries(ctx context.Context, name string) (*entity.Series, error)
+		AddBookToSeries(ctx context.Context, seriesId string, bookId string, volume int32) error
+		RemoveBookFromSeries(ctx context.Context, seriesId string, bookId string) error
+	}
+
+	CopyUseCase interface {
+		AddCopy(ctx context.Context, bookCopy *entity.Copy) (*entity.Copy, error)
+		UpdateCopy(ctx context.Context, update entity.CopyUpdate) error
+		RetireCopy(ctx context.Context, copyId string) (*entity.Copy, error)
+		ListBookCopies(ctx context.Context, filter entity.CopyFilter) ([]*entity.Copy, error)
+	}
+
+	PatronUseCase interface {
+		RegisterPatron(ctx context.Context, patron *entity.Patron) (*entity.Patron, error)
+		GetPatron(ctx context.Context, patronId string) (*entity.Patron, error)
+		UpdatePatron(ctx context.Context, update entity.PatronUpdate) error
+		DeletePatron(ctx context.Context, patronId string) error
+	}
+
+	LoanUseCase interface {
+		CheckoutCopy(ctx context.Context, copyId string, patronId string) (*entity.Loan, error)
+		ReturnCopy(ctx context.Context, copyId string) (*entity.Loan, error)
+		RenewLoan(ctx context.Context, loanId string) (*entity.Loan, error)
+		ListPatronLoans(ctx context.Context, filter entity.LoanFilter) ([]*entity.Loan, error)
+	}
+
+	HoldUseCase interface {
+		PlaceHold(ctx context.Context, bookId string, patronId string) (*entity.Hold, error)
+		CancelHold(ctx context.Context, holdId string) (*entity.Hold, error)
+		ListHolds(ctx context.Context, filter entity.HoldFilter) ([]*entity.Hold, error)
+		FulfillNextHold(ctx context.Context, bookId string) (*entity.Hold, error)
+		ExpireHolds(ctx context.Context) (int, error)
+	}
+
+	ChargeUseCase interface {
+		RecordLateReturn(ctx context.Context, lateReturn entity.LateReturn) (*entity.Charge, error)
+		PayCharge(ctx context.Context, chargeId string) (*entity.Charge, error)
+		WaiveCharge(ctx context.Context, chargeId string, reason string) (*entity.Charge, error)
+		ListCharges(ctx context.Context, filter entity.ChargeFilter) ([]*entity.Charge, error)
+		SendChargeReminders(ctx context.Context) (int, error)
+	}
+
+	ReviewUseCase interface {
+		CreateReview(ctx context.Context, review *entity.Review) (*entity.Review, error)
+		UpdateReview(ctx context.Context, update entity.ReviewUpdate) (*entity.Review, error)
+		DeleteReview(ctx context.Context, reviewId string) error
+		ListBookReviews(ctx context.Context, filter entity.ReviewFilter) (*entity.ReviewPage, error)
+	}
+
+	CoverUseCase interface {
+		UploadBookCover(ctx context.Context, upload entity.CoverUpload, content io.Reader) (*entity.BookCover, error)
+		// DownloadBookCover возвращает описание обложки и ее содержимое, reader нужно закрыть
+		DownloadBookCover(ctx context.Context, bookId string) (*entity.BookCover, io.ReadCloser, error)
+	}
+
+	GenreUseCase interface {
+		CreateGenre(ctx context.Context, genre *entity.Genre) (*entity.Genre, error)
+		ListGenres(ctx context.Context, filter entity.GenreFilter) ([]*entity.Genre, error)
+		AttachBookGenres(ctx context.Context, bookId string, genreIds []string) ([]*entity.BookGenre, error)
+		DetachBookGenres(ctx context.Context, bookId string, genreIds []string) error
 	}
 )
 
 type libraryImpl struct {
-	logger           *zap.Logger
-	authorRepository repository.AuthorRepository
-	booksRepository  repository.BooksRepository
-	outboxRepository repository.OutboxRepository
-	transactor       repository.Transactor
+	logger              *zap.Logger
+	authorRepository    repository.AuthorRepository
+	booksRepository     repository.BooksRepository
+	genreRepository     repository.GenreRepository
+	publisherRepository repository.PublisherRepository
+	seriesRepository    repository.SeriesRepository
+	copyRepository      repository.CopyRepository
+	patronRepository    repository.PatronRepository
+	loanRepository      repository.LoanRepository
+	holdRepository      repository.HoldRepository
+	chargeRepository    repository.ChargeRepository
+	reviewRepository    repository.ReviewRepository
+	coverRepository     repository.CoverRepository
+	blobStorage         repository.BlobStorage
+	outboxRepository    repository.OutboxRepository
+	transactor          repository.Transactor
 }
 
 func New(
 	logger *zap.Logger,
 	authorRepository repository.AuthorRepository,
 	booksRepository repository.BooksRepository,
+	genreRepository repository.GenreRepository,
+	publisherRepository repository.PublisherRepository,
+	seriesRepository repository.SeriesRepository,
+	copyRepository repository.CopyRepository,
+	patronRepository repository.PatronRepository,
+	loanRepository repository.LoanRepository,
+	holdRepository repository.HoldRepository,
+	chargeRepository repository.ChargeRepository,
+	reviewRepository repository.ReviewRepository,
+	coverRepository repository.CoverRepository,
+	blobStorage repository.BlobStorage,
 	outboxRepository repository.OutboxRepository,
 	transactor repository.Transactor,
 ) *libraryImpl {
 	return &libraryImpl{
-		logger:           logger,
-		authorRepository: authorRepository,
-		booksRepository:  booksRepository,
-		outboxRepository: outboxRepository,
-		transactor:       transactor,
+		logger:              logger,
+		authorRepository:    authorRepository,
+		booksRepository:     booksRepository,
+		genreRepository:     genreRepository,
+		publisherRepository: publisherRepository,
+		seriesRepository:    seriesRepository,
+		copyRepository:      copyRepository,
+		patronRepository:    patronRepository,
+		loanRepository:      loanRepository,
+		holdRepository:      holdRepository,
+		chargeRepository:    chargeRepository,
+		reviewRepository:    reviewRepository,
+		coverRepository:     coverRepository,
+		blobStorage:         blobStorage,
+		outboxRepository:    outboxRepository,
+		transactor:          transactor,
 	}
 }
@@ -0,0 +1,115 @@
+package library
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/repository"
+)
+
+func (l *libraryImpl) CheckoutCopy(ctx context.Context, copyId string, patronId string) (*entity.Loan, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("copy_id", copyId), attribute.String("patron_id", patronId))
+	entity.SendLoggerInfo(l.logger, ctx, "Start to checkout copy.", layerLib)
+
+	loan, err := l.changeLoan(ctx, "CheckoutCopy", func(ctx context.Context) (*entity.Loan, error) {
+		loan, err := l.loanRepository.CheckoutCopy(ctx, copyId, patronId)
+		if err != nil {
+			return nil, err
+		}
+
+		// Выданная книга закрывает бронь читателя на нее
+		hold, err := l.holdRepository.FulfillPatronHold(ctx, loan.BookId, loan.PatronId)
+		if errors.Is(err, entity.ErrHoldNotFound) {
+			return loan, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		return loan, l.sendHoldToOutbox(ctx, hold)
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to checkout copy.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Copy checked out.", layerLib, "loan_id", loan.Id)
+
+	return loan, nil
+}
+
+func (l *libraryImpl) ReturnCopy(ctx context.Context, copyId string) (*entity.Loan, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("copy_id", copyId))
+	entity.SendLoggerInfo(l.logger, ctx, "Start to return copy.", layerLib)
+
+	loan, err := l.changeLoan(ctx, "ReturnCopy", func(ctx context.Context) (*entity.Loan, error) {
+		return l.loanRepository.ReturnCopy(ctx, copyId)
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to return copy.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Copy returned.", layerLib, "loan_id", loan.Id)
+
+	return loan, nil
+}
+
+func (l *libraryImpl) RenewLoan(ctx context.Context, loanId string) (*entity.Loan, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("loan_id", loanId))
+	entity.SendLoggerInfo(l.logger, ctx, "Start to renew loan.", layerLib)
+
+	loan, err := l.changeLoan(ctx, "RenewLoan", func(ctx context.Context) (*entity.Loan, error) {
+		return l.loanRepository.RenewLoan(ctx, loanId)
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to renew loan.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Loan renewed.", layerLib, "loan_id", loan.Id)
+
+	return loan, nil
+}
+
+func (l *libraryImpl) ListPatronLoans(ctx context.Context, filter entity.LoanFilter) ([]*entity.Loan, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to list patron loans.", layerLib)
+
+	return l.loanRepository.ListPatronLoans(ctx, filter)
+}
+
+// changeLoan меняет выдачу и отправляет ее новое состояние в outbox в одной транзакции
+func (l *libraryImpl) changeLoan(
+	ctx context.Context,
+	operation string,
+	change func(ctx context.Context) (*entity.Loan, error),
+) (*entity.Loan, error) {
+	var loan *entity.Loan
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		entity.SendLoggerInfo(l.logger, ctx, "Transaction started for "+operation+".", layerLib)
+
+		var txErr error
+		loan, txErr = change(ctx)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error changing loan in repository.", layerLib, txErr)
+			return txErr
+		}
+
+		idempotencyKey := versionedKey(repository.OutboxKindLoan, loan.Id, loan.UpdatedAt)
+		return l.sendToOutbox(ctx, repository.OutboxKindLoan, idempotencyKey, loan)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return loan, nil
+}
@@ -0,0 +1,38 @@
+package library
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/repository"
+)
+
+// sendToOutbox сериализует данные и кладет их в outbox в рамках текущей транзакции
+func (l *libraryImpl) sendToOutbox(
+	ctx context.Context,
+	kind repository.OutboxKind,
+	idempotencyKey string,
+	data any,
+) error {
+	serialized, err := json.Marshal(data)
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Error serializing "+kind.String()+" data.", layerLib, err)
+		return err
+	}
+
+	err = l.outboxRepository.SendMessage(ctx, idempotencyKey, kind, serialized)
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Error sending message to outbox.", layerLib, err)
+		return err
+	}
+
+	return nil
+}
+
+// versionedKey строит ключ идемпотентности для событий, которые могут повторяться для одной сущности
+func versionedKey(kind repository.OutboxKind, id string, at time.Time) string {
+	return kind.String() + "_" + id + "_" + strconv.FormatInt(at.UnixNano(), 10)
+}
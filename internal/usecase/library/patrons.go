@@ -0,0 +1,121 @@
+package library
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/repository"
+)
+
+func (l *libraryImpl) RegisterPatron(ctx context.Context, newPatron *entity.Patron) (*entity.Patron, error) {
+	span := trace.SpanFromContext(ctx)
+	entity.SendLoggerInfo(l.logger, ctx, "Start to register patron.", layerLib)
+
+	newPatron.Name = strings.TrimSpace(newPatron.Name)
+
+	var err error
+	if newPatron.ExpiresOn, err = entity.NormalizeDate(newPatron.ExpiresOn); err != nil {
+		return nil, err
+	}
+
+	var patron *entity.Patron
+
+	err = l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		entity.SendLoggerInfo(l.logger, ctx, "Transaction started for RegisterPatron.", layerLib)
+
+		var txErr error
+		patron, txErr = l.patronRepository.RegisterPatron(ctx, newPatron)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error register patron to repository.", layerLib, txErr)
+			return txErr
+		}
+
+		span.SetAttributes(attribute.String("patron_id", patron.Id))
+
+		idempotencyKey := repository.OutboxKindPatron.String() + "_" + patron.Id
+		return l.sendToOutbox(ctx, repository.OutboxKindPatron, idempotencyKey, patron)
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to register patron.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Patron registered.", layerLib, "patron_id", patron.Id)
+
+	return patron, nil
+}
+
+func (l *libraryImpl) GetPatron(ctx context.Context, patronId string) (*entity.Patron, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to get patron.", layerLib)
+
+	return l.patronRepository.GetPatron(ctx, patronId)
+}
+
+func (l *libraryImpl) UpdatePatron(ctx context.Context, update entity.PatronUpdate) error {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("patron_id", update.Id))
+	entity.SendLoggerInfo(l.logger, ctx, "Start to update patron.", layerLib)
+
+	if update.Name != nil {
+		name := strings.TrimSpace(*update.Name)
+		update.Name = &name
+	}
+
+	var err error
+	if update.ExpiresOn, err = normalizeOptional(update.ExpiresOn, entity.NormalizeDate); err != nil {
+		return err
+	}
+
+	err = l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		entity.SendLoggerInfo(l.logger, ctx, "Transaction started for UpdatePatron.", layerLib)
+
+		patron, txErr := l.patronRepository.UpdatePatron(ctx, update)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error updating patron in repository.", layerLib, txErr)
+			return txErr
+		}
+
+		idempotencyKey := versionedKey(repository.OutboxKindPatron, patron.Id, patron.UpdatedAt)
+		return l.sendToOutbox(ctx, repository.OutboxKindPatron, idempotencyKey, patron)
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to update patron.", layerLib, err)
+		return err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Patron updated.", layerLib, "patron_id", update.Id)
+
+	return nil
+}
+
+func (l *libraryImpl) DeletePatron(ctx context.Context, patronId string) error {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("patron_id", patronId))
+	entity.SendLoggerInfo(l.logger, ctx, "Start to delete patron.", layerLib)
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		entity.SendLoggerInfo(l.logger, ctx, "Transaction started for DeletePatron.", layerLib)
+
+		patron, txErr := l.patronRepository.DeletePatron(ctx, patronId)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error deleting patron from repository.", layerLib, txErr)
+			return txErr
+		}
+
+		// Читатель удаляется один раз, поэтому его id делает ключ уникальным
+		idempotencyKey := repository.OutboxKindPatronDeleted.String() + "_" + patron.Id
+		return l.sendToOutbox(ctx, repository.OutboxKindPatronDeleted, idempotencyKey, patron)
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to delete patron.", layerLib, err)
+		return err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Patron deleted.", layerLib, "patron_id", patronId)
+
+	return nil
+}
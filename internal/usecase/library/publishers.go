@@ -0,0 +1,64 @@
+package library
+
+import (
+	"context"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/repository"
+)
+
+func (l *libraryImpl) CreatePublisher(ctx context.Context, name string) (*entity.Publisher, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to create publisher.", layerLib)
+
+	publisher, err := l.publisherRepository.CreatePublisher(ctx, &entity.Publisher{Name: name})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to create publisher.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Publisher created.", layerLib, "publisher_id", publisher.Id)
+
+	return publisher, nil
+}
+
+func (l *libraryImpl) GetPublisher(ctx context.Context, publisherId string) (*entity.Publisher, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to get publisher.", layerLib)
+
+	return l.publisherRepository.GetPublisher(ctx, publisherId)
+}
+
+func (l *libraryImpl) UpdatePublisher(ctx context.Context, publisherId string, newName string) error {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to update publisher.", layerLib)
+
+	return l.publisherRepository.UpdatePublisher(ctx, publisherId, newName)
+}
+
+func (l *libraryImpl) DeletePublisher(ctx context.Context, publisherId string) error {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to delete publisher.", layerLib)
+
+	err := l.transactor.WithTx(ctx, func(ctx context.Context) error {
+		books, txErr := l.publisherRepository.DeletePublisher(ctx, publisherId)
+		if txErr != nil {
+			entity.SendLoggerSpanError(l.logger, ctx, "Error deleting publisher from repository.", layerLib, txErr)
+			return txErr
+		}
+
+		// Книги остались без издательства, потребители получают их новую версию
+		for _, book := range books {
+			idempotencyKey := versionedKey(repository.OutboxKindBook, book.Id, book.UpdatedAt)
+			if txErr = l.sendToOutbox(ctx, repository.OutboxKindBook, idempotencyKey, book); txErr != nil {
+				return txErr
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to delete publisher.", layerLib, err)
+		return err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Publisher deleted.", layerLib, "publisher_id", publisherId)
+
+	return nil
+}
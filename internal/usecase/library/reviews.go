@@ -0,0 +1,52 @@
+package library
+
+import (
+	"context"
+
+	"github.com/project/library/internal/entity"
+)
+
+func (l *libraryImpl) CreateReview(ctx context.Context, review *entity.Review) (*entity.Review, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to create review.", layerLib)
+
+	created, err := l.reviewRepository.CreateReview(ctx, review)
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to create review.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Review created.", layerLib, "review_id", created.Id)
+
+	return created, nil
+}
+
+func (l *libraryImpl) UpdateReview(ctx context.Context, update entity.ReviewUpdate) (*entity.Review, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to update review.", layerLib)
+
+	updated, err := l.reviewRepository.UpdateReview(ctx, update)
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to update review.", layerLib, err)
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (l *libraryImpl) DeleteReview(ctx context.Context, reviewId string) error {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to delete review.", layerLib)
+
+	if err := l.reviewRepository.DeleteReview(ctx, reviewId); err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to delete review.", layerLib, err)
+		return err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Review deleted.", layerLib, "review_id", reviewId)
+
+	return nil
+}
+
+func (l *libraryImpl) ListBookReviews(ctx context.Context, filter entity.ReviewFilter) (*entity.ReviewPage, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to list book reviews.", layerLib)
+
+	return l.reviewRepository.ListBookReviews(ctx, filter)
+}
@@ -0,0 +1,33 @@
+package library
+
+import (
+	"context"
+
+	"github.com/project/library/internal/entity"
+)
+
+func (l *libraryImpl) CreateSeries(ctx context.Context, name string) (*entity.Series, error) {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to create series.", layerLib)
+
+	series, err := l.seriesRepository.CreateSeries(ctx, &entity.Series{Name: name})
+	if err != nil {
+		entity.SendLoggerSpanError(l.logger, ctx, "Failed to create series.", layerLib, err)
+		return nil, err
+	}
+
+	entity.SendLoggerInfoWithCondition(l.logger, ctx, "Series created.", layerLib, "series_id", series.Id)
+
+	return series, nil
+}
+
+func (l *libraryImpl) AddBookToSeries(ctx context.Context, seriesId string, bookId string, volume int32) error {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to add book to series.", layerLib)
+
+	return l.seriesRepository.AddBookToSeries(ctx, seriesId, bookId, volume)
+}
+
+func (l *libraryImpl) RemoveBookFromSeries(ctx context.Context, seriesId string, bookId string) error {
+	entity.SendLoggerInfo(l.logger, ctx, "Start to remove book from series.", layerLib)
+
+	return l.seriesRepository.RemoveBookFromSeries(ctx, seriesId, bookId)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -12,6 +14,8 @@ import (
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/project/library/internal/entity"
 	"github.com/project/library/internal/usecase/library"
@@ -53,6 +57,13 @@ func TestRegisterAuthor(t *testing.T) {
 			repositoryErr:         errors.New("error register author"),
 			outboxErr:             nil,
 		},
+		{
+			name:                  "register author | already exists",
+			repositoryRerunAuthor: nil,
+			returnAuthor:          nil,
+			repositoryErr:         &entity.AlreadyExistsError{Err: entity.ErrAuthorAlreadyExists, Id: uuid.NewString()},
+			outboxErr:             nil,
+		},
 		{
 			name:                  "register author | outbox error",
 			repositoryRerunAuthor: defaultAuthor,
@@ -71,7 +82,7 @@ func TestRegisterAuthor(t *testing.T) {
 			mockTransactor := mocks.NewMockTransactor(ctrl)
 			logger, _ := zap.NewProduction()
 			useCase := library.New(logger, mockAuthorRepo,
-				nil, mockOutboxRepo, mockTransactor)
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
 			ctx := t.Context()
 
 			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
@@ -92,9 +103,9 @@ func TestRegisterAuthor(t *testing.T) {
 			)
 
 			if test.repositoryRerunAuthor == nil {
-				resultAuthor, err = useCase.RegisterAuthor(ctx, defaultAuthor.Name)
+				resultAuthor, err = useCase.RegisterAuthor(ctx, &entity.Author{Name: defaultAuthor.Name})
 			} else {
-				resultAuthor, err = useCase.RegisterAuthor(ctx, test.repositoryRerunAuthor.Name)
+				resultAuthor, err = useCase.RegisterAuthor(ctx, &entity.Author{Name: test.repositoryRerunAuthor.Name})
 			}
 
 			switch {
@@ -141,7 +152,7 @@ func TestGetAuthorInfo(t *testing.T) {
 			mockAuthorRepo := mocks.NewMockAuthorRepository(ctrl)
 			logger, _ := zap.NewProduction()
 			useCase := library.New(logger, mockAuthorRepo,
-				nil, nil, nil)
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			ctx := t.Context()
 
 			mockAuthorRepo.EXPECT().GetAuthorInfo(ctx, test.repositoryRerunAuthor.Id).Return(test.repositoryRerunAuthor, test.wantErr)
@@ -183,13 +194,511 @@ func TestChangeAuthor(t *testing.T) {
 			mockAuthorRepo := mocks.NewMockAuthorRepository(ctrl)
 			logger, _ := zap.NewProduction()
 			useCase := library.New(logger, mockAuthorRepo,
-				nil, nil, nil)
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			ctx := t.Context()
 
-			mockAuthorRepo.EXPECT().ChangeAuthor(ctx, test.repositoryRerunAuthor.Id, test.repositoryRerunAuthor.Name).Return(test.wantErr)
+			update := entity.AuthorUpdate{Id: test.repositoryRerunAuthor.Id, Name: test.repositoryRerunAuthor.Name}
+			mockAuthorRepo.EXPECT().ChangeAuthor(ctx, update).Return(test.wantErr)
 
-			wantErr := useCase.ChangeAuthor(ctx, test.repositoryRerunAuthor.Id, test.repositoryRerunAuthor.Name)
+			wantErr := useCase.ChangeAuthor(ctx, update)
 			CheckError(t, wantErr, test.wantErrCode)
 		})
 	}
 }
+
+func TestRegisterAuthorProfile(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	tests := []struct {
+		name        string
+		author      *entity.Author
+		wantName    string
+		wantAliases []string
+		wantErrCode codes.Code
+	}{
+		{
+			name: "register author | aliases normalized",
+			author: &entity.Author{
+				Name:      "Samuel Clemens",
+				BirthDate: proto.String("1835-11-30"),
+				Aliases:   []string{" Mark Twain ", "Mark Twain", "Samuel Clemens", "Sieur Louis de Conte"},
+			},
+			wantName:    "Samuel Clemens",
+			wantAliases: []string{"Mark Twain", "Sieur Louis de Conte"},
+		},
+		{
+			name: "register author | decomposed name and aliases",
+			author: &entity.Author{
+				Name:    "E\u0301mile Zola",
+				Aliases: []string{"E\u0301mile Zola", "Zo\u0308la"},
+			},
+			wantName:    "\u00c9mile Zola",
+			wantAliases: []string{"Z\u00f6la"},
+		},
+		{
+			name: "register author | nonexistent birth date",
+			author: &entity.Author{
+				Name:      "Samuel Clemens",
+				BirthDate: proto.String("1835-02-30"),
+			},
+			wantErrCode: codes.InvalidArgument,
+		},
+		{
+			name: "register author | invalid death date",
+			author: &entity.Author{
+				Name:      "Samuel Clemens",
+				DeathDate: proto.String("21.04.1910"),
+			},
+			wantErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockAuthorRepo := mocks.NewMockAuthorRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, mockAuthorRepo,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			if test.wantErrCode == codes.OK {
+				mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					})
+				mockAuthorRepo.EXPECT().RegisterAuthor(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, author *entity.Author) (*entity.Author, error) {
+						assert.Equal(t, test.wantName, author.Name)
+						assert.Equal(t, test.wantAliases, author.Aliases)
+						author.Id = uuid.NewString()
+						return author, nil
+					})
+				mockOutboxRepo.EXPECT().SendMessage(ctx, gomock.Any(), repository.OutboxKindAuthor, gomock.Any())
+			}
+
+			author, err := useCase.RegisterAuthor(ctx, test.author)
+			if test.wantErrCode != codes.OK {
+				CheckError(t, err, test.wantErrCode)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.wantAliases, author.Aliases)
+		})
+	}
+}
+
+func TestChangeAuthorProfile(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	noAliases := make([]string, 0)
+	aliases := []string{"Mark Twain", " Mark Twain"}
+	wantAliases := []string{"Mark Twain"}
+
+	tests := []struct {
+		name        string
+		update      entity.AuthorUpdate
+		wantUpdate  entity.AuthorUpdate
+		wantErrCode codes.Code
+	}{
+		{
+			name:       "change author | aliases normalized",
+			update:     entity.AuthorUpdate{Name: "Samuel Clemens", Aliases: &aliases},
+			wantUpdate: entity.AuthorUpdate{Name: "Samuel Clemens", Aliases: &wantAliases},
+		},
+		{
+			name:       "change author | aliases and date removed",
+			update:     entity.AuthorUpdate{Name: "Samuel Clemens", DeathDate: proto.String(""), Aliases: &noAliases},
+			wantUpdate: entity.AuthorUpdate{Name: "Samuel Clemens", DeathDate: proto.String(""), Aliases: &noAliases},
+		},
+		{
+			name:        "change author | invalid birth date",
+			update:      entity.AuthorUpdate{Name: "Samuel Clemens", BirthDate: proto.String("1835-13-01")},
+			wantErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockAuthorRepo := mocks.NewMockAuthorRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, mockAuthorRepo,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			ctx := t.Context()
+
+			if test.wantErrCode == codes.OK {
+				mockAuthorRepo.EXPECT().ChangeAuthor(ctx, test.wantUpdate).Return(nil)
+			}
+
+			err := useCase.ChangeAuthor(ctx, test.update)
+			CheckError(t, err, test.wantErrCode)
+		})
+	}
+}
+
+func TestDeleteAuthor(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	deletedAt := time.Now()
+	detachedBook := &entity.Book{Id: uuid.NewString(), Name: "detached", AuthorIds: []string{uuid.NewString()}}
+	deletedBook := &entity.Book{Id: uuid.NewString(), Name: "deleted", AuthorIds: []string{}, DeletedAt: &deletedAt}
+
+	tests := []struct {
+		name          string
+		policy        entity.DeleteAuthorPolicy
+		removal       *entity.AuthorRemoval
+		repositoryErr error
+		wantMessages  int
+		wantErrCode   codes.Code
+	}{
+		{
+			name:   "delete author | restrict without books",
+			policy: entity.DeleteAuthorPolicyRestrict,
+			removal: &entity.AuthorRemoval{
+				Author:        defaultAuthor,
+				DetachedBooks: []*entity.Book{},
+				DeletedBooks:  []*entity.Book{},
+			},
+			wantMessages: 1,
+		},
+		{
+			name:          "delete author | restrict with books",
+			policy:        entity.DeleteAuthorPolicyRestrict,
+			repositoryErr: entity.ErrAuthorHasBooks,
+			wantErrCode:   codes.FailedPrecondition,
+		},
+		{
+			name:   "delete author | cascade",
+			policy: entity.DeleteAuthorPolicyCascade,
+			removal: &entity.AuthorRemoval{
+				Author:        defaultAuthor,
+				DetachedBooks: []*entity.Book{detachedBook},
+				DeletedBooks:  []*entity.Book{deletedBook},
+			},
+			wantMessages: 3,
+		},
+		{
+			name:          "delete author | not found",
+			policy:        entity.DeleteAuthorPolicyDetach,
+			repositoryErr: entity.ErrAuthorNotFound,
+			wantErrCode:   codes.NotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockAuthorRepo := mocks.NewMockAuthorRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, mockAuthorRepo,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+
+			mockAuthorRepo.EXPECT().DeleteAuthor(ctx, defaultAuthor.Id, test.policy).
+				Return(test.removal, test.repositoryErr)
+
+			if test.repositoryErr == nil {
+				mockOutboxRepo.EXPECT().SendMessage(ctx,
+					repository.OutboxKindAuthorDeleted.String()+"_"+defaultAuthor.Id,
+					repository.OutboxKindAuthorDeleted, gomock.Any()).Return(nil)
+			}
+
+			if test.wantMessages > 1 {
+				mockOutboxRepo.EXPECT().SendMessage(ctx,
+					repository.OutboxKindBook.String()+"_"+detachedBook.Id+"_detached_"+defaultAuthor.Id,
+					repository.OutboxKindBook, gomock.Any()).Return(nil)
+				mockOutboxRepo.EXPECT().SendMessage(ctx, gomock.Any(),
+					repository.OutboxKindBookDeleted, gomock.Any()).Return(nil)
+			}
+
+			removal, err := useCase.DeleteAuthor(ctx, defaultAuthor.Id, test.policy)
+			if test.repositoryErr != nil {
+				require.ErrorIs(t, err, test.repositoryErr)
+				CheckError(t, test.repositoryErr, test.wantErrCode)
+				assert.Nil(t, removal)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.removal, removal)
+		})
+	}
+}
+
+func TestMergeAuthors(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	sourceId := uuid.NewString()
+	duplicateId := uuid.NewString()
+	movedBook := &entity.Book{Id: uuid.NewString(), Name: "moved", AuthorIds: []string{defaultAuthor.Id}}
+
+	tests := []struct {
+		name          string
+		sourceIds     []string
+		wantSourceIds []string // nil - репозиторий не вызывается
+		merge         *entity.AuthorMerge
+		repositoryErr error
+		wantErrCode   codes.Code
+	}{
+		{
+			name:          "merge authors | repeated and upper case ids",
+			sourceIds:     []string{sourceId, strings.ToUpper(sourceId), duplicateId},
+			wantSourceIds: []string{sourceId, duplicateId},
+			merge: &entity.AuthorMerge{
+				Target:    defaultAuthor,
+				SourceIds: []string{sourceId, duplicateId},
+				Books:     []*entity.Book{movedBook},
+				MergedAt:  time.Now(),
+			},
+		},
+		{
+			name:        "merge authors | target among sources",
+			sourceIds:   []string{sourceId, defaultAuthor.Id},
+			wantErrCode: codes.InvalidArgument,
+		},
+		{
+			name:          "merge authors | source not found",
+			sourceIds:     []string{sourceId},
+			wantSourceIds: []string{sourceId},
+			repositoryErr: entity.ErrAuthorNotFound,
+			wantErrCode:   codes.NotFound,
+		},
+		{
+			name:          "merge authors | books become duplicates",
+			sourceIds:     []string{sourceId},
+			wantSourceIds: []string{sourceId},
+			repositoryErr: &entity.AlreadyExistsError{Err: entity.ErrBookAlreadyExists, Id: movedBook.Id},
+			wantErrCode:   codes.AlreadyExists,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockAuthorRepo := mocks.NewMockAuthorRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, mockAuthorRepo,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			if test.wantSourceIds != nil {
+				mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					})
+
+				mockAuthorRepo.EXPECT().MergeAuthors(ctx, test.wantSourceIds, defaultAuthor.Id).
+					Return(test.merge, test.repositoryErr)
+			}
+
+			if test.merge != nil {
+				for _, id := range test.merge.SourceIds {
+					redirect, err := json.Marshal(entity.AuthorRedirect{SourceId: id, TargetId: defaultAuthor.Id})
+					require.NoError(t, err)
+
+					mockOutboxRepo.EXPECT().SendMessage(ctx, repository.OutboxKindAuthorMerged.String()+"_"+id,
+						repository.OutboxKindAuthorMerged, redirect).Return(nil)
+				}
+
+				mockOutboxRepo.EXPECT().SendMessage(ctx, gomock.Any(),
+					repository.OutboxKindAuthor, gomock.Any()).Return(nil)
+				mockOutboxRepo.EXPECT().SendMessage(ctx, gomock.Any(),
+					repository.OutboxKindBook, gomock.Any()).Return(nil)
+			}
+
+			merge, err := useCase.MergeAuthors(ctx, test.sourceIds, defaultAuthor.Id)
+			if test.wantErrCode != codes.OK {
+				CheckError(t, err, test.wantErrCode)
+				assert.Nil(t, merge)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.merge, merge)
+		})
+	}
+}
+
+func TestListAuthors(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	tests := []struct {
+		name        string
+		filter      entity.AuthorFilter
+		returnPage  *entity.AuthorPage
+		wantErr     error
+		wantErrCode codes.Code
+	}{
+		{
+			name:   "list authors",
+			filter: entity.AuthorFilter{NamePrefix: "na", WithBookCount: true},
+			returnPage: &entity.AuthorPage{
+				Authors: []*entity.AuthorEntry{{Author: defaultAuthor}},
+			},
+		},
+		{
+			name:        "list authors | invalid page token",
+			filter:      entity.AuthorFilter{PageToken: "token"},
+			wantErr:     entity.ErrInvalidPageToken,
+			wantErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockAuthorRepo := mocks.NewMockAuthorRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, mockAuthorRepo,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			ctx := t.Context()
+
+			mockAuthorRepo.EXPECT().ListAuthors(ctx, test.filter).Return(test.returnPage, test.wantErr)
+
+			page, err := useCase.ListAuthors(ctx, test.filter)
+			CheckError(t, err, test.wantErrCode)
+			assert.Equal(t, test.returnPage, page)
+		})
+	}
+}
+
+func TestSearchAuthors(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	tests := []struct {
+		name          string
+		search        entity.AuthorSearch
+		returnMatches []*entity.AuthorMatch
+		wantErr       error
+		wantErrCode   codes.Code
+	}{
+		{
+			name:          "search authors",
+			search:        entity.AuthorSearch{Query: "nme", Threshold: 0.2},
+			returnMatches: []*entity.AuthorMatch{{Author: defaultAuthor, Similarity: 0.25}},
+		},
+		{
+			name:        "search authors | repository error",
+			search:      entity.AuthorSearch{Query: "nme"},
+			wantErr:     status.Error(codes.Internal, "error"),
+			wantErrCode: codes.Internal,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockAuthorRepo := mocks.NewMockAuthorRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, mockAuthorRepo,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			ctx := t.Context()
+
+			mockAuthorRepo.EXPECT().SearchAuthors(ctx, test.search).Return(test.returnMatches, test.wantErr)
+
+			matches, err := useCase.SearchAuthors(ctx, test.search)
+			CheckError(t, err, test.wantErrCode)
+			assert.Equal(t, test.returnMatches, matches)
+		})
+	}
+}
+
+func TestGetAuthorHistory(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	revisedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		asOf        *time.Time
+		revisions   []*entity.AuthorRevision
+		author      *entity.Author
+		wantErr     error
+		wantErrCode codes.Code
+	}{
+		{
+			name: "get author history",
+			revisions: []*entity.AuthorRevision{
+				{Author: &entity.Author{Name: "Samuel Clemens"}, RevisedAt: revisedAt},
+				{Author: &entity.Author{Name: "Mark Twain"}, RevisedAt: revisedAt.Add(time.Minute)},
+			},
+		},
+		{
+			name:        "get author history | not found",
+			wantErr:     entity.ErrAuthorNotFound,
+			wantErrCode: codes.NotFound,
+		},
+		{
+			name:   "get author info as of time",
+			asOf:   &revisedAt,
+			author: &entity.Author{Name: "Samuel Clemens"},
+		},
+		{
+			name:        "get author info as of time | not found",
+			asOf:        &revisedAt,
+			wantErr:     entity.ErrAuthorNotFound,
+			wantErrCode: codes.NotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockAuthorRepo := mocks.NewMockAuthorRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, mockAuthorRepo,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			ctx := t.Context()
+			authorId := uuid.NewString()
+
+			if test.asOf != nil {
+				mockAuthorRepo.EXPECT().GetAuthorInfoAsOf(ctx, authorId, *test.asOf).Return(test.author, test.wantErr)
+
+				got, err := useCase.GetAuthorInfoAsOf(ctx, authorId, *test.asOf)
+				CheckError(t, err, test.wantErrCode)
+				assert.Equal(t, test.author, got)
+				return
+			}
+
+			mockAuthorRepo.EXPECT().GetAuthorHistory(ctx, authorId).Return(test.revisions, test.wantErr)
+
+			got, err := useCase.GetAuthorHistory(ctx, authorId)
+			CheckError(t, err, test.wantErrCode)
+			assert.Equal(t, test.revisions, got)
+		})
+	}
+}
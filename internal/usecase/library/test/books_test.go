@@ -4,7 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -13,6 +16,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/project/library/internal/entity"
 	"github.com/project/library/internal/usecase/library"
@@ -72,7 +76,7 @@ func TestAddBook(t *testing.T) {
 			mockTransactor := mocks.NewMockTransactor(ctrl)
 			logger, _ := zap.NewProduction()
 			useCase := library.New(logger, nil,
-				mockBooksRepo, mockOutboxRepo, mockTransactor)
+				mockBooksRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
 			ctx := t.Context()
 
 			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
@@ -88,7 +92,7 @@ func TestAddBook(t *testing.T) {
 					repository.OutboxKindBook, serialized).Return(test.outboxErr)
 			}
 
-			resultBook, err := useCase.AddBook(ctx, book.Name, book.AuthorIds)
+			resultBook, err := useCase.AddBook(ctx, &entity.Book{Name: book.Name, AuthorIds: book.AuthorIds})
 			switch {
 			case test.outboxErr == nil && test.repositoryErr == nil:
 				require.NoError(t, err)
@@ -109,10 +113,14 @@ func TestGetBook(t *testing.T) {
 	t.Cleanup(ctrl.Finish)
 
 	tests := []struct {
-		name        string
-		returnBook  *entity.Book
-		wantErr     error
-		wantErrCode codes.Code
+		name            string
+		returnBook      *entity.Book
+		availability    *entity.CopyAvailability
+		availabilityErr error
+		rating          *entity.BookRating
+		ratingErr       error
+		wantErr         error
+		wantErrCode     codes.Code
 	}{
 		{
 			name: "get book",
@@ -121,6 +129,8 @@ func TestGetBook(t *testing.T) {
 				Name:      "name",
 				AuthorIds: make([]string, 0),
 			},
+			availability: &entity.CopyAvailability{Total: 3, Available: 1},
+			rating:       &entity.BookRating{ReviewCount: 2, RatingSum: 9},
 		},
 		{
 			name: "get book | with error",
@@ -132,6 +142,27 @@ func TestGetBook(t *testing.T) {
 			wantErrCode: codes.Internal,
 			wantErr:     status.Error(codes.Internal, "error"),
 		},
+		{
+			name: "get book | availability error",
+			returnBook: &entity.Book{
+				Id:        uuid.NewString(),
+				Name:      "name",
+				AuthorIds: make([]string, 0),
+			},
+			availabilityErr: status.Error(codes.Internal, "error"),
+			wantErrCode:     codes.Internal,
+		},
+		{
+			name: "get book | rating error",
+			returnBook: &entity.Book{
+				Id:        uuid.NewString(),
+				Name:      "name",
+				AuthorIds: make([]string, 0),
+			},
+			availability: &entity.CopyAvailability{Total: 3, Available: 1},
+			ratingErr:    status.Error(codes.Internal, "error"),
+			wantErrCode:  codes.Internal,
+		},
 	}
 
 	for _, test := range tests {
@@ -139,17 +170,36 @@ func TestGetBook(t *testing.T) {
 			t.Parallel()
 
 			mockBookRepo := mocks.NewMockBooksRepository(ctrl)
+			mockCopyRepo := mocks.NewMockCopyRepository(ctrl)
+			mockReviewRepo := mocks.NewMockReviewRepository(ctrl)
 			logger, _ := zap.NewProduction()
 			useCase := library.New(logger, nil,
-				mockBookRepo, nil, nil)
+				mockBookRepo, nil, nil, nil, mockCopyRepo, nil, nil, nil, nil, mockReviewRepo, nil, nil, nil, nil)
 			ctx := t.Context()
 
 			mockBookRepo.EXPECT().GetBook(ctx, test.returnBook.Id).
 				Return(test.returnBook, test.wantErr)
 
+			if test.wantErr == nil {
+				mockCopyRepo.EXPECT().GetCopyAvailability(ctx, test.returnBook.Id).
+					Return(test.availability, test.availabilityErr)
+			}
+
+			if test.wantErr == nil && test.availabilityErr == nil {
+				mockReviewRepo.EXPECT().GetBookRating(ctx, test.returnBook.Id).
+					Return(test.rating, test.ratingErr)
+			}
+
 			got, err := useCase.GetBook(ctx, test.returnBook.Id)
 			CheckError(t, err, test.wantErrCode)
+			if test.wantErrCode != codes.OK {
+				assert.Nil(t, got)
+				return
+			}
+
 			assert.Equal(t, test.returnBook, got)
+			assert.Equal(t, test.availability, got.Availability)
+			assert.Equal(t, test.rating, got.Rating)
 		})
 	}
 }
@@ -160,17 +210,18 @@ func TestUpdateBook(t *testing.T) {
 	t.Cleanup(ctrl.Finish)
 
 	tests := []struct {
-		name        string
-		returnBook  *entity.Book
-		wantErr     error
-		wantErrCode codes.Code
+		name             string
+		returnBook       *entity.Book
+		expectedRevision *int64
+		wantErr          error
+		wantErrCode      codes.Code
 	}{
 		{
 			name: "update book",
 			returnBook: &entity.Book{
 				Id:        uuid.NewString(),
 				Name:      "name",
-				AuthorIds: make([]string, 0),
+				AuthorIds: []string{uuid.NewString(), uuid.NewString()},
 			},
 		},
 		{
@@ -183,6 +234,17 @@ func TestUpdateBook(t *testing.T) {
 			wantErrCode: codes.NotFound,
 			wantErr:     entity.ErrBookNotFound,
 		},
+		{
+			name: "update book | revision mismatch",
+			returnBook: &entity.Book{
+				Id:        uuid.NewString(),
+				Name:      "name",
+				AuthorIds: []string{uuid.NewString()},
+			},
+			expectedRevision: proto.Int64(2),
+			wantErrCode:      codes.Aborted,
+			wantErr:          entity.ErrBookRevisionMismatch,
+		},
 	}
 
 	for _, test := range tests {
@@ -192,15 +254,28 @@ func TestUpdateBook(t *testing.T) {
 			mockBookRepo := mocks.NewMockBooksRepository(ctrl)
 			logger, _ := zap.NewProduction()
 			useCase := library.New(logger, nil,
-				mockBookRepo, nil, nil)
+				mockBookRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			ctx := t.Context()
 
-			mockBookRepo.EXPECT().UpdateBook(ctx,
-				test.returnBook.Id, test.returnBook.Name, test.returnBook.AuthorIds).
+			update := entity.BookUpdate{
+				Id:        test.returnBook.Id,
+				Name:      test.returnBook.Name,
+				AuthorIds: test.returnBook.AuthorIds,
+
+				ExpectedRevision: test.expectedRevision,
+			}
+
+			// author_ids превращаются в участников с ролью автора
+			resolved := update
+			resolved.Contributors = make([]entity.Contributor, len(update.AuthorIds))
+			for i, authorId := range update.AuthorIds {
+				resolved.Contributors[i] = entity.Contributor{AuthorId: authorId, Role: entity.ContributorRoleAuthor}
+			}
+
+			mockBookRepo.EXPECT().UpdateBook(ctx, resolved).
 				Return(test.wantErr)
 
-			err := useCase.UpdateBook(ctx,
-				test.returnBook.Id, test.returnBook.Name, test.returnBook.AuthorIds)
+			err := useCase.UpdateBook(ctx, update)
 			CheckError(t, err, test.wantErrCode)
 		})
 	}
@@ -211,9 +286,12 @@ func TestGetAuthorBooks(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	t.Cleanup(ctrl.Finish)
 
+	translator := entity.ContributorRoleTranslator
+
 	tests := []struct {
 		name                  string
 		repositoryRerunAuthor *entity.Author
+		role                  *entity.ContributorRole
 		returnBooks           []*entity.Book
 		wantErr               error
 		wantErrCode           codes.Code
@@ -232,6 +310,12 @@ func TestGetAuthorBooks(t *testing.T) {
 				{Name: "second book"},
 			},
 		},
+		{
+			name:                  "get author books | by role",
+			repositoryRerunAuthor: defaultAuthor,
+			role:                  &translator,
+			returnBooks:           []*entity.Book{{Name: "translated book"}},
+		},
 	}
 
 	for _, test := range tests {
@@ -241,14 +325,616 @@ func TestGetAuthorBooks(t *testing.T) {
 			mockBooksRepo := mocks.NewMockBooksRepository(ctrl)
 			logger, _ := zap.NewProduction()
 			useCase := library.New(logger, nil,
-				mockBooksRepo, nil, nil)
+				mockBooksRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			ctx := t.Context()
 
-			mockBooksRepo.EXPECT().GetAuthorBooks(ctx, test.repositoryRerunAuthor.Id).Return(test.returnBooks, test.wantErr)
+			mockBooksRepo.EXPECT().GetAuthorBooks(ctx, test.repositoryRerunAuthor.Id, test.role).
+				Return(test.returnBooks, test.wantErr)
 
-			books, wantErr := useCase.GetAuthorBooks(ctx, test.repositoryRerunAuthor.Id)
+			books, wantErr := useCase.GetAuthorBooks(ctx, test.repositoryRerunAuthor.Id, test.role)
 			CheckError(t, wantErr, test.wantErrCode)
 			assert.Equal(t, test.returnBooks, books)
 		})
 	}
 }
+
+func TestDeleteBook(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	deletedAt := time.Now()
+	book := &entity.Book{
+		Id:        uuid.NewString(),
+		Name:      "Deleted Book",
+		AuthorIds: []string{uuid.NewString()},
+		DeletedAt: &deletedAt,
+	}
+	serialized, _ := json.Marshal(book)
+	idempotencyKey := repository.OutboxKindBookDeleted.String() + "_" + book.Id +
+		"_" + strconv.FormatInt(deletedAt.UnixNano(), 10)
+
+	tests := []struct {
+		name                string
+		repositoryRerunBook *entity.Book
+		repositoryErr       error
+		outboxErr           error
+	}{
+		{
+			name:                "delete book",
+			repositoryRerunBook: book,
+		},
+		{
+			name:          "delete book | book not found",
+			repositoryErr: entity.ErrBookNotFound,
+		},
+		{
+			name:                "delete book | outbox error",
+			repositoryRerunBook: book,
+			outboxErr:           errors.New("cannot send message"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockBooksRepo := mocks.NewMockBooksRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil,
+				mockBooksRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				},
+			)
+			mockBooksRepo.EXPECT().DeleteBook(ctx, book.Id).
+				Return(test.repositoryRerunBook, test.repositoryErr)
+
+			if test.repositoryErr == nil {
+				mockOutboxRepo.EXPECT().SendMessage(ctx, idempotencyKey,
+					repository.OutboxKindBookDeleted, serialized).Return(test.outboxErr)
+			}
+
+			err := useCase.DeleteBook(ctx, book.Id)
+			switch {
+			case test.outboxErr == nil && test.repositoryErr == nil:
+				require.NoError(t, err)
+			case test.outboxErr != nil:
+				require.ErrorIs(t, err, test.outboxErr)
+			case test.repositoryErr != nil:
+				require.ErrorIs(t, err, test.repositoryErr)
+			}
+		})
+	}
+}
+
+func TestRestoreBook(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	book := &entity.Book{
+		Id:        uuid.NewString(),
+		Name:      "Restored Book",
+		AuthorIds: []string{uuid.NewString()},
+		UpdatedAt: time.Now(),
+	}
+	serialized, _ := json.Marshal(book)
+	idempotencyKey := repository.OutboxKindBook.String() + "_" + book.Id +
+		"_" + strconv.FormatInt(book.UpdatedAt.UnixNano(), 10)
+
+	tests := []struct {
+		name                string
+		repositoryRerunBook *entity.Book
+		returnBook          *entity.Book
+		repositoryErr       error
+		outboxErr           error
+	}{
+		{
+			name:                "restore book",
+			repositoryRerunBook: book,
+			returnBook:          book,
+		},
+		{
+			name:          "restore book | book not deleted",
+			repositoryErr: entity.ErrBookNotFound,
+		},
+		{
+			name:                "restore book | outbox error",
+			repositoryRerunBook: book,
+			outboxErr:           errors.New("cannot send message"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockBooksRepo := mocks.NewMockBooksRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil,
+				mockBooksRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				},
+			)
+			mockBooksRepo.EXPECT().RestoreBook(ctx, book.Id).
+				Return(test.repositoryRerunBook, test.repositoryErr)
+
+			if test.repositoryErr == nil {
+				mockOutboxRepo.EXPECT().SendMessage(ctx, idempotencyKey,
+					repository.OutboxKindBook, serialized).Return(test.outboxErr)
+			}
+
+			resultBook, err := useCase.RestoreBook(ctx, book.Id)
+			switch {
+			case test.outboxErr == nil && test.repositoryErr == nil:
+				require.NoError(t, err)
+			case test.outboxErr != nil:
+				require.ErrorIs(t, err, test.outboxErr)
+			case test.repositoryErr != nil:
+				require.ErrorIs(t, err, test.repositoryErr)
+			}
+
+			assert.Equal(t, test.returnBook, resultBook)
+		})
+	}
+}
+
+func TestListBooks(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	tests := []struct {
+		name        string
+		filter      entity.BookFilter
+		returnPage  *entity.BookPage
+		wantErr     error
+		wantErrCode codes.Code
+	}{
+		{
+			name:   "list books",
+			filter: entity.BookFilter{NamePrefix: "name", PageSize: 2},
+			returnPage: &entity.BookPage{
+				Books:         []*entity.Book{{Name: "name 1"}, {Name: "name 2"}},
+				NextPageToken: "token",
+			},
+		},
+		{
+			name:        "list books | invalid page token",
+			filter:      entity.BookFilter{PageToken: "token"},
+			wantErr:     entity.ErrInvalidPageToken,
+			wantErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockBooksRepo := mocks.NewMockBooksRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil,
+				mockBooksRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			ctx := t.Context()
+
+			mockBooksRepo.EXPECT().ListBooks(ctx, test.filter).Return(test.returnPage, test.wantErr)
+
+			page, err := useCase.ListBooks(ctx, test.filter)
+			CheckError(t, err, test.wantErrCode)
+			assert.Equal(t, test.returnPage, page)
+		})
+	}
+}
+
+func TestSearchBooks(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	tests := []struct {
+		name        string
+		search      entity.BookSearch
+		returnPage  *entity.BookSearchPage
+		wantErr     error
+		wantErrCode codes.Code
+	}{
+		{
+			name:   "search books",
+			search: entity.BookSearch{Query: "name", PageSize: 1},
+			returnPage: &entity.BookSearchPage{
+				Hits:          []*entity.BookSearchHit{{Book: &entity.Book{Name: "name"}, Rank: 0.1, Snippet: "<b>name</b>"}},
+				NextPageToken: "token",
+			},
+		},
+		{
+			name:        "search books | invalid page token",
+			search:      entity.BookSearch{Query: "name", PageToken: "token"},
+			wantErr:     entity.ErrInvalidPageToken,
+			wantErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockBooksRepo := mocks.NewMockBooksRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil,
+				mockBooksRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			ctx := t.Context()
+
+			mockBooksRepo.EXPECT().SearchBooks(ctx, test.search).Return(test.returnPage, test.wantErr)
+
+			page, err := useCase.SearchBooks(ctx, test.search)
+			CheckError(t, err, test.wantErrCode)
+			assert.Equal(t, test.returnPage, page)
+		})
+	}
+}
+
+func TestAddBookISBN(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	tests := []struct {
+		name        string
+		isbn        string
+		wantISBN    string
+		wantErrCode codes.Code
+	}{
+		{
+			name:     "add book | isbn-10 normalized to isbn-13",
+			isbn:     "0-8044-2957-X",
+			wantISBN: "9780804429573",
+		},
+		{
+			name:     "add book | isbn-13 with spaces",
+			isbn:     "978 0 306 40615 7",
+			wantISBN: "9780306406157",
+		},
+		{
+			name:        "add book | invalid checksum",
+			isbn:        "978-0-306-40615-8",
+			wantErrCode: codes.InvalidArgument,
+		},
+		{
+			name:        "add book | isbn-13 with unknown prefix",
+			isbn:        "1230306406150",
+			wantErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockBooksRepo := mocks.NewMockBooksRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil,
+				mockBooksRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			if test.wantErrCode == codes.OK {
+				mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					},
+				)
+				mockBooksRepo.EXPECT().AddBook(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, book *entity.Book) (*entity.Book, error) {
+						assert.Equal(t, test.wantISBN, *book.ISBN)
+						book.Id = uuid.NewString()
+						return book, nil
+					},
+				)
+				mockOutboxRepo.EXPECT().SendMessage(ctx, gomock.Any(), repository.OutboxKindBook, gomock.Any())
+			}
+
+			isbn := test.isbn
+			book, err := useCase.AddBook(ctx, &entity.Book{Name: "name", ISBN: &isbn})
+			if test.wantErrCode != codes.OK {
+				CheckError(t, err, test.wantErrCode)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.wantISBN, *book.ISBN)
+		})
+	}
+}
+
+func TestAddBookContributors(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	author, translator := uuid.NewString(), uuid.NewString()
+
+	tests := []struct {
+		name             string
+		authorIds        []string
+		contributors     []entity.Contributor
+		wantContributors []entity.Contributor
+		wantErrCode      codes.Code
+	}{
+		{
+			name:      "add book | author ids become authors",
+			authorIds: []string{author, translator},
+			wantContributors: []entity.Contributor{
+				{AuthorId: author, Role: entity.ContributorRoleAuthor},
+				{AuthorId: translator, Role: entity.ContributorRoleAuthor},
+			},
+		},
+		{
+			name: "add book | contributors with roles",
+			contributors: []entity.Contributor{
+				{AuthorId: author, Role: entity.ContributorRoleAuthor},
+				{AuthorId: translator, Role: entity.ContributorRoleTranslator},
+			},
+			wantContributors: []entity.Contributor{
+				{AuthorId: author, Role: entity.ContributorRoleAuthor},
+				{AuthorId: translator, Role: entity.ContributorRoleTranslator},
+			},
+		},
+		{
+			name:         "add book | author ids combined with contributors",
+			authorIds:    []string{author},
+			contributors: []entity.Contributor{{AuthorId: translator, Role: entity.ContributorRoleTranslator}},
+			wantErrCode:  codes.InvalidArgument,
+		},
+		{
+			name: "add book | duplicated contributor",
+			contributors: []entity.Contributor{
+				{AuthorId: author, Role: entity.ContributorRoleAuthor},
+				{AuthorId: strings.ToUpper(author), Role: entity.ContributorRoleEditor},
+			},
+			wantErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockBooksRepo := mocks.NewMockBooksRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil,
+				mockBooksRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			if test.wantErrCode == codes.OK {
+				mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					},
+				)
+				mockBooksRepo.EXPECT().AddBook(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, book *entity.Book) (*entity.Book, error) {
+						book.Id = uuid.NewString()
+						return book, nil
+					},
+				)
+				mockOutboxRepo.EXPECT().SendMessage(ctx, gomock.Any(), repository.OutboxKindBook, gomock.Any())
+			}
+
+			book, err := useCase.AddBook(ctx, &entity.Book{
+				Name:         "name",
+				AuthorIds:    test.authorIds,
+				Contributors: test.contributors,
+			})
+			if test.wantErrCode != codes.OK {
+				CheckError(t, err, test.wantErrCode)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.wantContributors, book.Contributors)
+			assert.Equal(t, []string{author, translator}, book.AuthorIds)
+		})
+	}
+}
+
+func TestGetBookByISBN(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	tests := []struct {
+		name        string
+		isbn        string
+		repoISBN    string
+		returnBook  *entity.Book
+		wantErr     error
+		wantErrCode codes.Code
+	}{
+		{
+			name:       "get book by isbn-10",
+			isbn:       "0306406152",
+			repoISBN:   "9780306406157",
+			returnBook: &entity.Book{Id: uuid.NewString(), Name: "name"},
+		},
+		{
+			name:        "get book by isbn | not found",
+			isbn:        "9780306406157",
+			repoISBN:    "9780306406157",
+			wantErr:     entity.ErrBookNotFound,
+			wantErrCode: codes.NotFound,
+		},
+		{
+			name:        "get book by isbn | invalid isbn",
+			isbn:        "0306406153",
+			wantErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockBookRepo := mocks.NewMockBooksRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil,
+				mockBookRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			ctx := t.Context()
+
+			if test.repoISBN != "" {
+				mockBookRepo.EXPECT().GetBookByISBN(ctx, test.repoISBN).
+					Return(test.returnBook, test.wantErr)
+			}
+
+			got, err := useCase.GetBookByISBN(ctx, test.isbn)
+			CheckError(t, err, test.wantErrCode)
+			assert.Equal(t, test.returnBook, got)
+		})
+	}
+}
+
+func TestUpdateBookLanguage(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	tests := []struct {
+		name         string
+		language     *string
+		wantLanguage *string
+		wantErrCode  codes.Code
+	}{
+		{
+			name:         "update book | language normalized",
+			language:     proto.String("EN-us"),
+			wantLanguage: proto.String("en-US"),
+		},
+		{
+			name:         "update book | language removed",
+			language:     proto.String(""),
+			wantLanguage: proto.String(""),
+		},
+		{
+			name: "update book | language unchanged",
+		},
+		{
+			name:        "update book | invalid language",
+			language:    proto.String("not a language"),
+			wantErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockBookRepo := mocks.NewMockBooksRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil,
+				mockBookRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			ctx := t.Context()
+
+			id := uuid.NewString()
+			if test.wantErrCode == codes.OK {
+				mockBookRepo.EXPECT().UpdateBook(ctx, entity.BookUpdate{
+					Id:           id,
+					Name:         "name",
+					AuthorIds:    []string{},
+					Contributors: []entity.Contributor{},
+					Language:     test.wantLanguage,
+				}).Return(nil)
+			}
+
+			err := useCase.UpdateBook(ctx, entity.BookUpdate{Id: id, Name: "name", Language: test.language})
+			if test.wantErrCode == codes.OK {
+				require.NoError(t, err)
+				return
+			}
+
+			CheckError(t, err, test.wantErrCode)
+		})
+	}
+}
+
+func TestGetBookHistory(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	revisedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		asOf        *time.Time
+		revisions   []*entity.BookRevision
+		book        *entity.Book
+		wantErr     error
+		wantErrCode codes.Code
+	}{
+		{
+			name: "get book history",
+			revisions: []*entity.BookRevision{
+				{Book: &entity.Book{Name: "old"}, RevisedAt: revisedAt},
+				{Book: &entity.Book{Name: "new"}, RevisedAt: revisedAt.Add(time.Minute)},
+			},
+		},
+		{
+			name:        "get book history | not found",
+			wantErr:     entity.ErrBookNotFound,
+			wantErrCode: codes.NotFound,
+		},
+		{
+			name: "get book as of time",
+			asOf: &revisedAt,
+			book: &entity.Book{Name: "old"},
+		},
+		{
+			name:        "get book as of time | not found",
+			asOf:        &revisedAt,
+			wantErr:     entity.ErrBookNotFound,
+			wantErrCode: codes.NotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockBookRepo := mocks.NewMockBooksRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil,
+				mockBookRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			ctx := t.Context()
+			bookId := uuid.NewString()
+
+			if test.asOf != nil {
+				mockBookRepo.EXPECT().GetBookAsOf(ctx, bookId, *test.asOf).Return(test.book, test.wantErr)
+
+				got, err := useCase.GetBookAsOf(ctx, bookId, *test.asOf)
+				CheckError(t, err, test.wantErrCode)
+				assert.Equal(t, test.book, got)
+				return
+			}
+
+			mockBookRepo.EXPECT().GetBookHistory(ctx, bookId).Return(test.revisions, test.wantErr)
+
+			got, err := useCase.GetBookHistory(ctx, bookId)
+			CheckError(t, err, test.wantErrCode)
+			assert.Equal(t, test.revisions, got)
+		})
+	}
+}
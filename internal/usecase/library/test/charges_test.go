@@ -0,0 +1,338 @@
+package library
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library"
+	"github.com/project/library/internal/usecase/repository"
+	"github.com/project/library/internal/usecase/repository/mocks"
+)
+
+func newCharge() *entity.Charge {
+	now := time.Now()
+	return &entity.Charge{
+		Id:         uuid.NewString(),
+		PatronId:   uuid.NewString(),
+		BookId:     uuid.NewString(),
+		DueAt:      now.AddDate(0, 0, -5),
+		ReturnedAt: now,
+		DaysLate:   5,
+		Amount:     100,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+func chargeIdempotencyKey(kind repository.OutboxKind, charge *entity.Charge, at time.Time) string {
+	return kind.String() + "_" + charge.Id + "_" + strconv.FormatInt(at.UnixNano(), 10)
+}
+
+func TestRecordLateReturn(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	charge := newCharge()
+	serialized, _ := json.Marshal(charge)
+	lateReturn := entity.LateReturn{
+		PatronId:   charge.PatronId,
+		BookId:     charge.BookId,
+		DueAt:      charge.DueAt,
+		ReturnedAt: charge.ReturnedAt,
+	}
+
+	tests := []struct {
+		name          string
+		lateReturn    entity.LateReturn
+		repositoryErr error
+		outboxErr     error
+		wantErrCode   codes.Code
+		repoUsed      bool
+	}{
+		{
+			name:       "record late return",
+			lateReturn: lateReturn,
+			repoUsed:   true,
+		},
+		{
+			name: "record late return | returned before due date",
+			lateReturn: entity.LateReturn{
+				PatronId:   charge.PatronId,
+				BookId:     charge.BookId,
+				DueAt:      charge.ReturnedAt,
+				ReturnedAt: charge.DueAt,
+			},
+			wantErrCode: codes.InvalidArgument,
+		},
+		{
+			name:          "record late return | within grace period",
+			lateReturn:    lateReturn,
+			repositoryErr: entity.ErrReturnWithinGrace,
+			wantErrCode:   codes.FailedPrecondition,
+			repoUsed:      true,
+		},
+		{
+			name:          "record late return | already charged",
+			lateReturn:    lateReturn,
+			repositoryErr: entity.ErrChargeAlreadyExists,
+			wantErrCode:   codes.AlreadyExists,
+			repoUsed:      true,
+		},
+		{
+			name:       "record late return | outbox error",
+			lateReturn: lateReturn,
+			outboxErr:  errors.New("outbox error"),
+			repoUsed:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockChargeRepo := mocks.NewMockChargeRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+				mockChargeRepo, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			if test.repoUsed {
+				mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					})
+			}
+
+			switch {
+			case !test.repoUsed:
+			case test.repositoryErr != nil:
+				mockChargeRepo.EXPECT().RecordLateReturn(ctx, test.lateReturn).Return(nil, test.repositoryErr)
+			default:
+				mockChargeRepo.EXPECT().RecordLateReturn(ctx, test.lateReturn).Return(charge, nil)
+				mockOutboxRepo.EXPECT().SendMessage(ctx,
+					chargeIdempotencyKey(repository.OutboxKindCharge, charge, charge.UpdatedAt),
+					repository.OutboxKindCharge, serialized).Return(test.outboxErr)
+			}
+
+			result, err := useCase.RecordLateReturn(ctx, test.lateReturn)
+			switch {
+			case test.outboxErr != nil:
+				require.ErrorIs(t, err, test.outboxErr)
+			case test.wantErrCode != codes.OK:
+				CheckError(t, err, test.wantErrCode)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, charge, result)
+				return
+			}
+
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestSettleCharge(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	const reason = "book was damaged before checkout"
+
+	paid := newCharge()
+	paid.Status = entity.ChargeStatusPaid
+
+	waived := newCharge()
+	waived.Status = entity.ChargeStatusWaived
+	waiverReason := reason
+	waived.WaiverReason = &waiverReason
+
+	tests := []struct {
+		name          string
+		waive         bool
+		charge        *entity.Charge
+		repositoryErr error
+		wantErrCode   codes.Code
+	}{
+		{
+			name:   "pay charge",
+			charge: paid,
+		},
+		{
+			name:   "waive charge",
+			waive:  true,
+			charge: waived,
+		},
+		{
+			name:          "pay charge | already settled",
+			charge:        paid,
+			repositoryErr: entity.ErrChargeSettled,
+			wantErrCode:   codes.FailedPrecondition,
+		},
+		{
+			name:          "waive charge | not found",
+			waive:         true,
+			charge:        waived,
+			repositoryErr: entity.ErrChargeNotFound,
+			wantErrCode:   codes.NotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockChargeRepo := mocks.NewMockChargeRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+				mockChargeRepo, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+
+			var result *entity.Charge
+			if test.repositoryErr == nil {
+				serialized, _ := json.Marshal(test.charge)
+				mockOutboxRepo.EXPECT().SendMessage(ctx,
+					chargeIdempotencyKey(repository.OutboxKindCharge, test.charge, test.charge.UpdatedAt),
+					repository.OutboxKindCharge, serialized).Return(nil)
+				result = test.charge
+			}
+
+			var err error
+			if test.waive {
+				mockChargeRepo.EXPECT().WaiveCharge(ctx, test.charge.Id, reason).Return(result, test.repositoryErr)
+				result, err = useCase.WaiveCharge(ctx, test.charge.Id, reason)
+			} else {
+				mockChargeRepo.EXPECT().PayCharge(ctx, test.charge.Id).Return(result, test.repositoryErr)
+				result, err = useCase.PayCharge(ctx, test.charge.Id)
+			}
+
+			if test.repositoryErr != nil {
+				CheckError(t, err, test.wantErrCode)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.charge, result)
+		})
+	}
+}
+
+func TestListCharges(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	charge := newCharge()
+	filter := entity.ChargeFilter{PatronId: charge.PatronId, IncludeSettled: true}
+
+	mockChargeRepo := mocks.NewMockChargeRepository(ctrl)
+	logger, _ := zap.NewProduction()
+	useCase := library.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockChargeRepo, nil, nil, nil, nil, nil)
+	ctx := t.Context()
+
+	mockChargeRepo.EXPECT().ListCharges(ctx, filter).Return([]*entity.Charge{charge}, nil)
+
+	result, err := useCase.ListCharges(ctx, filter)
+	require.NoError(t, err)
+	assert.Equal(t, []*entity.Charge{charge}, result)
+}
+
+func TestSendChargeReminders(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	remindedAt := time.Now()
+	first := newCharge()
+	first.RemindedAt = &remindedAt
+	second := newCharge()
+	second.RemindedAt = &remindedAt
+
+	tests := []struct {
+		name          string
+		charges       []*entity.Charge
+		repositoryErr error
+		outboxErr     error
+		wantSent      int
+	}{
+		{
+			name:     "send charge reminders",
+			charges:  []*entity.Charge{first, second},
+			wantSent: 2,
+		},
+		{
+			name:    "send charge reminders | nothing to remind",
+			charges: []*entity.Charge{},
+		},
+		{
+			name:          "send charge reminders | repository error",
+			repositoryErr: errors.New("repository error"),
+		},
+		{
+			name:      "send charge reminders | outbox error",
+			charges:   []*entity.Charge{first},
+			outboxErr: errors.New("outbox error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockChargeRepo := mocks.NewMockChargeRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+				mockChargeRepo, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+
+			mockChargeRepo.EXPECT().ClaimChargeReminders(ctx, gomock.Any()).Return(test.charges, test.repositoryErr)
+			for _, charge := range test.charges {
+				serialized, _ := json.Marshal(charge)
+				mockOutboxRepo.EXPECT().SendMessage(ctx,
+					chargeIdempotencyKey(repository.OutboxKindChargeReminder, charge, *charge.RemindedAt),
+					repository.OutboxKindChargeReminder, serialized).Return(test.outboxErr)
+			}
+
+			sent, err := useCase.SendChargeReminders(ctx)
+			switch {
+			case test.repositoryErr != nil:
+				require.ErrorIs(t, err, test.repositoryErr)
+			case test.outboxErr != nil:
+				require.ErrorIs(t, err, test.outboxErr)
+			default:
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, test.wantSent, sent)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package library
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library"
+	"github.com/project/library/internal/usecase/repository/mocks"
+)
+
+func TestAddCopy(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	newCopy := &entity.Copy{
+		BookId:    uuid.NewString(),
+		Barcode:   "LIB-0001",
+		Branch:    "central",
+		Condition: entity.CopyConditionNew,
+	}
+
+	tests := []struct {
+		name          string
+		repositoryErr error
+		wantErrCode   codes.Code
+	}{
+		{
+			name: "add copy",
+		},
+		{
+			name:          "add copy | barcode taken",
+			repositoryErr: &entity.AlreadyExistsError{Err: entity.ErrCopyAlreadyExists, Id: uuid.NewString()},
+			wantErrCode:   codes.AlreadyExists,
+		},
+		{
+			name:          "add copy | book not found",
+			repositoryErr: entity.ErrBookNotFound,
+			wantErrCode:   codes.NotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCopyRepo := mocks.NewMockCopyRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, mockCopyRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			ctx := t.Context()
+
+			var added *entity.Copy
+			if test.repositoryErr == nil {
+				added = &entity.Copy{
+					Id:        uuid.NewString(),
+					BookId:    newCopy.BookId,
+					Barcode:   newCopy.Barcode,
+					Branch:    newCopy.Branch,
+					Condition: newCopy.Condition,
+					CreatedAt: time.Now(),
+				}
+			}
+
+			mockCopyRepo.EXPECT().AddCopy(ctx, newCopy).Return(added, test.repositoryErr)
+
+			result, err := useCase.AddCopy(ctx, newCopy)
+			if test.repositoryErr != nil {
+				require.ErrorIs(t, err, test.repositoryErr)
+				CheckError(t, err, test.wantErrCode)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, added, result)
+		})
+	}
+}
+
+func TestUpdateCopy(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	copyId := uuid.NewString()
+	inRepair := entity.CopyStatusInRepair
+	retired := entity.CopyStatusRetired
+
+	tests := []struct {
+		name           string
+		update         entity.CopyUpdate
+		repositoryUsed bool
+		repositoryErr  error
+		wantErrCode    codes.Code
+	}{
+		{
+			name:           "update copy",
+			update:         entity.CopyUpdate{Id: copyId, Status: &inRepair},
+			repositoryUsed: true,
+		},
+		{
+			name:        "update copy | retire through update",
+			update:      entity.CopyUpdate{Id: copyId, Status: &retired},
+			wantErrCode: codes.InvalidArgument,
+		},
+		{
+			name:           "update copy | copy retired",
+			update:         entity.CopyUpdate{Id: copyId, Status: &inRepair},
+			repositoryUsed: true,
+			repositoryErr:  entity.ErrCopyRetired,
+			wantErrCode:    codes.FailedPrecondition,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCopyRepo := mocks.NewMockCopyRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, mockCopyRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			ctx := t.Context()
+
+			if test.repositoryUsed {
+				mockCopyRepo.EXPECT().UpdateCopy(ctx, test.update).Return(test.repositoryErr)
+			}
+
+			err := useCase.UpdateCopy(ctx, test.update)
+			CheckError(t, err, test.wantErrCode)
+		})
+	}
+}
+
+func TestRetireCopy(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	retiredAt := time.Now()
+	retired := &entity.Copy{
+		Id:        uuid.NewString(),
+		Status:    entity.CopyStatusRetired,
+		RetiredAt: &retiredAt,
+	}
+
+	tests := []struct {
+		name          string
+		repositoryErr error
+	}{
+		{
+			name: "retire copy",
+		},
+		{
+			name:          "retire copy | repository error",
+			repositoryErr: errors.New("error retire copy"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCopyRepo := mocks.NewMockCopyRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, mockCopyRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			ctx := t.Context()
+
+			var result *entity.Copy
+			if test.repositoryErr == nil {
+				result = retired
+			}
+
+			mockCopyRepo.EXPECT().RetireCopy(ctx, retired.Id).Return(result, test.repositoryErr)
+
+			got, err := useCase.RetireCopy(ctx, retired.Id)
+			if test.repositoryErr != nil {
+				require.ErrorIs(t, err, test.repositoryErr)
+				assert.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, retired, got)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package library
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library"
+	"github.com/project/library/internal/usecase/repository/mocks"
+)
+
+var pngCover = []byte("\x89PNG\r\n\x1a\n cover")
+
+func TestUploadBookCover(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	tests := []struct {
+		name        string
+		contentType string
+		content     []byte
+		getBookErr  error
+		wantErr     error
+	}{
+		{
+			name:        "upload book cover",
+			contentType: "image/png",
+			content:     pngCover,
+		},
+		{
+			name:        "upload book cover | book not found",
+			contentType: "image/png",
+			content:     pngCover,
+			getBookErr:  entity.ErrBookNotFound,
+			wantErr:     entity.ErrBookNotFound,
+		},
+		{
+			name:        "upload book cover | content type mismatch",
+			contentType: "image/jpeg",
+			content:     pngCover,
+			wantErr:     entity.ErrCoverContentTypeMismatch,
+		},
+		{
+			name:        "upload book cover | empty",
+			contentType: "image/png",
+			wantErr:     entity.ErrEmptyCover,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockBookRepo := mocks.NewMockBooksRepository(ctrl)
+			mockCoverRepo := mocks.NewMockCoverRepository(ctrl)
+			mockBlobStorage := mocks.NewMockBlobStorage(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, mockBookRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+				mockCoverRepo, mockBlobStorage, nil, nil)
+			ctx := t.Context()
+
+			bookId := uuid.NewString()
+			mockBookRepo.EXPECT().GetBook(ctx, bookId).Return(&entity.Book{Id: bookId}, test.getBookErr)
+
+			blob := &entity.Blob{SHA256: "sha256", Size: int64(len(test.content))}
+			if test.wantErr == nil {
+				mockBlobStorage.EXPECT().PutBlob(ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, content io.Reader) (*entity.Blob, error) {
+						stored, err := io.ReadAll(content)
+						require.NoError(t, err)
+						assert.Equal(t, test.content, stored)
+						return blob, nil
+					})
+
+				mockCoverRepo.EXPECT().SetBookCover(ctx, &entity.BookCover{
+					BookId:      bookId,
+					ContentType: test.contentType,
+					Size:        blob.Size,
+					SHA256:      blob.SHA256,
+				}).DoAndReturn(func(_ context.Context, cover *entity.BookCover) (*entity.BookCover, error) {
+					return cover, nil
+				})
+			}
+
+			cover, err := useCase.UploadBookCover(ctx, entity.CoverUpload{
+				BookId:      bookId,
+				ContentType: test.contentType,
+			}, bytes.NewReader(test.content))
+			if test.wantErr != nil {
+				require.ErrorIs(t, err, test.wantErr)
+				assert.Nil(t, cover)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, blob.SHA256, cover.SHA256)
+		})
+	}
+}
+
+func TestDownloadBookCover(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	tests := []struct {
+		name     string
+		coverErr error
+		blobErr  error
+		wantErr  error
+	}{
+		{
+			name: "download book cover",
+		},
+		{
+			name:     "download book cover | no cover",
+			coverErr: entity.ErrCoverNotFound,
+			wantErr:  entity.ErrCoverNotFound,
+		},
+		{
+			name:    "download book cover | content missing",
+			blobErr: entity.ErrBlobNotFound,
+			wantErr: entity.ErrCoverNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCoverRepo := mocks.NewMockCoverRepository(ctrl)
+			mockBlobStorage := mocks.NewMockBlobStorage(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+				mockCoverRepo, mockBlobStorage, nil, nil)
+			ctx := t.Context()
+
+			bookId := uuid.NewString()
+			stored := &entity.BookCover{BookId: bookId, ContentType: "image/png", SHA256: "sha256"}
+			if test.coverErr != nil {
+				stored = nil
+			}
+
+			mockCoverRepo.EXPECT().GetBookCover(ctx, bookId).Return(stored, test.coverErr)
+
+			if test.coverErr == nil {
+				var content io.ReadCloser
+				if test.blobErr == nil {
+					content = io.NopCloser(bytes.NewReader(pngCover))
+				}
+
+				mockBlobStorage.EXPECT().GetBlob(ctx, "sha256").Return(content, test.blobErr)
+			}
+
+			cover, content, err := useCase.DownloadBookCover(ctx, bookId)
+			if test.wantErr != nil {
+				require.ErrorIs(t, err, test.wantErr)
+				assert.Nil(t, cover)
+				assert.Nil(t, content)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, stored, cover)
+
+			read, err := io.ReadAll(content)
+			require.NoError(t, err)
+			assert.Equal(t, pngCover, read)
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package library
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library"
+	"github.com/project/library/internal/usecase/repository"
+	"github.com/project/library/internal/usecase/repository/mocks"
+)
+
+func TestCreateGenre(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	parentId := uuid.NewString()
+	genre := &entity.Genre{Name: "Science Fiction", ParentId: &parentId}
+
+	tests := []struct {
+		name          string
+		repositoryErr error
+		wantCode      codes.Code
+	}{
+		{
+			name:          "create genre",
+			repositoryErr: nil,
+			wantCode:      codes.OK,
+		},
+		{
+			name:          "create genre | parent not found",
+			repositoryErr: entity.ErrGenreNotFound,
+			wantCode:      codes.NotFound,
+		},
+		{
+			name:          "create genre | already exists",
+			repositoryErr: &entity.AlreadyExistsError{Err: entity.ErrGenreAlreadyExists, Id: uuid.NewString()},
+			wantCode:      codes.AlreadyExists,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockGenreRepo := mocks.NewMockGenreRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, mockGenreRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			ctx := t.Context()
+
+			var created *entity.Genre
+			if test.repositoryErr == nil {
+				created = &entity.Genre{Id: uuid.NewString(), Name: genre.Name, ParentId: genre.ParentId}
+			}
+
+			mockGenreRepo.EXPECT().CreateGenre(ctx, genre).Return(created, test.repositoryErr)
+
+			result, err := useCase.CreateGenre(ctx, genre)
+
+			if test.repositoryErr == nil {
+				require.NoError(t, err)
+				assert.Equal(t, created, result)
+				return
+			}
+
+			CheckError(t, err, test.wantCode)
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestAttachBookGenres(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	bookId := uuid.NewString()
+	genreIds := []string{uuid.NewString(), uuid.NewString()}
+	attachedAt := time.Now()
+
+	attached := make([]*entity.BookGenre, len(genreIds))
+	for i, genreId := range genreIds {
+		attached[i] = &entity.BookGenre{BookId: bookId, GenreId: genreId, AttachedAt: attachedAt}
+	}
+
+	tests := []struct {
+		name          string
+		requested     []string
+		attached      []*entity.BookGenre
+		repositoryErr error
+		outboxErr     error
+	}{
+		{
+			name:      "attach book genres",
+			requested: genreIds,
+			attached:  attached,
+		},
+		{
+			name:      "attach book genres | duplicate ids",
+			requested: append(genreIds, genreIds...),
+			attached:  attached,
+		},
+		{
+			name:      "attach book genres | already attached",
+			requested: genreIds,
+			attached:  []*entity.BookGenre{},
+		},
+		{
+			name:          "attach book genres | genre not found",
+			requested:     genreIds,
+			repositoryErr: entity.ErrGenreNotFound,
+		},
+		{
+			name:      "attach book genres | outbox error",
+			requested: genreIds,
+			attached:  attached,
+			outboxErr: errors.New("outbox error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockGenreRepo := mocks.NewMockGenreRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, mockGenreRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+
+			mockGenreRepo.EXPECT().AttachBookGenres(ctx, bookId, genreIds).
+				Return(test.attached, test.repositoryErr)
+
+			if test.repositoryErr == nil && len(test.attached) > 0 {
+				// При ошибке outbox остальные сообщения не отправляются
+				sends := test.attached
+				if test.outboxErr != nil {
+					sends = sends[:1]
+				}
+
+				for _, bookGenre := range sends {
+					serialized, _ := json.Marshal(bookGenre)
+					idempotencyKey := repository.OutboxKindBookGenreAdded.String() + "_" + bookId + "_" +
+						bookGenre.GenreId + "_" + strconv.FormatInt(attachedAt.UnixNano(), 10)
+					mockOutboxRepo.EXPECT().SendMessage(ctx, idempotencyKey,
+						repository.OutboxKindBookGenreAdded, serialized).Return(test.outboxErr)
+				}
+			}
+
+			result, err := useCase.AttachBookGenres(ctx, bookId, test.requested)
+
+			switch {
+			case test.repositoryErr != nil:
+				CheckError(t, err, codes.NotFound)
+				assert.Nil(t, result)
+			case test.outboxErr != nil:
+				require.ErrorIs(t, err, test.outboxErr)
+				assert.Nil(t, result)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, test.attached, result)
+			}
+		})
+	}
+}
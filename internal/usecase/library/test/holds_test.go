@@ -0,0 +1,414 @@
+package library
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library"
+	"github.com/project/library/internal/usecase/repository"
+	"github.com/project/library/internal/usecase/repository/mocks"
+)
+
+func newHold() *entity.Hold {
+	now := time.Now()
+	return &entity.Hold{
+		Id:        uuid.NewString(),
+		BookId:    uuid.NewString(),
+		PatronId:  uuid.NewString(),
+		Status:    entity.HoldStatusWaiting,
+		PlacedAt:  now,
+		UpdatedAt: now,
+	}
+}
+
+func newReadyHold(bookId string) *entity.Hold {
+	hold := newHold()
+	readyAt := time.Now()
+	expiresAt := readyAt.AddDate(0, 0, 3)
+	hold.BookId = bookId
+	hold.Status = entity.HoldStatusReady
+	hold.ReadyAt = &readyAt
+	hold.ExpiresAt = &expiresAt
+	return hold
+}
+
+func holdIdempotencyKey(hold *entity.Hold) string {
+	return repository.OutboxKindHold.String() + "_" + hold.Id + "_" + strconv.FormatInt(hold.UpdatedAt.UnixNano(), 10)
+}
+
+func expectHoldOutbox(ctx context.Context, mockOutboxRepo *mocks.MockOutboxRepository, hold *entity.Hold, err error) {
+	serialized, _ := json.Marshal(hold)
+	mockOutboxRepo.EXPECT().SendMessage(ctx, holdIdempotencyKey(hold), repository.OutboxKindHold, serialized).Return(err)
+}
+
+func TestPlaceHold(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	hold := newHold()
+
+	tests := []struct {
+		name          string
+		repositoryErr error
+		outboxErr     error
+		wantErrCode   codes.Code
+	}{
+		{
+			name: "place hold",
+		},
+		{
+			name:          "place hold | already exists",
+			repositoryErr: entity.ErrHoldAlreadyExists,
+			wantErrCode:   codes.AlreadyExists,
+		},
+		{
+			name:          "place hold | book not found",
+			repositoryErr: entity.ErrBookNotFound,
+			wantErrCode:   codes.NotFound,
+		},
+		{
+			name:      "place hold | outbox error",
+			outboxErr: errors.New("outbox error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockHoldRepo := mocks.NewMockHoldRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, mockHoldRepo, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+
+			if test.repositoryErr != nil {
+				mockHoldRepo.EXPECT().PlaceHold(ctx, hold.BookId, hold.PatronId).Return(nil, test.repositoryErr)
+			} else {
+				mockHoldRepo.EXPECT().PlaceHold(ctx, hold.BookId, hold.PatronId).Return(hold, nil)
+				expectHoldOutbox(ctx, mockOutboxRepo, hold, test.outboxErr)
+			}
+
+			result, err := useCase.PlaceHold(ctx, hold.BookId, hold.PatronId)
+			switch {
+			case test.outboxErr != nil:
+				require.ErrorIs(t, err, test.outboxErr)
+			case test.repositoryErr != nil:
+				CheckError(t, err, test.wantErrCode)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, hold, result)
+				return
+			}
+
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestCancelHold(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	waiting := newHold()
+	waiting.Status = entity.HoldStatusCancelled
+
+	ready := newReadyHold(uuid.NewString())
+	ready.Status = entity.HoldStatusCancelled
+
+	next := newReadyHold(ready.BookId)
+
+	tests := []struct {
+		name          string
+		hold          *entity.Hold
+		next          *entity.Hold
+		nextErr       error
+		repositoryErr error
+		wantErrCode   codes.Code
+	}{
+		{
+			name: "cancel hold | waiting",
+			hold: waiting,
+		},
+		{
+			name: "cancel hold | ready advances queue",
+			hold: ready,
+			next: next,
+		},
+		{
+			name:    "cancel hold | ready with empty queue",
+			hold:    ready,
+			nextErr: entity.ErrNoWaitingHolds,
+		},
+		{
+			name:          "cancel hold | not found",
+			hold:          waiting,
+			repositoryErr: entity.ErrHoldNotFound,
+			wantErrCode:   codes.NotFound,
+		},
+		{
+			name:          "cancel hold | closed",
+			hold:          waiting,
+			repositoryErr: entity.ErrHoldClosed,
+			wantErrCode:   codes.FailedPrecondition,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockHoldRepo := mocks.NewMockHoldRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, mockHoldRepo, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+
+			if test.repositoryErr != nil {
+				mockHoldRepo.EXPECT().CancelHold(ctx, test.hold.Id).Return(nil, test.repositoryErr)
+			} else {
+				mockHoldRepo.EXPECT().CancelHold(ctx, test.hold.Id).Return(test.hold, nil)
+				expectHoldOutbox(ctx, mockOutboxRepo, test.hold, nil)
+			}
+
+			switch {
+			case test.next != nil:
+				mockHoldRepo.EXPECT().FulfillNextHold(ctx, test.hold.BookId).Return(test.next, nil)
+				expectHoldOutbox(ctx, mockOutboxRepo, test.next, nil)
+			case test.nextErr != nil:
+				mockHoldRepo.EXPECT().FulfillNextHold(ctx, test.hold.BookId).Return(nil, test.nextErr)
+			}
+
+			result, err := useCase.CancelHold(ctx, test.hold.Id)
+			if test.repositoryErr != nil {
+				CheckError(t, err, test.wantErrCode)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.hold, result)
+		})
+	}
+}
+
+func TestListHolds(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	hold := newHold()
+
+	tests := []struct {
+		name          string
+		filter        entity.HoldFilter
+		repositoryErr error
+		wantErrCode   codes.Code
+	}{
+		{
+			name:   "list holds | by book",
+			filter: entity.HoldFilter{BookId: hold.BookId},
+		},
+		{
+			name:   "list holds | by patron",
+			filter: entity.HoldFilter{PatronId: hold.PatronId, IncludeClosed: true},
+		},
+		{
+			name:        "list holds | empty filter",
+			wantErrCode: codes.InvalidArgument,
+		},
+		{
+			name:          "list holds | repository error",
+			filter:        entity.HoldFilter{BookId: hold.BookId},
+			repositoryErr: entity.ErrHoldNotFound,
+			wantErrCode:   codes.NotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockHoldRepo := mocks.NewMockHoldRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, mockHoldRepo, nil, nil, nil, nil, nil, nil)
+			ctx := t.Context()
+
+			if test.filter.BookId != "" || test.filter.PatronId != "" {
+				if test.repositoryErr != nil {
+					mockHoldRepo.EXPECT().ListHolds(ctx, test.filter).Return(nil, test.repositoryErr)
+				} else {
+					mockHoldRepo.EXPECT().ListHolds(ctx, test.filter).Return([]*entity.Hold{hold}, nil)
+				}
+			}
+
+			result, err := useCase.ListHolds(ctx, test.filter)
+			if test.wantErrCode != codes.OK {
+				CheckError(t, err, test.wantErrCode)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, []*entity.Hold{hold}, result)
+		})
+	}
+}
+
+func TestFulfillNextHold(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	hold := newReadyHold(uuid.NewString())
+
+	tests := []struct {
+		name          string
+		repositoryErr error
+		outboxErr     error
+		wantErrCode   codes.Code
+	}{
+		{
+			name: "fulfill next hold",
+		},
+		{
+			name:          "fulfill next hold | empty queue",
+			repositoryErr: entity.ErrNoWaitingHolds,
+			wantErrCode:   codes.NotFound,
+		},
+		{
+			name:      "fulfill next hold | outbox error",
+			outboxErr: errors.New("outbox error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockHoldRepo := mocks.NewMockHoldRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, mockHoldRepo, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+
+			if test.repositoryErr != nil {
+				mockHoldRepo.EXPECT().FulfillNextHold(ctx, hold.BookId).Return(nil, test.repositoryErr)
+			} else {
+				mockHoldRepo.EXPECT().FulfillNextHold(ctx, hold.BookId).Return(hold, nil)
+				expectHoldOutbox(ctx, mockOutboxRepo, hold, test.outboxErr)
+			}
+
+			result, err := useCase.FulfillNextHold(ctx, hold.BookId)
+			switch {
+			case test.outboxErr != nil:
+				require.ErrorIs(t, err, test.outboxErr)
+			case test.repositoryErr != nil:
+				CheckError(t, err, test.wantErrCode)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, hold, result)
+				return
+			}
+
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestExpireHolds(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	first := newReadyHold(uuid.NewString())
+	first.Status = entity.HoldStatusExpired
+	second := newReadyHold(uuid.NewString())
+	second.Status = entity.HoldStatusExpired
+	next := newReadyHold(first.BookId)
+
+	tests := []struct {
+		name          string
+		repositoryErr error
+		wantExpired   int
+	}{
+		{
+			name:        "expire holds",
+			wantExpired: 2,
+		},
+		{
+			name:          "expire holds | repository error",
+			repositoryErr: errors.New("repository error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockHoldRepo := mocks.NewMockHoldRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, mockHoldRepo, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+
+			if test.repositoryErr != nil {
+				mockHoldRepo.EXPECT().ExpireHolds(ctx, gomock.Any()).Return(nil, test.repositoryErr)
+			} else {
+				mockHoldRepo.EXPECT().ExpireHolds(ctx, gomock.Any()).Return([]*entity.Hold{first, second}, nil)
+				expectHoldOutbox(ctx, mockOutboxRepo, first, nil)
+				mockHoldRepo.EXPECT().FulfillNextHold(ctx, first.BookId).Return(next, nil)
+				expectHoldOutbox(ctx, mockOutboxRepo, next, nil)
+				expectHoldOutbox(ctx, mockOutboxRepo, second, nil)
+				mockHoldRepo.EXPECT().FulfillNextHold(ctx, second.BookId).Return(nil, entity.ErrNoWaitingHolds)
+			}
+
+			expired, err := useCase.ExpireHolds(ctx)
+			if test.repositoryErr != nil {
+				require.ErrorIs(t, err, test.repositoryErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, test.wantExpired, expired)
+		})
+	}
+}
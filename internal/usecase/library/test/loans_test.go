@@ -0,0 +1,259 @@
+package library
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library"
+	"github.com/project/library/internal/usecase/repository"
+	"github.com/project/library/internal/usecase/repository/mocks"
+)
+
+func newLoan() *entity.Loan {
+	now := time.Now()
+	return &entity.Loan{
+		Id:           uuid.NewString(),
+		CopyId:       uuid.NewString(),
+		BookId:       uuid.NewString(),
+		PatronId:     uuid.NewString(),
+		CheckedOutAt: now,
+		DueAt:        now.AddDate(0, 0, 14),
+		UpdatedAt:    now,
+	}
+}
+
+func loanIdempotencyKey(loan *entity.Loan) string {
+	return repository.OutboxKindLoan.String() + "_" + loan.Id + "_" + strconv.FormatInt(loan.UpdatedAt.UnixNano(), 10)
+}
+
+func TestCheckoutCopy(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	loan := newLoan()
+	serialized, _ := json.Marshal(loan)
+
+	hold := newHold()
+	hold.BookId, hold.PatronId = loan.BookId, loan.PatronId
+	hold.Status = entity.HoldStatusFulfilled
+	serializedHold, _ := json.Marshal(hold)
+
+	tests := []struct {
+		name          string
+		hold          *entity.Hold
+		repositoryErr error
+		outboxErr     error
+		wantErrCode   codes.Code
+	}{
+		{
+			name: "checkout copy",
+		},
+		{
+			name: "checkout copy | fulfills patron hold",
+			hold: hold,
+		},
+		{
+			name:          "checkout copy | already on loan",
+			repositoryErr: entity.ErrCopyOnLoan,
+			wantErrCode:   codes.FailedPrecondition,
+		},
+		{
+			name:          "checkout copy | card expired",
+			repositoryErr: entity.ErrPatronCardExpired,
+			wantErrCode:   codes.FailedPrecondition,
+		},
+		{
+			name:      "checkout copy | outbox error",
+			outboxErr: errors.New("outbox error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockLoanRepo := mocks.NewMockLoanRepository(ctrl)
+			mockHoldRepo := mocks.NewMockHoldRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, nil, nil, mockLoanRepo, mockHoldRepo, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+
+			if test.repositoryErr != nil {
+				mockLoanRepo.EXPECT().CheckoutCopy(ctx, loan.CopyId, loan.PatronId).Return(nil, test.repositoryErr)
+			} else {
+				mockLoanRepo.EXPECT().CheckoutCopy(ctx, loan.CopyId, loan.PatronId).Return(loan, nil)
+
+				if test.hold != nil {
+					mockHoldRepo.EXPECT().FulfillPatronHold(ctx, loan.BookId, loan.PatronId).Return(test.hold, nil)
+					mockOutboxRepo.EXPECT().SendMessage(ctx, holdIdempotencyKey(test.hold),
+						repository.OutboxKindHold, serializedHold).Return(nil)
+				} else {
+					mockHoldRepo.EXPECT().FulfillPatronHold(ctx, loan.BookId, loan.PatronId).Return(nil, entity.ErrHoldNotFound)
+				}
+
+				mockOutboxRepo.EXPECT().SendMessage(ctx, loanIdempotencyKey(loan),
+					repository.OutboxKindLoan, serialized).Return(test.outboxErr)
+			}
+
+			result, err := useCase.CheckoutCopy(ctx, loan.CopyId, loan.PatronId)
+			switch {
+			case test.outboxErr != nil:
+				require.ErrorIs(t, err, test.outboxErr)
+			case test.repositoryErr != nil:
+				CheckError(t, err, test.wantErrCode)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, loan, result)
+				return
+			}
+
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestReturnCopy(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	loan := newLoan()
+	returnedAt := time.Now()
+	loan.ReturnedAt = &returnedAt
+	serialized, _ := json.Marshal(loan)
+
+	tests := []struct {
+		name          string
+		repositoryErr error
+		wantErrCode   codes.Code
+	}{
+		{
+			name: "return copy",
+		},
+		{
+			name:          "return copy | not on loan",
+			repositoryErr: entity.ErrCopyNotOnLoan,
+			wantErrCode:   codes.FailedPrecondition,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockLoanRepo := mocks.NewMockLoanRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, nil, nil, mockLoanRepo, nil, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+
+			if test.repositoryErr != nil {
+				mockLoanRepo.EXPECT().ReturnCopy(ctx, loan.CopyId).Return(nil, test.repositoryErr)
+			} else {
+				mockLoanRepo.EXPECT().ReturnCopy(ctx, loan.CopyId).Return(loan, nil)
+				mockOutboxRepo.EXPECT().SendMessage(ctx, loanIdempotencyKey(loan),
+					repository.OutboxKindLoan, serialized).Return(nil)
+			}
+
+			result, err := useCase.ReturnCopy(ctx, loan.CopyId)
+			CheckError(t, err, test.wantErrCode)
+			if test.repositoryErr != nil {
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.Equal(t, loan, result)
+		})
+	}
+}
+
+func TestRenewLoan(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	loan := newLoan()
+	loan.Renewals = 1
+	serialized, _ := json.Marshal(loan)
+
+	tests := []struct {
+		name          string
+		repositoryErr error
+		wantErrCode   codes.Code
+	}{
+		{
+			name: "renew loan",
+		},
+		{
+			name:          "renew loan | already returned",
+			repositoryErr: entity.ErrLoanReturned,
+			wantErrCode:   codes.FailedPrecondition,
+		},
+		{
+			name:          "renew loan | not found",
+			repositoryErr: entity.ErrLoanNotFound,
+			wantErrCode:   codes.NotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockLoanRepo := mocks.NewMockLoanRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, nil, nil, mockLoanRepo, nil, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+
+			if test.repositoryErr != nil {
+				mockLoanRepo.EXPECT().RenewLoan(ctx, loan.Id).Return(nil, test.repositoryErr)
+			} else {
+				mockLoanRepo.EXPECT().RenewLoan(ctx, loan.Id).Return(loan, nil)
+				mockOutboxRepo.EXPECT().SendMessage(ctx, loanIdempotencyKey(loan),
+					repository.OutboxKindLoan, serialized).Return(nil)
+			}
+
+			result, err := useCase.RenewLoan(ctx, loan.Id)
+			CheckError(t, err, test.wantErrCode)
+			if test.repositoryErr != nil {
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.Equal(t, loan, result)
+		})
+	}
+}
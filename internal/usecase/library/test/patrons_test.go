@@ -0,0 +1,269 @@
+package library
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library"
+	"github.com/project/library/internal/usecase/repository"
+	"github.com/project/library/internal/usecase/repository/mocks"
+)
+
+func TestRegisterPatron(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	registered := &entity.Patron{
+		Id:         uuid.NewString(),
+		CardNumber: "CARD-0001",
+		Name:       "Anna",
+		ExpiresOn:  "2027-01-01",
+		CreatedAt:  time.Now(),
+	}
+	serialized, _ := json.Marshal(registered)
+	idempotencyKey := repository.OutboxKindPatron.String() + "_" + registered.Id
+
+	tests := []struct {
+		name           string
+		patron         *entity.Patron
+		repositoryUsed bool
+		repositoryErr  error
+		outboxErr      error
+		wantErrCode    codes.Code
+	}{
+		{
+			name:           "register patron | name trimmed",
+			patron:         &entity.Patron{CardNumber: "CARD-0001", Name: "  Anna ", ExpiresOn: "2027-01-01"},
+			repositoryUsed: true,
+		},
+		{
+			name:        "register patron | nonexistent date",
+			patron:      &entity.Patron{CardNumber: "CARD-0001", Name: "Anna", ExpiresOn: "2027-02-30"},
+			wantErrCode: codes.InvalidArgument,
+		},
+		{
+			name:           "register patron | card number taken",
+			patron:         &entity.Patron{CardNumber: "CARD-0001", Name: "Anna", ExpiresOn: "2027-01-01"},
+			repositoryUsed: true,
+			repositoryErr:  &entity.AlreadyExistsError{Err: entity.ErrPatronAlreadyExists, Id: uuid.NewString()},
+			wantErrCode:    codes.AlreadyExists,
+		},
+		{
+			name:           "register patron | outbox error",
+			patron:         &entity.Patron{CardNumber: "CARD-0001", Name: "Anna", ExpiresOn: "2027-01-01"},
+			repositoryUsed: true,
+			outboxErr:      errors.New("outbox error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockPatronRepo := mocks.NewMockPatronRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, nil, mockPatronRepo, nil, nil, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			if test.repositoryUsed {
+				mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					})
+
+				mockPatronRepo.EXPECT().RegisterPatron(ctx, &entity.Patron{
+					CardNumber: "CARD-0001",
+					Name:       "Anna",
+					ExpiresOn:  "2027-01-01",
+				}).DoAndReturn(func(_ context.Context, _ *entity.Patron) (*entity.Patron, error) {
+					if test.repositoryErr != nil {
+						return nil, test.repositoryErr
+					}
+					return registered, nil
+				})
+
+				if test.repositoryErr == nil {
+					mockOutboxRepo.EXPECT().SendMessage(ctx, idempotencyKey,
+						repository.OutboxKindPatron, serialized).Return(test.outboxErr)
+				}
+			}
+
+			result, err := useCase.RegisterPatron(ctx, test.patron)
+			switch {
+			case test.outboxErr != nil:
+				require.ErrorIs(t, err, test.outboxErr)
+			case test.wantErrCode != codes.OK:
+				CheckError(t, err, test.wantErrCode)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, registered, result)
+				return
+			}
+
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestUpdatePatron(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	patronId := uuid.NewString()
+	suspended := entity.PatronStatusSuspended
+	name := " Anna Karenina "
+	trimmedName := "Anna Karenina"
+	badDate := "2027-13-01"
+
+	updated := &entity.Patron{
+		Id:         patronId,
+		CardNumber: "CARD-0001",
+		Name:       trimmedName,
+		Status:     entity.PatronStatusSuspended,
+		ExpiresOn:  "2027-01-01",
+		UpdatedAt:  time.Now(),
+	}
+	serialized, _ := json.Marshal(updated)
+	idempotencyKey := repository.OutboxKindPatron.String() + "_" + patronId +
+		"_" + strconv.FormatInt(updated.UpdatedAt.UnixNano(), 10)
+
+	tests := []struct {
+		name           string
+		update         entity.PatronUpdate
+		wantUpdate     entity.PatronUpdate
+		repositoryUsed bool
+		repositoryErr  error
+		wantErrCode    codes.Code
+	}{
+		{
+			name:           "update patron",
+			update:         entity.PatronUpdate{Id: patronId, Name: &name, Status: &suspended},
+			wantUpdate:     entity.PatronUpdate{Id: patronId, Name: &trimmedName, Status: &suspended},
+			repositoryUsed: true,
+		},
+		{
+			name:        "update patron | invalid expiry",
+			update:      entity.PatronUpdate{Id: patronId, ExpiresOn: &badDate},
+			wantErrCode: codes.InvalidArgument,
+		},
+		{
+			name:           "update patron | not found",
+			update:         entity.PatronUpdate{Id: patronId, Status: &suspended},
+			wantUpdate:     entity.PatronUpdate{Id: patronId, Status: &suspended},
+			repositoryUsed: true,
+			repositoryErr:  entity.ErrPatronNotFound,
+			wantErrCode:    codes.NotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockPatronRepo := mocks.NewMockPatronRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, nil, mockPatronRepo, nil, nil, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			if test.repositoryUsed {
+				mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					})
+
+				if test.repositoryErr != nil {
+					mockPatronRepo.EXPECT().UpdatePatron(ctx, test.wantUpdate).Return(nil, test.repositoryErr)
+				} else {
+					mockPatronRepo.EXPECT().UpdatePatron(ctx, test.wantUpdate).Return(updated, nil)
+					mockOutboxRepo.EXPECT().SendMessage(ctx, idempotencyKey,
+						repository.OutboxKindPatron, serialized).Return(nil)
+				}
+			}
+
+			err := useCase.UpdatePatron(ctx, test.update)
+			CheckError(t, err, test.wantErrCode)
+		})
+	}
+}
+
+func TestDeletePatron(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	deleted := &entity.Patron{
+		Id:         uuid.NewString(),
+		CardNumber: "CARD-0001",
+		Name:       "Anna",
+		ExpiresOn:  "2027-01-01",
+	}
+	serialized, _ := json.Marshal(deleted)
+	idempotencyKey := repository.OutboxKindPatronDeleted.String() + "_" + deleted.Id
+
+	tests := []struct {
+		name          string
+		repositoryErr error
+		wantErrCode   codes.Code
+	}{
+		{
+			name: "delete patron",
+		},
+		{
+			name:          "delete patron | not found",
+			repositoryErr: entity.ErrPatronNotFound,
+			wantErrCode:   codes.NotFound,
+		},
+		{
+			name:          "delete patron | has loans",
+			repositoryErr: entity.ErrPatronHasLoans,
+			wantErrCode:   codes.FailedPrecondition,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockPatronRepo := mocks.NewMockPatronRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, nil, mockPatronRepo, nil, nil, nil, nil, nil, nil, mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				})
+
+			if test.repositoryErr != nil {
+				mockPatronRepo.EXPECT().DeletePatron(ctx, deleted.Id).Return(nil, test.repositoryErr)
+			} else {
+				mockPatronRepo.EXPECT().DeletePatron(ctx, deleted.Id).Return(deleted, nil)
+				mockOutboxRepo.EXPECT().SendMessage(ctx, idempotencyKey,
+					repository.OutboxKindPatronDeleted, serialized).Return(nil)
+			}
+
+			err := useCase.DeletePatron(ctx, deleted.Id)
+			CheckError(t, err, test.wantErrCode)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package library
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library"
+	"github.com/project/library/internal/usecase/repository"
+	"github.com/project/library/internal/usecase/repository/mocks"
+)
+
+func TestCreatePublisher(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	publisher := &entity.Publisher{
+		Id:   uuid.NewString(),
+		Name: "Penguin Books",
+	}
+
+	tests := []struct {
+		name          string
+		repositoryErr error
+	}{
+		{
+			name:          "create publisher",
+			repositoryErr: nil,
+		},
+		{
+			name:          "create publisher | repository error",
+			repositoryErr: errors.New("error create publisher"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockPublisherRepo := mocks.NewMockPublisherRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, mockPublisherRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			ctx := t.Context()
+
+			var created *entity.Publisher
+			if test.repositoryErr == nil {
+				created = publisher
+			}
+
+			mockPublisherRepo.EXPECT().CreatePublisher(ctx, &entity.Publisher{Name: publisher.Name}).
+				Return(created, test.repositoryErr)
+
+			result, err := useCase.CreatePublisher(ctx, publisher.Name)
+
+			if test.repositoryErr != nil {
+				require.ErrorIs(t, err, test.repositoryErr)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, publisher, result)
+		})
+	}
+}
+
+func TestGetPublisherBooks(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	publisherId := uuid.NewString()
+	books := []*entity.Book{
+		{Id: uuid.NewString(), Name: "book1", PublisherId: &publisherId},
+		{Id: uuid.NewString(), Name: "book2", PublisherId: &publisherId},
+	}
+
+	mockBooksRepo := mocks.NewMockBooksRepository(ctrl)
+	logger, _ := zap.NewProduction()
+	useCase := library.New(logger, nil, mockBooksRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	ctx := t.Context()
+
+	mockBooksRepo.EXPECT().GetPublisherBooks(ctx, publisherId).Return(books, nil)
+
+	result, err := useCase.GetPublisherBooks(ctx, publisherId)
+	require.NoError(t, err)
+	assert.Equal(t, books, result)
+
+	mockBooksRepo.EXPECT().GetPublisherBooks(ctx, publisherId).Return(nil, entity.ErrPublisherNotFound)
+
+	_, err = useCase.GetPublisherBooks(ctx, publisherId)
+	CheckError(t, err, codes.NotFound)
+}
+
+func TestDeletePublisher(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	publisherId := uuid.NewString()
+	book := &entity.Book{
+		Id:        uuid.NewString(),
+		Name:      "Detached Book",
+		AuthorIds: []string{uuid.NewString()},
+		UpdatedAt: time.Now(),
+		Revision:  3,
+	}
+	serialized, _ := json.Marshal(book)
+	idempotencyKey := repository.OutboxKindBook.String() + "_" + book.Id +
+		"_" + strconv.FormatInt(book.UpdatedAt.UnixNano(), 10)
+
+	tests := []struct {
+		name          string
+		books         []*entity.Book
+		repositoryErr error
+		outboxErr     error
+	}{
+		{
+			name:  "delete publisher | books detached",
+			books: []*entity.Book{book},
+		},
+		{
+			name:  "delete publisher | without books",
+			books: []*entity.Book{},
+		},
+		{
+			name:          "delete publisher | not found",
+			repositoryErr: entity.ErrPublisherNotFound,
+		},
+		{
+			name:      "delete publisher | outbox error",
+			books:     []*entity.Book{book},
+			outboxErr: errors.New("cannot send message"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockPublisherRepo := mocks.NewMockPublisherRepository(ctrl)
+			mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+			mockTransactor := mocks.NewMockTransactor(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, mockPublisherRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+				mockOutboxRepo, mockTransactor)
+			ctx := t.Context()
+
+			mockTransactor.EXPECT().WithTx(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				},
+			)
+			mockPublisherRepo.EXPECT().DeletePublisher(ctx, publisherId).
+				Return(test.books, test.repositoryErr)
+
+			for range test.books {
+				mockOutboxRepo.EXPECT().SendMessage(ctx, idempotencyKey,
+					repository.OutboxKindBook, serialized).Return(test.outboxErr)
+			}
+
+			err := useCase.DeletePublisher(ctx, publisherId)
+			switch {
+			case test.repositoryErr != nil:
+				CheckError(t, err, codes.NotFound)
+			case test.outboxErr != nil:
+				require.ErrorIs(t, err, test.outboxErr)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package library
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library"
+	"github.com/project/library/internal/usecase/repository/mocks"
+)
+
+func TestCreateReview(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	newReview := &entity.Review{
+		BookId:   uuid.NewString(),
+		PatronId: uuid.NewString(),
+		Rating:   4,
+		Text:     "text",
+	}
+
+	tests := []struct {
+		name          string
+		repositoryErr error
+		wantErrCode   codes.Code
+	}{
+		{
+			name: "create review",
+		},
+		{
+			name:          "create review | already reviewed",
+			repositoryErr: &entity.AlreadyExistsError{Err: entity.ErrReviewAlreadyExists, Id: uuid.NewString()},
+			wantErrCode:   codes.AlreadyExists,
+		},
+		{
+			name:          "create review | book not found",
+			repositoryErr: entity.ErrBookNotFound,
+			wantErrCode:   codes.NotFound,
+		},
+		{
+			name:          "create review | patron not found",
+			repositoryErr: entity.ErrPatronNotFound,
+			wantErrCode:   codes.NotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockReviewRepo := mocks.NewMockReviewRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockReviewRepo, nil, nil, nil, nil)
+			ctx := t.Context()
+
+			var created *entity.Review
+			if test.repositoryErr == nil {
+				created = &entity.Review{
+					Id:        uuid.NewString(),
+					BookId:    newReview.BookId,
+					PatronId:  newReview.PatronId,
+					Rating:    newReview.Rating,
+					Text:      newReview.Text,
+					CreatedAt: time.Now(),
+				}
+			}
+
+			mockReviewRepo.EXPECT().CreateReview(ctx, newReview).Return(created, test.repositoryErr)
+
+			result, err := useCase.CreateReview(ctx, newReview)
+			if test.repositoryErr != nil {
+				require.ErrorIs(t, err, test.repositoryErr)
+				CheckError(t, err, test.wantErrCode)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, created, result)
+		})
+	}
+}
+
+func TestUpdateReview(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	rating := int32(2)
+	update := entity.ReviewUpdate{Id: uuid.NewString(), Rating: &rating}
+
+	tests := []struct {
+		name          string
+		repositoryErr error
+	}{
+		{
+			name: "update review",
+		},
+		{
+			name:          "update review | not found",
+			repositoryErr: entity.ErrReviewNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockReviewRepo := mocks.NewMockReviewRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockReviewRepo, nil, nil, nil, nil)
+			ctx := t.Context()
+
+			var updated *entity.Review
+			if test.repositoryErr == nil {
+				updated = &entity.Review{Id: update.Id, Rating: rating}
+			}
+
+			mockReviewRepo.EXPECT().UpdateReview(ctx, update).Return(updated, test.repositoryErr)
+
+			result, err := useCase.UpdateReview(ctx, update)
+			if test.repositoryErr != nil {
+				require.ErrorIs(t, err, test.repositoryErr)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, updated, result)
+		})
+	}
+}
+
+func TestDeleteReview(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	tests := []struct {
+		name          string
+		repositoryErr error
+	}{
+		{
+			name: "delete review",
+		},
+		{
+			name:          "delete review | not found",
+			repositoryErr: entity.ErrReviewNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockReviewRepo := mocks.NewMockReviewRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockReviewRepo, nil, nil, nil, nil)
+			ctx := t.Context()
+
+			reviewId := uuid.NewString()
+			mockReviewRepo.EXPECT().DeleteReview(ctx, reviewId).Return(test.repositoryErr)
+
+			err := useCase.DeleteReview(ctx, reviewId)
+			if test.repositoryErr != nil {
+				require.ErrorIs(t, err, test.repositoryErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestListBookReviews(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockReviewRepo := mocks.NewMockReviewRepository(ctrl)
+	logger, _ := zap.NewProduction()
+	useCase := library.New(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockReviewRepo, nil, nil, nil, nil)
+	ctx := t.Context()
+
+	filter := entity.ReviewFilter{BookId: uuid.NewString(), PageSize: 10}
+	page := &entity.ReviewPage{
+		Reviews:       []*entity.Review{{Id: uuid.NewString(), BookId: filter.BookId, Rating: 5}},
+		NextPageToken: "token",
+	}
+
+	mockReviewRepo.EXPECT().ListBookReviews(ctx, filter).Return(page, nil)
+
+	result, err := useCase.ListBookReviews(ctx, filter)
+	require.NoError(t, err)
+	assert.Equal(t, page, result)
+}
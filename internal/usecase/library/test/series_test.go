@@ -0,0 +1,98 @@
+package library
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/library"
+	"github.com/project/library/internal/usecase/repository/mocks"
+)
+
+func TestCreateSeries(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	series := &entity.Series{
+		Id:   uuid.NewString(),
+		Name: "The Lord of the Rings",
+	}
+
+	mockSeriesRepo := mocks.NewMockSeriesRepository(ctrl)
+	logger, _ := zap.NewProduction()
+	useCase := library.New(logger, nil, nil, nil, nil, mockSeriesRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	ctx := t.Context()
+
+	mockSeriesRepo.EXPECT().CreateSeries(ctx, &entity.Series{Name: series.Name}).Return(series, nil)
+
+	result, err := useCase.CreateSeries(ctx, series.Name)
+	require.NoError(t, err)
+	assert.Equal(t, series, result)
+
+	repositoryErr := errors.New("error create series")
+	mockSeriesRepo.EXPECT().CreateSeries(ctx, &entity.Series{Name: series.Name}).Return(nil, repositoryErr)
+
+	result, err = useCase.CreateSeries(ctx, series.Name)
+	require.ErrorIs(t, err, repositoryErr)
+	assert.Nil(t, result)
+}
+
+func TestAddBookToSeries(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	tests := []struct {
+		name          string
+		repositoryErr error
+		wantCode      codes.Code
+	}{
+		{
+			name:          "add book to series",
+			repositoryErr: nil,
+			wantCode:      codes.OK,
+		},
+		{
+			name:          "add book to series | series not found",
+			repositoryErr: entity.ErrSeriesNotFound,
+			wantCode:      codes.NotFound,
+		},
+		{
+			name:          "add book to series | volume taken",
+			repositoryErr: entity.ErrSeriesVolumeTaken,
+			wantCode:      codes.AlreadyExists,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			seriesId, bookId := uuid.NewString(), uuid.NewString()
+
+			mockSeriesRepo := mocks.NewMockSeriesRepository(ctrl)
+			logger, _ := zap.NewProduction()
+			useCase := library.New(logger, nil, nil, nil, nil, mockSeriesRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			ctx := t.Context()
+
+			mockSeriesRepo.EXPECT().AddBookToSeries(ctx, seriesId, bookId, int32(3)).Return(test.repositoryErr)
+
+			err := useCase.AddBookToSeries(ctx, seriesId, bookId, 3)
+
+			if test.repositoryErr == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			CheckError(t, err, test.wantCode)
+		})
+	}
+}
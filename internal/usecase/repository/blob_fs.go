@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+
+	"github.com/project/library/internal/entity"
+)
+
+var _ BlobStorage = (*fileBlobStorage)(nil)
+
+// fileBlobStorage хранит содержимое в файлах dir/<первые 2 символа sha256>/<sha256>
+type fileBlobStorage struct {
+	dir    string
+	logger *zap.Logger
+}
+
+func NewFileBlobStorage(dir string, logger *zap.Logger) (*fileBlobStorage, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &fileBlobStorage{
+		dir:    dir,
+		logger: logger,
+	}, nil
+}
+
+func (s *fileBlobStorage) PutBlob(ctx context.Context, content io.Reader) (*entity.Blob, error) {
+	entity.SendLoggerInfo(s.logger, ctx, "Start to put blob to file.", layerBlob)
+
+	// Содержимое пишется во временный файл, имя известно только после подсчета хеша
+	file, err := os.CreateTemp(s.dir, "upload-*")
+	if err != nil {
+		return nil, err
+	}
+
+	defer os.Remove(file.Name()) // После переименования файла уже нет, ошибка не важна
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(file, hash), content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	blob := &entity.Blob{SHA256: hex.EncodeToString(hash.Sum(nil)), Size: size}
+	path := s.path(blob.SHA256)
+
+	if _, err = os.Stat(path); err == nil {
+		// Такое содержимое уже сохранено, копия не нужна
+		return blob, nil
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+
+	// Переименование атомарно, поэтому читатель не увидит файл записанным наполовину
+	if err = os.Rename(file.Name(), path); err != nil {
+		return nil, err
+	}
+
+	return blob, nil
+}
+
+func (s *fileBlobStorage) GetBlob(ctx context.Context, sha256 string) (io.ReadCloser, error) {
+	entity.SendLoggerInfo(s.logger, ctx, "Start to get blob from file.", layerBlob)
+
+	// Ключ становится частью пути, поэтому принимается только hex SHA-256
+	if decoded, err := hex.DecodeString(sha256); err != nil || len(decoded) != 32 {
+		return nil, entity.ErrBlobNotFound
+	}
+
+	file, err := os.Open(s.path(sha256))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, entity.ErrBlobNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (s *fileBlobStorage) path(sha256 string) string {
+	return filepath.Join(s.dir, sha256[:2], sha256)
+}
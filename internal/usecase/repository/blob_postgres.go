@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"github.com/project/library/internal/entity"
+)
+
+var _ BlobStorage = (*postgresBlobStorage)(nil)
+
+const layerBlob = "blob_storage"
+
+// postgresBlobStorage хранит содержимое в large object Postgres, таблица blob связывает sha256 с oid
+type postgresBlobStorage struct {
+	db     PgxInterface
+	logger *zap.Logger
+}
+
+func NewPostgresBlobStorage(db PgxInterface, logger *zap.Logger) *postgresBlobStorage {
+	return &postgresBlobStorage{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (s *postgresBlobStorage) PutBlob(ctx context.Context, content io.Reader) (blob *entity.Blob, txErr error) {
+	entity.SendLoggerInfo(s.logger, ctx, "Start to put blob to postgres.", layerBlob)
+
+	// Содержимое читается до начала транзакции: медленный клиент не должен держать соединение из пула
+	data, err := io.ReadAll(io.LimitReader(content, entity.MaxCoverSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > entity.MaxCoverSize {
+		return nil, entity.ErrCoverTooLarge
+	}
+
+	hash := sha256.Sum256(data)
+	blob = &entity.Blob{SHA256: hex.EncodeToString(hash[:]), Size: int64(len(data))}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Незафиксированный large object удаляется вместе с транзакцией
+	defer func() {
+		if txErr != nil {
+			_ = tx.Rollback(ctx)
+			return
+		}
+
+		txErr = tx.Commit(ctx)
+	}()
+
+	largeObjects := tx.LargeObjects()
+	oid, err := largeObjects.Create(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := largeObjects.Open(ctx, oid, pgx.LargeObjectModeWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = object.Write(data); err != nil {
+		return nil, err
+	}
+
+	if err = object.Close(); err != nil {
+		return nil, err
+	}
+
+	var storedOid uint32
+	err = tx.QueryRow(ctx, insertBlobQuery, blob.SHA256, oid, blob.Size).Scan(&storedOid)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Такое содержимое уже сохранено, копия не нужна
+		return blob, largeObjects.Unlink(ctx, oid)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return blob, nil
+}
+
+func (s *postgresBlobStorage) GetBlob(ctx context.Context, sha256 string) (io.ReadCloser, error) {
+	entity.SendLoggerInfo(s.logger, ctx, "Start to get blob from postgres.", layerBlob)
+
+	// Large object читается только внутри транзакции, она закрывается вместе с reader
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var oid uint32
+	if err = tx.QueryRow(ctx, getBlobOidQuery, sha256).Scan(&oid); err != nil {
+		_ = tx.Rollback(ctx)
+		return nil, mapPostgresError(err, entity.ErrBlobNotFound)
+	}
+
+	largeObjects := tx.LargeObjects()
+	object, err := largeObjects.Open(ctx, oid, pgx.LargeObjectModeRead)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return nil, err
+	}
+
+	return &largeObjectReader{ctx: ctx, tx: tx, object: object}, nil
+}
+
+type largeObjectReader struct {
+	ctx    context.Context
+	tx     pgx.Tx
+	object *pgx.LargeObject
+}
+
+func (r *largeObjectReader) Read(p []byte) (int, error) {
+	return r.object.Read(p)
+}
+
+func (r *largeObjectReader) Close() error {
+	closeErr := r.object.Close()
+	// Транзакция только читала, фиксировать нечего
+	return errors.Join(closeErr, r.tx.Rollback(r.ctx))
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"strconv"
+	"strings"
+)
+
+// conditionBuilder собирает WHERE из необязательных условий, нумеруя параметры запроса
+type conditionBuilder struct {
+	conditions []string
+	args       []any
+}
+
+// arg добавляет параметр и возвращает его плейсхолдер
+func (b *conditionBuilder) arg(value any) string {
+	b.args = append(b.args, value)
+	return "$" + strconv.Itoa(len(b.args))
+}
+
+func (b *conditionBuilder) add(condition string) {
+	b.conditions = append(b.conditions, condition)
+}
+
+func (b *conditionBuilder) where() string {
+	if len(b.conditions) == 0 {
+		return "TRUE"
+	}
+
+	return strings.Join(b.conditions, "\n\t\tAND ")
+}
+
+// keysetOrder описывает сортировку, по которой возможна keyset-пагинация.
+// Id записи добавляется вторым ключом, чтобы порядок был строгим.
+type keysetOrder struct {
+	column string // Колонка сортировки
+	cast   string // Тип, к которому приводится значение из курсора
+	desc   bool
+}
+
+func (k keysetOrder) direction() string {
+	if k.desc {
+		return "DESC"
+	}
+
+	return "ASC"
+}
+
+// after возвращает условие "запись идет после курсора"
+func (k keysetOrder) after(b *conditionBuilder, idColumn string, value string, id string) string {
+	operator := ">"
+	if k.desc {
+		operator = "<"
+	}
+
+	return "(" + k.column + ", " + idColumn + ") " + operator +
+		" (" + b.arg(value) + "::" + k.cast + ", " + b.arg(id) + "::uuid)"
+}
+
+func (k keysetOrder) orderBy(idColumn string) string {
+	return k.column + " " + k.direction() + ", " + idColumn + " " + k.direction()
+}
+
+// likePrefix экранирует спецсимволы LIKE, чтобы искать строго по префиксу
+func likePrefix(prefix string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(prefix) + "%"
+}
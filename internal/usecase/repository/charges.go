@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/project/library/internal/entity"
+)
+
+func (p *postgresRepository) RecordLateReturn(
+	ctx context.Context,
+	lateReturn entity.LateReturn,
+) (resCharge *entity.Charge, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to record late return.", layerPost,
+		"patron_id", lateReturn.PatronId)
+
+	daysLate, amount := p.finePolicy().Fine(lateReturn.DueAt, lateReturn.ReturnedAt)
+	if amount == 0 {
+		return nil, entity.ErrReturnWithinGrace
+	}
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	var charge *entity.Charge
+	err = measureQueryLatency("record_late_return", func() error {
+		var patronId string
+		if err := tx.QueryRow(ctx, lockChargedPatronQuery, lateReturn.PatronId).Scan(&patronId); err != nil {
+			return mapPostgresError(err, entity.ErrPatronNotFound)
+		}
+
+		var err error
+		charge, err = scanCharge(tx.QueryRow(ctx, insertChargeQuery, lateReturn.PatronId, lateReturn.BookId,
+			lateReturn.DueAt, lateReturn.ReturnedAt, daysLate, amount.String()))
+		return mapPostgresError(err, entity.ErrBookNotFound)
+	})
+
+	if err != nil {
+		return nil, p.withExistingId(ctx, err, getChargeIdQuery,
+			lateReturn.PatronId, lateReturn.BookId, lateReturn.DueAt)
+	}
+
+	return charge, nil
+}
+
+func (p *postgresRepository) PayCharge(ctx context.Context, chargeId string) (*entity.Charge, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to pay charge.", layerPost, "charge_id", chargeId)
+
+	return p.settleCharge(ctx, "pay_charge", chargeId, entity.ChargeStatusPaid, nil)
+}
+
+func (p *postgresRepository) WaiveCharge(ctx context.Context, chargeId string, reason string) (*entity.Charge, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to waive charge.", layerPost, "charge_id", chargeId)
+
+	return p.settleCharge(ctx, "waive_charge", chargeId, entity.ChargeStatusWaived, &reason)
+}
+
+func (p *postgresRepository) ListCharges(ctx context.Context, filter entity.ChargeFilter) ([]*entity.Charge, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to list charges.", layerPost, "patron_id", filter.PatronId)
+
+	var charges []*entity.Charge
+	err := measureQueryLatency("list_charges", func() error {
+		rows, err := p.db.Query(ctx, listChargesQuery, filter.PatronId, filter.BookId, filter.IncludeSettled)
+		if err != nil {
+			return err
+		}
+
+		charges, err = collectCharges(rows)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return charges, nil
+}
+
+func (p *postgresRepository) ClaimChargeReminders(
+	ctx context.Context,
+	limit int,
+) (resCharges []*entity.Charge, txErr error) {
+	entity.SendLoggerInfo(p.logger, ctx, "Start to claim charge reminders.", layerPost)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	var charges []*entity.Charge
+	err = measureQueryLatency("claim_charge_reminders", func() error {
+		rows, err := tx.Query(ctx, claimChargeRemindersQuery, limit, p.cfg.Fine.ReminderRepeatDays)
+		if err != nil {
+			return err
+		}
+
+		charges, err = collectCharges(rows)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return charges, nil
+}
+
+// settleCharge закрывает неоплаченный штраф оплатой или списанием
+func (p *postgresRepository) settleCharge(
+	ctx context.Context,
+	operation string,
+	chargeId string,
+	chargeStatus entity.ChargeStatus,
+	waiverReason *string,
+) (resCharge *entity.Charge, txErr error) {
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	var charge *entity.Charge
+	err = measureQueryLatency(operation, func() error {
+		var status string
+		if err := tx.QueryRow(ctx, lockChargeQuery, chargeId).Scan(&status); err != nil {
+			return mapPostgresError(err, entity.ErrChargeNotFound)
+		}
+
+		if entity.ParseChargeStatus(status) != entity.ChargeStatusOutstanding {
+			return entity.ErrChargeSettled
+		}
+
+		var err error
+		charge, err = scanCharge(tx.QueryRow(ctx, settleChargeQuery, chargeId, chargeStatus.String(), waiverReason))
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return charge, nil
+}
+
+// finePolicy возвращает правила начисления штрафов из конфигурации
+func (p *postgresRepository) finePolicy() entity.FinePolicy {
+	return entity.FinePolicy{
+		DailyRate: entity.Money(p.cfg.Fine.DailyRate),
+		GraceDays: p.cfg.Fine.GraceDays,
+		MaxAmount: entity.Money(p.cfg.Fine.MaxAmount),
+	}
+}
+
+// scanCharge читает штраф в порядке колонок запросов, возвращающих штраф
+func scanCharge(row pgx.Row) (*entity.Charge, error) {
+	var charge entity.Charge
+	var amount, status string
+
+	if err := row.Scan(
+		&charge.Id,
+		&charge.PatronId,
+		&charge.BookId,
+		&charge.DueAt,
+		&charge.ReturnedAt,
+		&charge.DaysLate,
+		&amount,
+		&status,
+		&charge.WaiverReason,
+		&charge.SettledAt,
+		&charge.RemindedAt,
+		&charge.CreatedAt,
+		&charge.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if charge.Amount, err = entity.ParseMoney(amount); err != nil {
+		return nil, err
+	}
+
+	charge.Status = entity.ParseChargeStatus(status)
+
+	return &charge, nil
+}
+
+func collectCharges(rows pgx.Rows) ([]*entity.Charge, error) {
+	defer rows.Close()
+
+	charges := make([]*entity.Charge, 0)
+	for rows.Next() {
+		charge, err := scanCharge(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		charges = append(charges, charge)
+	}
+
+	return charges, rows.Err()
+}
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/project/library/internal/entity"
+)
+
+func (p *postgresRepository) AddCopy(ctx context.Context, bookCopy *entity.Copy) (resCopy *entity.Copy, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to add copy.", layerPost, "book_id", bookCopy.BookId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	err = measureQueryLatency("add_copy", func() error {
+		return tx.QueryRow(ctx, insertCopyQuery, bookCopy.Barcode, bookCopy.BookId, bookCopy.Branch,
+			bookCopy.Location, bookCopy.Condition.String()).
+			Scan(&bookCopy.Id, &bookCopy.CreatedAt, &bookCopy.UpdatedAt)
+	})
+
+	if err != nil {
+		return nil, p.withExistingId(ctx, mapPostgresError(err, entity.ErrBookNotFound),
+			getCopyIdByBarcodeQuery, bookCopy.Barcode)
+	}
+
+	bookCopy.Status = entity.CopyStatusAvailable
+
+	return bookCopy, nil
+}
+
+func (p *postgresRepository) UpdateCopy(ctx context.Context, update entity.CopyUpdate) (txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to update copy.", layerPost, "copy_id", update.Id)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer rollback(txErr)
+
+	var condition, status *string
+	if update.Condition != nil {
+		value := update.Condition.String()
+		condition = &value
+	}
+
+	if update.Status != nil {
+		value := update.Status.String()
+		status = &value
+	}
+
+	return measureQueryLatency("update_copy", func() error {
+		if err := lockActiveCopy(ctx, tx, update.Id); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(ctx, updateCopyQuery, update.Id, update.Branch, update.Location, condition, status)
+		return err
+	})
+}
+
+func (p *postgresRepository) RetireCopy(ctx context.Context, copyId string) (resCopy *entity.Copy, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to retire copy.", layerPost, "copy_id", copyId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	var bookCopy entity.Copy
+	err = measureQueryLatency("retire_copy", func() error {
+		if err := lockActiveCopy(ctx, tx, copyId); err != nil {
+			return err
+		}
+
+		var onLoan bool
+		if err := tx.QueryRow(ctx, activeLoanExistsQuery, copyId).Scan(&onLoan); err != nil {
+			return err
+		}
+
+		if onLoan {
+			return entity.ErrCopyOnLoan
+		}
+
+		var columns copyColumns
+		if err := tx.QueryRow(ctx, retireCopyQuery, copyId).Scan(copyFields(&bookCopy, &columns)...); err != nil {
+			return err
+		}
+
+		columns.fill(&bookCopy)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookCopy, nil
+}
+
+func (p *postgresRepository) ListBookCopies(ctx context.Context, filter entity.CopyFilter) ([]*entity.Copy, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to list book copies.", layerPost, "book_id", filter.BookId)
+
+	var copies []*entity.Copy
+	err := measureQueryLatency("list_book_copies", func() error {
+		rows, err := p.db.Query(ctx, listBookCopiesQuery, filter.BookId, filter.Branch, filter.IncludeRetired)
+		if err != nil {
+			return err
+		}
+
+		copies, err = collectCopies(rows)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return copies, nil
+}
+
+func (p *postgresRepository) GetCopyAvailability(ctx context.Context, bookId string) (*entity.CopyAvailability, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to get copy availability.", layerPost, "book_id", bookId)
+
+	var availability entity.CopyAvailability
+	err := measureQueryLatency("get_copy_availability", func() error {
+		return p.db.QueryRow(ctx, getCopyAvailabilityQuery, bookId).Scan(&availability.Total, &availability.Available)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &availability, nil
+}
+
+// lockActiveCopy блокирует экземпляр до конца транзакции, списанный экземпляр не меняется
+func lockActiveCopy(ctx context.Context, tx pgx.Tx, copyId string) error {
+	var status string
+	if err := tx.QueryRow(ctx, lockCopyQuery, copyId).Scan(&status); err != nil {
+		return mapPostgresError(err, entity.ErrCopyNotFound)
+	}
+
+	if entity.ParseCopyStatus(status) == entity.CopyStatusRetired {
+		return entity.ErrCopyRetired
+	}
+
+	return nil
+}
+
+// copyColumns - текстовые колонки book_copy, которые разбираются после Scan
+type copyColumns struct {
+	condition string
+	status    string
+}
+
+// fill заполняет состояние и статус экземпляра после Scan
+func (c *copyColumns) fill(bookCopy *entity.Copy) {
+	bookCopy.Condition = entity.ParseCopyCondition(c.condition)
+	bookCopy.Status = entity.ParseCopyStatus(c.status)
+}
+
+// copyFields возвращает поля для Scan в порядке колонок запросов, читающих экземпляр
+func copyFields(bookCopy *entity.Copy, columns *copyColumns) []any {
+	return []any{
+		&bookCopy.Id,
+		&bookCopy.BookId,
+		&bookCopy.Barcode,
+		&bookCopy.Branch,
+		&bookCopy.Location,
+		&columns.condition,
+		&columns.status,
+		&bookCopy.CreatedAt,
+		&bookCopy.UpdatedAt,
+		&bookCopy.RetiredAt,
+	}
+}
+
+func collectCopies(rows pgx.Rows) ([]*entity.Copy, error) {
+	defer rows.Close()
+
+	copies := make([]*entity.Copy, 0)
+	for rows.Next() {
+		var bookCopy entity.Copy
+		var columns copyColumns
+
+		if err := rows.Scan(copyFields(&bookCopy, &columns)...); err != nil {
+			return nil, err
+		}
+
+		columns.fill(&bookCopy)
+		copies = append(copies, &bookCopy)
+	}
+
+	return copies, rows.Err()
+}
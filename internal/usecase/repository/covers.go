@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/project/library/internal/entity"
+)
+
+func (p *postgresRepository) SetBookCover(ctx context.Context, cover *entity.BookCover) (*entity.BookCover, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to set book cover.", layerPost, "book_id", cover.BookId)
+
+	err := measureQueryLatency("set_book_cover", func() error {
+		return p.db.QueryRow(ctx, upsertBookCoverQuery, cover.BookId, cover.ContentType, cover.Size, cover.SHA256).
+			Scan(&cover.CreatedAt, &cover.UpdatedAt)
+	})
+
+	if err != nil {
+		return nil, mapPostgresError(err, entity.ErrBookNotFound)
+	}
+
+	return cover, nil
+}
+
+func (p *postgresRepository) GetBookCover(ctx context.Context, bookId string) (*entity.BookCover, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to get book cover.", layerPost, "book_id", bookId)
+
+	var cover entity.BookCover
+	err := measureQueryLatency("get_book_cover", func() error {
+		return p.db.QueryRow(ctx, getBookCoverQuery, bookId).Scan(&cover.BookId, &cover.ContentType,
+			&cover.Size, &cover.SHA256, &cover.CreatedAt, &cover.UpdatedAt)
+	})
+
+	if err != nil {
+		return nil, mapPostgresError(err, entity.ErrCoverNotFound)
+	}
+
+	return &cover, nil
+}
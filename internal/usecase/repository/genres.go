@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/project/library/internal/entity"
+)
+
+func (p *postgresRepository) CreateGenre(ctx context.Context, genre *entity.Genre) (resGenre *entity.Genre, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to create genre.", layerPost, "genre_name", genre.Name)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	err = measureQueryLatency("create_genre", func() error {
+		return tx.QueryRow(ctx, insertGenreQuery, genre.Name, genre.ParentId).Scan(&genre.Id, &genre.CreatedAt)
+	})
+
+	if err != nil {
+		// Нарушение внешнего ключа означает, что родителя нет
+		return nil, p.withExistingId(ctx, mapPostgresError(err, entity.ErrGenreNotFound),
+			getGenreIdByParentNameQuery, genre.ParentId, genre.Name)
+	}
+
+	return genre, nil
+}
+
+func (p *postgresRepository) ListGenres(ctx context.Context, filter entity.GenreFilter) ([]*entity.Genre, error) {
+	entity.SendLoggerInfo(p.logger, ctx, "Start to list genres.", layerPost)
+
+	genres := make([]*entity.Genre, 0)
+	err := measureQueryLatency("list_genres", func() error {
+		rows, err := p.db.Query(ctx, listGenresQuery, filter.ParentId)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var genre entity.Genre
+			if err = rows.Scan(&genre.Id, &genre.Name, &genre.ParentId, &genre.CreatedAt); err != nil {
+				return err
+			}
+			genres = append(genres, &genre)
+		}
+
+		return rows.Err()
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return genres, nil
+}
+
+func (p *postgresRepository) AttachBookGenres(
+	ctx context.Context,
+	bookId string,
+	genreIds []string,
+) (attached []*entity.BookGenre, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to attach book genres.", layerPost, "book_id", bookId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	err = measureQueryLatency("lock_book", func() error {
+		return tx.QueryRow(ctx, lockBookQuery, bookId).Scan(&bookId)
+	})
+
+	if err != nil {
+		return nil, mapPostgresError(err, entity.ErrBookNotFound)
+	}
+
+	attached = make([]*entity.BookGenre, 0, len(genreIds))
+	err = measureQueryLatency("attach_book_genres", func() error {
+		rows, err := tx.Query(ctx, insertBookGenresQuery, bookId, genreIds)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			bookGenre := entity.BookGenre{BookId: bookId}
+			if err = rows.Scan(&bookGenre.GenreId, &bookGenre.AttachedAt); err != nil {
+				return err
+			}
+			attached = append(attached, &bookGenre)
+		}
+
+		return rows.Err()
+	})
+
+	if err != nil {
+		// Книга уже заблокирована, поэтому внешний ключ может нарушить только жанр
+		return nil, mapPostgresError(err, entity.ErrGenreNotFound)
+	}
+
+	return attached, nil
+}
+
+func (p *postgresRepository) DetachBookGenres(ctx context.Context, bookId string, genreIds []string) (txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to detach book genres.", layerPost, "book_id", bookId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer rollback(txErr)
+
+	err = measureQueryLatency("lock_book", func() error {
+		return tx.QueryRow(ctx, lockBookQuery, bookId).Scan(&bookId)
+	})
+
+	if err != nil {
+		return mapPostgresError(err, entity.ErrBookNotFound)
+	}
+
+	return measureQueryLatency("detach_book_genres", func() error {
+		_, err := tx.Exec(ctx, deleteBookGenresQuery, bookId, genreIds)
+		return err
+	})
+}
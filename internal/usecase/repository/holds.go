@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/project/library/internal/entity"
+)
+
+func (p *postgresRepository) PlaceHold(
+	ctx context.Context,
+	bookId string,
+	patronId string,
+) (resHold *entity.Hold, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to place hold.", layerPost, "book_id", bookId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	var hold *entity.Hold
+	err = measureQueryLatency("place_hold", func() error {
+		if err := lockBorrowingPatron(ctx, tx, patronId); err != nil {
+			return err
+		}
+
+		var err error
+		hold, err = scanHold(tx.QueryRow(ctx, insertHoldQuery, bookId, patronId))
+		return err
+	})
+
+	if err != nil {
+		return nil, p.withExistingId(ctx, mapPostgresError(err, entity.ErrBookNotFound),
+			getActiveHoldIdQuery, bookId, patronId)
+	}
+
+	return hold, nil
+}
+
+func (p *postgresRepository) CancelHold(ctx context.Context, holdId string) (resHold *entity.Hold, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to cancel hold.", layerPost, "hold_id", holdId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	var hold *entity.Hold
+	err = measureQueryLatency("cancel_hold", func() error {
+		var status string
+		if err := tx.QueryRow(ctx, lockHoldQuery, holdId).Scan(&status); err != nil {
+			return mapPostgresError(err, entity.ErrHoldNotFound)
+		}
+
+		if holdStatus := entity.ParseHoldStatus(status); holdStatus != entity.HoldStatusWaiting &&
+			holdStatus != entity.HoldStatusReady {
+			return entity.ErrHoldClosed
+		}
+
+		var err error
+		hold, err = scanHold(tx.QueryRow(ctx, closeHoldQuery, holdId, entity.HoldStatusCancelled.String()))
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+func (p *postgresRepository) FulfillNextHold(ctx context.Context, bookId string) (resHold *entity.Hold, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to fulfill next hold.", layerPost, "book_id", bookId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	var hold *entity.Hold
+	err = measureQueryLatency("fulfill_next_hold", func() error {
+		var err error
+		hold, err = scanHold(tx.QueryRow(ctx, fulfillNextHoldQuery, bookId, p.cfg.Hold.PickupDays))
+		return err
+	})
+
+	if err != nil {
+		return nil, mapPostgresError(err, entity.ErrNoWaitingHolds)
+	}
+
+	return hold, nil
+}
+
+func (p *postgresRepository) FulfillPatronHold(
+	ctx context.Context,
+	bookId string,
+	patronId string,
+) (resHold *entity.Hold, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to fulfill patron hold.", layerPost, "book_id", bookId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	var hold *entity.Hold
+	err = measureQueryLatency("fulfill_patron_hold", func() error {
+		var holdId string
+		if err := tx.QueryRow(ctx, lockPatronHoldQuery, bookId, patronId).Scan(&holdId); err != nil {
+			return mapPostgresError(err, entity.ErrHoldNotFound)
+		}
+
+		var err error
+		hold, err = scanHold(tx.QueryRow(ctx, closeHoldQuery, holdId, entity.HoldStatusFulfilled.String()))
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+func (p *postgresRepository) ExpireHolds(ctx context.Context, limit int) (resHolds []*entity.Hold, txErr error) {
+	entity.SendLoggerInfo(p.logger, ctx, "Start to expire holds.", layerPost)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	var holds []*entity.Hold
+	err = measureQueryLatency("expire_holds", func() error {
+		rows, err := tx.Query(ctx, expireHoldsQuery, limit)
+		if err != nil {
+			return err
+		}
+
+		holds, err = collectHolds(rows)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return holds, nil
+}
+
+func (p *postgresRepository) ListHolds(ctx context.Context, filter entity.HoldFilter) ([]*entity.Hold, error) {
+	entity.SendLoggerInfo(p.logger, ctx, "Start to list holds.", layerPost)
+
+	var holds []*entity.Hold
+	err := measureQueryLatency("list_holds", func() error {
+		rows, err := p.db.Query(ctx, listHoldsQuery, filter.BookId, filter.PatronId, filter.IncludeClosed)
+		if err != nil {
+			return err
+		}
+
+		holds, err = collectHolds(rows)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return holds, nil
+}
+
+// scanHold читает бронь в порядке колонок запросов, возвращающих book_hold
+func scanHold(row pgx.Row) (*entity.Hold, error) {
+	var hold entity.Hold
+	var status string
+
+	err := row.Scan(&hold.Id, &hold.BookId, &hold.PatronId, &status, &hold.PlacedAt,
+		&hold.ReadyAt, &hold.ExpiresAt, &hold.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	hold.Status = entity.ParseHoldStatus(status)
+
+	return &hold, nil
+}
+
+func collectHolds(rows pgx.Rows) ([]*entity.Hold, error) {
+	defer rows.Close()
+
+	holds := make([]*entity.Hold, 0)
+	for rows.Next() {
+		hold, err := scanHold(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		holds = append(holds, hold)
+	}
+
+	return holds, rows.Err()
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -16,14 +17,109 @@ type (
 	AuthorRepository interface {
 		RegisterAuthor(ctx context.Context, author *entity.Author) (*entity.Author, error)
 		GetAuthorInfo(ctx context.Context, authorId string) (*entity.Author, error)
-		ChangeAuthor(ctx context.Context, authorId string, newAuthorName string) error
+		GetAuthorInfoAsOf(ctx context.Context, authorId string, asOf time.Time) (*entity.Author, error)
+		GetAuthorHistory(ctx context.Context, authorId string) ([]*entity.AuthorRevision, error)
+		ChangeAuthor(ctx context.Context, update entity.AuthorUpdate) error
+		DeleteAuthor(ctx context.Context, authorId string, policy entity.DeleteAuthorPolicy) (*entity.AuthorRemoval, error)
+		MergeAuthors(ctx context.Context, sourceIds []string, targetId string) (*entity.AuthorMerge, error)
+		ListAuthors(ctx context.Context, filter entity.AuthorFilter) (*entity.AuthorPage, error)
+		SearchAuthors(ctx context.Context, search entity.AuthorSearch) ([]*entity.AuthorMatch, error)
 	}
 
 	BooksRepository interface {
 		AddBook(ctx context.Context, book *entity.Book) (*entity.Book, error)
 		GetBook(ctx context.Context, bookId string) (*entity.Book, error)
-		UpdateBook(ctx context.Context, bookId string, newBookName string, authorIds []string) error
-		GetAuthorBooks(ctx context.Context, authorId string) ([]*entity.Book, error)
+		GetBookAsOf(ctx context.Context, bookId string, asOf time.Time) (*entity.Book, error)
+		GetBookHistory(ctx context.Context, bookId string) ([]*entity.BookRevision, error)
+		GetBookByISBN(ctx context.Context, isbn string) (*entity.Book, error)
+		UpdateBook(ctx context.Context, update entity.BookUpdate) error
+		GetAuthorBooks(ctx context.Context, authorId string, role *entity.ContributorRole) ([]*entity.Book, error)
+		GetPublisherBooks(ctx context.Context, publisherId string) ([]*entity.Book, error)
+		GetSeriesBooks(ctx context.Context, seriesId string) ([]*entity.Book, error)
+		DeleteBook(ctx context.Context, bookId string) (*entity.Book, error)
+		RestoreBook(ctx context.Context, bookId string) (*entity.Book, error)
+		ListBooks(ctx context.Context, filter entity.BookFilter) (*entity.BookPage, error)
+		SearchBooks(ctx context.Context, search entity.BookSearch) (*entity.BookSearchPage, error)
+	}
+
+	PublisherRepository interface {
+		CreatePublisher(ctx context.Context, publisher *entity.Publisher) (*entity.Publisher, error)
+		GetPublisher(ctx context.Context, publisherId string) (*entity.Publisher, error)
+		UpdatePublisher(ctx context.Context, publisherId string, newName string) error
+		// DeletePublisher возвращает неудаленные книги издательства после отвязки
+		DeletePublisher(ctx context.Context, publisherId string) ([]*entity.Book, error)
+	}
+
+	SeriesRepository interface {
+		CreateSeries(ctx context.Context, series *entity.Series) (*entity.Series, error)
+		AddBookToSeries(ctx context.Context, seriesId string, bookId string, volume int32) error
+		RemoveBookFromSeries(ctx context.Context, seriesId string, bookId string) error
+	}
+
+	CopyRepository interface {
+		AddCopy(ctx context.Context, bookCopy *entity.Copy) (*entity.Copy, error)
+		UpdateCopy(ctx context.Context, update entity.CopyUpdate) error
+		RetireCopy(ctx context.Context, copyId string) (*entity.Copy, error)
+		ListBookCopies(ctx context.Context, filter entity.CopyFilter) ([]*entity.Copy, error)
+		GetCopyAvailability(ctx context.Context, bookId string) (*entity.CopyAvailability, error)
+	}
+
+	PatronRepository interface {
+		RegisterPatron(ctx context.Context, patron *entity.Patron) (*entity.Patron, error)
+		GetPatron(ctx context.Context, patronId string) (*entity.Patron, error)
+		UpdatePatron(ctx context.Context, update entity.PatronUpdate) (*entity.Patron, error)
+		DeletePatron(ctx context.Context, patronId string) (*entity.Patron, error)
+	}
+
+	LoanRepository interface {
+		CheckoutCopy(ctx context.Context, copyId string, patronId string) (*entity.Loan, error)
+		ReturnCopy(ctx context.Context, copyId string) (*entity.Loan, error)
+		RenewLoan(ctx context.Context, loanId string) (*entity.Loan, error)
+		ListPatronLoans(ctx context.Context, filter entity.LoanFilter) ([]*entity.Loan, error)
+	}
+
+	HoldRepository interface {
+		PlaceHold(ctx context.Context, bookId string, patronId string) (*entity.Hold, error)
+		CancelHold(ctx context.Context, holdId string) (*entity.Hold, error)
+		FulfillNextHold(ctx context.Context, bookId string) (*entity.Hold, error)
+		FulfillPatronHold(ctx context.Context, bookId string, patronId string) (*entity.Hold, error)
+		ExpireHolds(ctx context.Context, limit int) ([]*entity.Hold, error)
+		ListHolds(ctx context.Context, filter entity.HoldFilter) ([]*entity.Hold, error)
+	}
+
+	ChargeRepository interface {
+		RecordLateReturn(ctx context.Context, lateReturn entity.LateReturn) (*entity.Charge, error)
+		PayCharge(ctx context.Context, chargeId string) (*entity.Charge, error)
+		WaiveCharge(ctx context.Context, chargeId string, reason string) (*entity.Charge, error)
+		ListCharges(ctx context.Context, filter entity.ChargeFilter) ([]*entity.Charge, error)
+		ClaimChargeReminders(ctx context.Context, limit int) ([]*entity.Charge, error)
+	}
+
+	ReviewRepository interface {
+		CreateReview(ctx context.Context, review *entity.Review) (*entity.Review, error)
+		UpdateReview(ctx context.Context, update entity.ReviewUpdate) (*entity.Review, error)
+		DeleteReview(ctx context.Context, reviewId string) error
+		ListBookReviews(ctx context.Context, filter entity.ReviewFilter) (*entity.ReviewPage, error)
+		GetBookRating(ctx context.Context, bookId string) (*entity.BookRating, error)
+	}
+
+	CoverRepository interface {
+		SetBookCover(ctx context.Context, cover *entity.BookCover) (*entity.BookCover, error)
+		GetBookCover(ctx context.Context, bookId string) (*entity.BookCover, error)
+	}
+
+	// BlobStorage хранит содержимое по его SHA-256, поэтому одинаковое содержимое хранится один раз
+	BlobStorage interface {
+		PutBlob(ctx context.Context, content io.Reader) (*entity.Blob, error)
+		// GetBlob возвращает entity.ErrBlobNotFound, если содержимого нет. Reader нужно закрыть
+		GetBlob(ctx context.Context, sha256 string) (io.ReadCloser, error)
+	}
+
+	GenreRepository interface {
+		CreateGenre(ctx context.Context, genre *entity.Genre) (*entity.Genre, error)
+		ListGenres(ctx context.Context, filter entity.GenreFilter) ([]*entity.Genre, error)
+		AttachBookGenres(ctx context.Context, bookId string, genreIds []string) ([]*entity.BookGenre, error)
+		DetachBookGenres(ctx context.Context, bookId string, genreIds []string) error
 	}
 
 	OutboxRepository interface {
@@ -59,6 +155,16 @@ const (
 	OutboxKindUndefined OutboxKind = iota
 	OutboxKindBook
 	OutboxKindAuthor
+	OutboxKindBookDeleted
+	OutboxKindAuthorDeleted
+	OutboxKindBookGenreAdded
+	OutboxKindAuthorMerged
+	OutboxKindPatron
+	OutboxKindPatronDeleted
+	OutboxKindLoan
+	OutboxKindHold
+	OutboxKindCharge
+	OutboxKindChargeReminder
 )
 
 func (o OutboxKind) String() string {
@@ -67,6 +173,26 @@ func (o OutboxKind) String() string {
 		return "book"
 	case OutboxKindAuthor:
 		return "author"
+	case OutboxKindBookDeleted:
+		return "book_deleted"
+	case OutboxKindAuthorDeleted:
+		return "author_deleted"
+	case OutboxKindBookGenreAdded:
+		return "book_genre_added"
+	case OutboxKindAuthorMerged:
+		return "author_merged"
+	case OutboxKindPatron:
+		return "patron"
+	case OutboxKindPatronDeleted:
+		return "patron_deleted"
+	case OutboxKindLoan:
+		return "loan"
+	case OutboxKindHold:
+		return "hold"
+	case OutboxKindCharge:
+		return "charge"
+	case OutboxKindChargeReminder:
+		return "charge_reminder"
 	default:
 		return "undefined"
 	}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/project/library/internal/entity"
+)
+
+// Авторы сортируются по фамилии: индекс idx_author_sort_name покрывает и порядок, и пагинацию
+var authorSortNameOrder = keysetOrder{column: "author.sort_name", cast: "text"}
+
+const authorSortNameOrderName = "sort_name_asc"
+
+func (p *postgresRepository) ListAuthors(ctx context.Context, filter entity.AuthorFilter) (*entity.AuthorPage, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to list authors.", layerPost, "name_prefix", filter.NamePrefix)
+	start := time.Now()
+	defer func() {
+		dbQueryLatency.WithLabelValues("list_authors").Observe(time.Since(start).Seconds())
+	}()
+
+	cursor, err := entity.DecodeCursor(filter.PageToken, authorSortNameOrderName)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := &conditionBuilder{}
+	if filter.NamePrefix != "" {
+		// Нижняя граница дает индексу точку старта, LIKE отсекает остальное
+		builder.add("author.name >= " + builder.arg(filter.NamePrefix))
+		builder.add("author.name LIKE " + builder.arg(likePrefix(filter.NamePrefix)))
+	}
+
+	if cursor != nil {
+		builder.add(authorSortNameOrder.after(builder, "author.id", cursor.Value, cursor.Id))
+	}
+
+	bookCountColumn := "NULL::bigint"
+	if filter.WithBookCount {
+		bookCountColumn = authorBookCountColumn
+	}
+
+	pageSize := entity.PageSize(filter.PageSize)
+	query := fmt.Sprintf(listAuthorsQuery,
+		bookCountColumn, builder.where(), authorSortNameOrder.orderBy("author.id"), pageSize+1)
+
+	rows, err := p.db.Query(ctx, query, builder.args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	authors := make([]*entity.AuthorEntry, 0)
+	for rows.Next() {
+		var author entity.Author
+		var bookCount *int64
+
+		if err = rows.Scan(&author.Id, &author.Name, &author.SortName, &bookCount); err != nil {
+			return nil, err
+		}
+
+		authors = append(authors, &entity.AuthorEntry{Author: &author, BookCount: bookCount})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	page := &entity.AuthorPage{Authors: authors}
+	if len(authors) > pageSize {
+		page.Authors = authors[:pageSize]
+		last := page.Authors[pageSize-1].Author
+		page.NextPageToken = entity.EncodeCursor(entity.Cursor{
+			Order: authorSortNameOrderName,
+			Value: last.SortName,
+			Id:    last.Id,
+		})
+	}
+
+	return page, nil
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/project/library/internal/entity"
+)
+
+var bookOrders = map[entity.BookOrder]keysetOrder{
+	entity.BookOrderCreatedAtAsc:  {column: "book.created_at", cast: "timestamp"},
+	entity.BookOrderCreatedAtDesc: {column: "book.created_at", cast: "timestamp", desc: true},
+	entity.BookOrderNameAsc:       {column: "book.name", cast: "text"},
+	entity.BookOrderNameDesc:      {column: "book.name", cast: "text", desc: true},
+}
+
+func (p *postgresRepository) ListBooks(ctx context.Context, filter entity.BookFilter) (*entity.BookPage, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to list books.", layerPost, "order_by", filter.OrderBy.String())
+	start := time.Now()
+	defer func() {
+		dbQueryLatency.WithLabelValues("list_books").Observe(time.Since(start).Seconds())
+	}()
+
+	order, ok := bookOrders[filter.OrderBy]
+	if !ok {
+		order = bookOrders[entity.BookOrderCreatedAtAsc]
+	}
+
+	cursor, err := entity.DecodeCursor(filter.PageToken, filter.OrderBy.String())
+	if err != nil {
+		return nil, err
+	}
+
+	builder := &conditionBuilder{}
+	builder.add("book.deleted_at IS NULL")
+	addBookFilterConditions(builder, filter)
+
+	if cursor != nil {
+		builder.add(order.after(builder, "book.id", cursor.Value, cursor.Id))
+	}
+
+	pageSize := entity.PageSize(filter.PageSize)
+	// Лишняя запись показывает, есть ли следующая страница
+	query := fmt.Sprintf(listBooksQuery, builder.where(), order.orderBy("book.id"), pageSize+1)
+
+	rows, err := p.db.Query(ctx, query, builder.args...)
+	if err != nil {
+		return nil, err
+	}
+
+	books, err := collectBooks(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &entity.BookPage{Books: books}
+	if len(books) > pageSize {
+		page.Books = books[:pageSize]
+		last := page.Books[pageSize-1]
+		page.NextPageToken = entity.EncodeCursor(entity.Cursor{
+			Order: filter.OrderBy.String(),
+			Value: bookCursorValue(last, filter.OrderBy),
+			Id:    last.Id,
+		})
+	}
+
+	return page, nil
+}
+
+func addBookFilterConditions(builder *conditionBuilder, filter entity.BookFilter) {
+	if filter.NamePrefix != "" {
+		builder.add("book.name LIKE " + builder.arg(likePrefix(filter.NamePrefix)))
+	}
+
+	if len(filter.AuthorIds) > 0 {
+		builder.add("book.id IN (SELECT book_id FROM author_book WHERE author_id = ANY(" +
+			builder.arg(filter.AuthorIds) + "::uuid[]))")
+	}
+
+	if len(filter.GenreIds) > 0 {
+		builder.add(fmt.Sprintf(bookGenreSubtreeCondition, builder.arg(filter.GenreIds)))
+	}
+
+	if filter.CreatedAfter != nil {
+		builder.add("book.created_at >= " + builder.arg(*filter.CreatedAfter))
+	}
+
+	if filter.CreatedBefore != nil {
+		builder.add("book.created_at < " + builder.arg(*filter.CreatedBefore))
+	}
+
+	if filter.UpdatedAfter != nil {
+		builder.add("book.updated_at >= " + builder.arg(*filter.UpdatedAfter))
+	}
+
+	if filter.UpdatedBefore != nil {
+		builder.add("book.updated_at < " + builder.arg(*filter.UpdatedBefore))
+	}
+
+	if filter.YearFrom != nil {
+		builder.add("book.publication_year >= " + builder.arg(*filter.YearFrom))
+	}
+
+	if filter.YearTo != nil {
+		builder.add("book.publication_year <= " + builder.arg(*filter.YearTo))
+	}
+
+	if filter.Language != "" {
+		builder.add("book.language = " + builder.arg(filter.Language))
+	}
+}
+
+func bookCursorValue(book *entity.Book, order entity.BookOrder) string {
+	switch order {
+	case entity.BookOrderNameAsc, entity.BookOrderNameDesc:
+		return book.Name
+	default:
+		return book.CreatedAt.Format(time.RFC3339Nano)
+	}
+}
@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/project/library/internal/entity"
+)
+
+func (p *postgresRepository) CheckoutCopy(
+	ctx context.Context,
+	copyId string,
+	patronId string,
+) (resLoan *entity.Loan, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to checkout copy.", layerPost, "copy_id", copyId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	var loan entity.Loan
+	err = measureQueryLatency("checkout_copy", func() error {
+		if err := lockLendableCopy(ctx, tx, copyId); err != nil {
+			return err
+		}
+
+		if err := lockBorrowingPatron(ctx, tx, patronId); err != nil {
+			return err
+		}
+
+		return tx.QueryRow(ctx, insertLoanQuery, copyId, patronId, p.cfg.Loan.PeriodDays).Scan(loanFields(&loan)...)
+	})
+
+	if err != nil {
+		return nil, mapPostgresError(err, entity.ErrCopyNotFound)
+	}
+
+	return &loan, nil
+}
+
+func (p *postgresRepository) ReturnCopy(ctx context.Context, copyId string) (resLoan *entity.Loan, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to return copy.", layerPost, "copy_id", copyId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	var loan entity.Loan
+	err = measureQueryLatency("return_copy", func() error {
+		var status string
+		if err := tx.QueryRow(ctx, lockCopyQuery, copyId).Scan(&status); err != nil {
+			return mapPostgresError(err, entity.ErrCopyNotFound)
+		}
+
+		return mapPostgresError(tx.QueryRow(ctx, returnLoanQuery, copyId).Scan(loanFields(&loan)...),
+			entity.ErrCopyNotOnLoan)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &loan, nil
+}
+
+func (p *postgresRepository) RenewLoan(ctx context.Context, loanId string) (resLoan *entity.Loan, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to renew loan.", layerPost, "loan_id", loanId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	var loan entity.Loan
+	err = measureQueryLatency("renew_loan", func() error {
+		var patronId string
+		var returned bool
+		if err := tx.QueryRow(ctx, lockLoanQuery, loanId).Scan(&patronId, &returned); err != nil {
+			return mapPostgresError(err, entity.ErrLoanNotFound)
+		}
+
+		if returned {
+			return entity.ErrLoanReturned
+		}
+
+		if err := lockBorrowingPatron(ctx, tx, patronId); err != nil {
+			return err
+		}
+
+		return tx.QueryRow(ctx, renewLoanQuery, loanId, p.cfg.Loan.PeriodDays).Scan(loanFields(&loan)...)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &loan, nil
+}
+
+func (p *postgresRepository) ListPatronLoans(ctx context.Context, filter entity.LoanFilter) ([]*entity.Loan, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to list patron loans.", layerPost,
+		"patron_id", filter.PatronId)
+
+	var loans []*entity.Loan
+	err := measureQueryLatency("list_patron_loans", func() error {
+		rows, err := p.db.Query(ctx, listPatronLoansQuery, filter.PatronId, filter.IncludeReturned)
+		if err != nil {
+			return err
+		}
+
+		loans, err = collectLoans(rows)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return loans, nil
+}
+
+// lockLendableCopy блокирует экземпляр до конца транзакции, поэтому одновременные выдачи
+// одного экземпляра выполняются по очереди и вторая видит выдачу первой
+func lockLendableCopy(ctx context.Context, tx pgx.Tx, copyId string) error {
+	var status string
+	if err := tx.QueryRow(ctx, lockCopyQuery, copyId).Scan(&status); err != nil {
+		return mapPostgresError(err, entity.ErrCopyNotFound)
+	}
+
+	if entity.ParseCopyStatus(status) != entity.CopyStatusAvailable {
+		return entity.ErrCopyUnavailable
+	}
+
+	var onLoan bool
+	if err := tx.QueryRow(ctx, activeLoanExistsQuery, copyId).Scan(&onLoan); err != nil {
+		return err
+	}
+
+	if onLoan {
+		return entity.ErrCopyOnLoan
+	}
+
+	return nil
+}
+
+// lockBorrowingPatron проверяет, что читатель может брать книги, и не дает удалить его до конца транзакции
+func lockBorrowingPatron(ctx context.Context, tx pgx.Tx, patronId string) error {
+	var status string
+	var expired bool
+	if err := tx.QueryRow(ctx, lockBorrowingPatronQuery, patronId).Scan(&status, &expired); err != nil {
+		return mapPostgresError(err, entity.ErrPatronNotFound)
+	}
+
+	switch {
+	case entity.ParsePatronStatus(status) == entity.PatronStatusSuspended:
+		return entity.ErrPatronSuspended
+	case expired:
+		return entity.ErrPatronCardExpired
+	}
+
+	return nil
+}
+
+// loanFields возвращает поля для Scan в порядке колонок запросов, читающих выдачу
+func loanFields(loan *entity.Loan) []any {
+	return []any{
+		&loan.Id,
+		&loan.CopyId,
+		&loan.BookId,
+		&loan.PatronId,
+		&loan.CheckedOutAt,
+		&loan.DueAt,
+		&loan.Renewals,
+		&loan.ReturnedAt,
+		&loan.UpdatedAt,
+	}
+}
+
+func collectLoans(rows pgx.Rows) ([]*entity.Loan, error) {
+	defer rows.Close()
+
+	loans := make([]*entity.Loan, 0)
+	for rows.Next() {
+		var loan entity.Loan
+		if err := rows.Scan(loanFields(&loan)...); err != nil {
+			return nil, err
+		}
+
+		loans = append(loans, &loan)
+	}
+
+	return loans, rows.Err()
+}
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/project/library/internal/entity"
+)
+
+func (p *postgresRepository) RegisterPatron(ctx context.Context, patron *entity.Patron) (resPatron *entity.Patron, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to register patron.", layerPost,
+		"card_number", patron.CardNumber)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	err = measureQueryLatency("register_patron", func() error {
+		return tx.QueryRow(ctx, insertPatronQuery, patron.CardNumber, patron.Name, patron.Email, patron.Phone,
+			patron.ExpiresOn).
+			Scan(&patron.Id, &patron.CreatedAt, &patron.UpdatedAt)
+	})
+
+	if err != nil {
+		return nil, p.withExistingId(ctx, mapPostgresError(err, entity.ErrPatronNotFound),
+			getPatronIdByCardNumberQuery, patron.CardNumber)
+	}
+
+	patron.Status = entity.PatronStatusActive
+
+	return patron, nil
+}
+
+func (p *postgresRepository) GetPatron(ctx context.Context, patronId string) (*entity.Patron, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to get patron.", layerPost, "patron_id", patronId)
+
+	var patron entity.Patron
+	var patronStatus string
+	err := measureQueryLatency("get_patron", func() error {
+		return p.db.QueryRow(ctx, getPatronQuery, patronId).Scan(patronFields(&patron, &patronStatus)...)
+	})
+
+	if err != nil {
+		return nil, mapPostgresError(err, entity.ErrPatronNotFound)
+	}
+
+	patron.Status = entity.ParsePatronStatus(patronStatus)
+
+	return &patron, nil
+}
+
+func (p *postgresRepository) UpdatePatron(
+	ctx context.Context,
+	update entity.PatronUpdate,
+) (resPatron *entity.Patron, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to update patron.", layerPost, "patron_id", update.Id)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	var newStatus *string
+	if update.Status != nil {
+		value := update.Status.String()
+		newStatus = &value
+	}
+
+	var patron entity.Patron
+	var patronStatus string
+	err = measureQueryLatency("update_patron", func() error {
+		return tx.QueryRow(ctx, updatePatronQuery, update.Id, update.Name, update.Email, update.Phone,
+			newStatus, update.ExpiresOn).
+			Scan(patronFields(&patron, &patronStatus)...)
+	})
+
+	if err != nil {
+		return nil, mapPostgresError(err, entity.ErrPatronNotFound)
+	}
+
+	patron.Status = entity.ParsePatronStatus(patronStatus)
+
+	return &patron, nil
+}
+
+func (p *postgresRepository) DeletePatron(ctx context.Context, patronId string) (resPatron *entity.Patron, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to delete patron.", layerPost, "patron_id", patronId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	var patron entity.Patron
+	var patronStatus string
+	err = measureQueryLatency("delete_patron", func() error {
+		var hasLoans bool
+		if err := tx.QueryRow(ctx, lockPatronLoansQuery, patronId).Scan(&hasLoans); err != nil {
+			return err
+		}
+
+		if hasLoans {
+			return entity.ErrPatronHasLoans
+		}
+
+		var hasCharges bool
+		if err := tx.QueryRow(ctx, outstandingChargesExistQuery, patronId).Scan(&hasCharges); err != nil {
+			return err
+		}
+
+		if hasCharges {
+			return entity.ErrPatronHasCharges
+		}
+
+		if _, err := tx.Exec(ctx, removePatronReviewsFromRatingQuery, patronId); err != nil {
+			return err
+		}
+
+		return tx.QueryRow(ctx, deletePatronQuery, patronId).Scan(patronFields(&patron, &patronStatus)...)
+	})
+
+	if err != nil {
+		return nil, mapPostgresError(err, entity.ErrPatronNotFound)
+	}
+
+	patron.Status = entity.ParsePatronStatus(patronStatus)
+
+	return &patron, nil
+}
+
+// patronFields возвращает поля для Scan в порядке колонок запросов, читающих читателя
+func patronFields(patron *entity.Patron, patronStatus *string) []any {
+	return []any{
+		&patron.Id,
+		&patron.CardNumber,
+		&patron.Name,
+		&patron.Email,
+		&patron.Phone,
+		patronStatus,
+		&patron.ExpiresOn,
+		&patron.CreatedAt,
+		&patron.UpdatedAt,
+	}
+}
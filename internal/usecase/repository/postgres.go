@@ -10,15 +10,36 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/project/library/config"
 	"github.com/project/library/internal/entity"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 )
 
 var _ AuthorRepository = (*postgresRepository)(nil)
 var _ BooksRepository = (*postgresRepository)(nil)
+var _ GenreRepository = (*postgresRepository)(nil)
+var _ PublisherRepository = (*postgresRepository)(nil)
+var _ SeriesRepository = (*postgresRepository)(nil)
+var _ CopyRepository = (*postgresRepository)(nil)
+var _ PatronRepository = (*postgresRepository)(nil)
+var _ LoanRepository = (*postgresRepository)(nil)
+var _ HoldRepository = (*postgresRepository)(nil)
+var _ ChargeRepository = (*postgresRepository)(nil)
+var _ ReviewRepository = (*postgresRepository)(nil)
+var _ CoverRepository = (*postgresRepository)(nil)
+
+const (
+	foreignKeyViolationCode = "23503"
+	uniqueViolationCode     = "23505"
+	checkViolationCode      = "23514"
+)
 
-var ErrForeignKeyViolation = &pgconn.PgError{Code: "23503"}
+// checkConstraintErrors сопоставляет проверки таблиц, которые может нарушить клиент, с ошибками
+var checkConstraintErrors = map[string]error{
+	"author_life_dates_check": entity.ErrInvalidAuthorDates,
+}
 
 const layerPost = "postgres"
 
@@ -38,12 +59,14 @@ func init() {
 type postgresRepository struct {
 	db     *pgxpool.Pool
 	logger *zap.Logger
+	cfg    *config.Config
 }
 
-func NewPostgresRepository(db *pgxpool.Pool, logger *zap.Logger) *postgresRepository {
+func NewPostgresRepository(db *pgxpool.Pool, logger *zap.Logger, cfg *config.Config) *postgresRepository {
 	return &postgresRepository{
 		db:     db,
 		logger: logger,
+		cfg:    cfg,
 	}
 }
 
@@ -62,9 +85,14 @@ func (p *postgresRepository) AddBook(ctx context.Context, book *entity.Book) (re
 	}()
 
 	id := uuid.UUID{}
-	err = tx.QueryRow(ctx, insertBookQuery, book.Name).Scan(&id, &book.CreatedAt, &book.UpdatedAt)
+	uniqueKey := p.bookUniqueKey(book.Name, book.AuthorIds)
+	err = tx.QueryRow(ctx, insertBookQuery, book.Name, uniqueKey, book.ISBN,
+		book.PublicationYear, book.Language, book.PageCount, book.Description, book.PublisherId).
+		Scan(&id, &book.CreatedAt, &book.UpdatedAt, &book.Revision)
 	if err != nil {
-		return nil, err
+		// Внешний ключ книги ссылается только на издательство
+		return nil, p.withExistingId(ctx, mapPostgresError(err, entity.ErrPublisherNotFound),
+			getConflictingBookIdQuery, uniqueKey, book.ISBN, nil)
 	}
 
 	book.Id = id.String()
@@ -74,30 +102,43 @@ func (p *postgresRepository) AddBook(ctx context.Context, book *entity.Book) (re
 		return nil, mapPostgresError(err, entity.ErrAuthorNotFound)
 	}
 
+	if err = saveBookRevisions(ctx, tx, []string{book.Id}); err != nil {
+		return nil, err
+	}
+
 	return book, nil
 }
 
 func (p *postgresRepository) GetBook(ctx context.Context, bookId string) (*entity.Book, error) {
 	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to get book", layerPost, "book_id", bookId)
 
+	return p.getBookBy(ctx, "get_book", getBookQuery, bookId)
+}
+
+func (p *postgresRepository) GetBookByISBN(ctx context.Context, isbn string) (*entity.Book, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to get book by isbn", layerPost, "isbn", isbn)
+
+	return p.getBookBy(ctx, "get_book_by_isbn", getBookByISBNQuery, isbn)
+}
+
+func (p *postgresRepository) getBookBy(ctx context.Context, operation string, query string, arg any) (*entity.Book, error) {
 	var book entity.Book
-	var authorIDs []uuid.UUID
-	err := measureQueryLatency("get_book", func() error {
-		return p.db.QueryRow(ctx, getBookQuery, bookId).
-			Scan(&book.Id, &book.Name, &book.CreatedAt, &book.UpdatedAt, &authorIDs)
+	var authors bookAuthors
+	err := measureQueryLatency(operation, func() error {
+		return p.db.QueryRow(ctx, query, arg).Scan(bookFields(&book, &authors)...)
 	})
 
 	if err != nil {
 		return nil, mapPostgresError(err, entity.ErrBookNotFound)
 	}
 
-	book.AuthorIds = convertUUIDsToStrings(authorIDs)
+	authors.fill(&book)
 
 	return &book, nil
 }
 
-func (p *postgresRepository) UpdateBook(ctx context.Context, bookId string, newBookName string, authorIds []string) (txErr error) {
-	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to update book.", layerPost, "book_id", bookId)
+func (p *postgresRepository) UpdateBook(ctx context.Context, update entity.BookUpdate) (txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to update book.", layerPost, "book_id", update.Id)
 
 	tx, rollback, err := p.beginTx(ctx)
 	if err != nil {
@@ -111,48 +152,148 @@ func (p *postgresRepository) UpdateBook(ctx context.Context, bookId string, newB
 		dbQueryLatency.WithLabelValues("update_book").Observe(time.Since(start).Seconds())
 	}()
 
-	_, err = tx.Exec(ctx, updateBookQuery, newBookName, bookId)
+	if update.ExpectedRevision != nil {
+		err = checkRevision(ctx, tx, lockBookRevisionQuery, update.Id, *update.ExpectedRevision,
+			entity.ErrBookNotFound, entity.ErrBookRevisionMismatch)
+		if err != nil {
+			return err
+		}
+	}
+
+	uniqueKey := p.bookUniqueKey(update.Name, update.AuthorIds)
+	tag, err := tx.Exec(ctx, updateBookQuery, update.Name, update.Id, uniqueKey, update.ISBN,
+		update.PublicationYear, update.Language, update.PageCount, update.Description, update.PublisherId)
 	if err != nil {
-		return err
+		return p.withExistingId(ctx, mapPostgresError(err, entity.ErrPublisherNotFound),
+			getConflictingBookIdQuery, uniqueKey, update.ISBN, update.Id)
+	}
+
+	// Удаленная книга не меняется, в том числе ее авторы
+	if tag.RowsAffected() == 0 {
+		return entity.ErrBookNotFound
 	}
 
-	_, err = tx.Exec(ctx, updateBookAuthorsQuery, authorIds, bookId)
+	authorIds, roles := contributorColumns(update.Contributors)
+	_, err = tx.Exec(ctx, updateBookAuthorsQuery, authorIds, update.Id, roles)
 	if err != nil {
 		return mapPostgresError(err, entity.ErrAuthorNotFound)
 	}
 
-	return nil
+	return saveBookRevisions(ctx, tx, []string{update.Id})
 }
 
-func (p *postgresRepository) GetAuthorBooks(ctx context.Context, authorId string) ([]*entity.Book, error) {
+func (p *postgresRepository) GetAuthorBooks(
+	ctx context.Context,
+	authorId string,
+	role *entity.ContributorRole,
+) ([]*entity.Book, error) {
 	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to get author books.", layerPost, "author_id", authorId)
 	start := time.Now()
 	defer func() {
 		dbQueryLatency.WithLabelValues("get_author_books").Observe(time.Since(start).Seconds())
 	}()
 
-	rows, err := p.db.Query(ctx, getAuthorBooksQuery, authorId)
+	var roleArg *string
+	if role != nil {
+		roleArg = lo.ToPtr(role.String())
+	}
+
+	rows, err := p.db.Query(ctx, getAuthorBooksQuery, authorId, roleArg)
 	if err != nil {
 		return nil, err
 	}
 
-	defer rows.Close()
+	return collectBooks(rows)
+}
 
-	books := make([]*entity.Book, 0)
-	for rows.Next() {
-		var book entity.Book
-		var authorIDs []uuid.UUID
+func (p *postgresRepository) GetPublisherBooks(ctx context.Context, publisherId string) ([]*entity.Book, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to get publisher books.", layerPost, "publisher_id", publisherId)
+	start := time.Now()
+	defer func() {
+		dbQueryLatency.WithLabelValues("get_publisher_books").Observe(time.Since(start).Seconds())
+	}()
 
-		if err = rows.Scan(&book.Id, &book.Name, &book.CreatedAt,
-			&book.UpdatedAt, &authorIDs); err != nil {
-			return nil, err
-		}
+	rows, err := p.db.Query(ctx, getPublisherBooksQuery, publisherId)
+	if err != nil {
+		return nil, err
+	}
 
-		book.AuthorIds = convertUUIDsToStrings(authorIDs)
-		books = append(books, &book)
+	return collectBooks(rows)
+}
+
+func (p *postgresRepository) GetSeriesBooks(ctx context.Context, seriesId string) ([]*entity.Book, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to get series books.", layerPost, "series_id", seriesId)
+	start := time.Now()
+	defer func() {
+		dbQueryLatency.WithLabelValues("get_series_books").Observe(time.Since(start).Seconds())
+	}()
+
+	rows, err := p.db.Query(ctx, getSeriesBooksQuery, seriesId)
+	if err != nil {
+		return nil, err
 	}
 
-	return books, nil
+	return collectBooks(rows)
+}
+
+func (p *postgresRepository) DeleteBook(ctx context.Context, bookId string) (resBook *entity.Book, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to delete book.", layerPost, "book_id", bookId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	var book entity.Book
+	var authors bookAuthors
+	err = measureQueryLatency("delete_book", func() error {
+		return tx.QueryRow(ctx, deleteBookQuery, bookId).
+			Scan(append(bookFields(&book, &authors), &book.DeletedAt)...)
+	})
+
+	if err != nil {
+		return nil, mapPostgresError(err, entity.ErrBookNotFound)
+	}
+
+	if err = saveBookRevisions(ctx, tx, []string{bookId}); err != nil {
+		return nil, err
+	}
+
+	authors.fill(&book)
+
+	return &book, nil
+}
+
+func (p *postgresRepository) RestoreBook(ctx context.Context, bookId string) (resBook *entity.Book, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to restore book.", layerPost, "book_id", bookId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	var book entity.Book
+	var authors bookAuthors
+	err = measureQueryLatency("restore_book", func() error {
+		return tx.QueryRow(ctx, restoreBookQuery, bookId).Scan(bookFields(&book, &authors)...)
+	})
+
+	if err != nil {
+		return nil, p.withExistingId(ctx, mapPostgresError(err, entity.ErrBookNotFound),
+			getBookDuplicateIdQuery, bookId)
+	}
+
+	if err = saveBookRevisions(ctx, tx, []string{bookId}); err != nil {
+		return nil, err
+	}
+
+	authors.fill(&book)
+
+	return &book, nil
 }
 
 func (p *postgresRepository) RegisterAuthor(ctx context.Context, author *entity.Author) (retAuthor *entity.Author, txErr error) {
@@ -166,12 +307,28 @@ func (p *postgresRepository) RegisterAuthor(ctx context.Context, author *entity.
 	defer rollback(txErr)
 
 	id := uuid.UUID{}
+	nameKey := p.authorNameKey(author.Name)
 	err = measureQueryLatency("register_author", func() error {
-		return tx.QueryRow(ctx, insertAuthorQuery, author.Name).Scan(&id)
+		author.SortName = entity.SortName(author.Name)
+		err := tx.QueryRow(ctx, insertAuthorQuery, author.Name, nameKey, author.Biography,
+			author.BirthDate, author.DeathDate, author.ExternalIds, author.SortName, entity.NameSearchKey(author.Name)).
+			Scan(&id, &author.CreatedAt, &author.UpdatedAt, &author.Revision)
+		if err != nil {
+			return err
+		}
+
+		if len(author.Aliases) > 0 {
+			if _, err = tx.Exec(ctx, replaceAuthorAliasesQuery, id, author.Aliases); err != nil {
+				return err
+			}
+		}
+
+		return saveAuthorRevision(ctx, tx, id.String())
 	})
 
 	if err != nil {
-		return nil, err
+		return nil, p.withExistingId(ctx, mapPostgresError(err, entity.ErrAuthorNotFound),
+			getAuthorIdByNameKeyQuery, nameKey)
 	}
 
 	author.Id = id.String()
@@ -184,8 +341,7 @@ func (p *postgresRepository) GetAuthorInfo(ctx context.Context, authorId string)
 
 	var author entity.Author
 	err := measureQueryLatency("get_author_info", func() error {
-		return p.db.QueryRow(ctx, getAuthorQuery, authorId).
-			Scan(&author.Id, &author.Name)
+		return p.db.QueryRow(ctx, getAuthorQuery, authorId).Scan(authorFields(&author)...)
 	})
 
 	if err != nil {
@@ -195,8 +351,8 @@ func (p *postgresRepository) GetAuthorInfo(ctx context.Context, authorId string)
 	return &author, nil
 }
 
-func (p *postgresRepository) ChangeAuthor(ctx context.Context, authorId string, newAuthorName string) (txErr error) {
-	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to change author", layerPost, "author_id", authorId)
+func (p *postgresRepository) ChangeAuthor(ctx context.Context, update entity.AuthorUpdate) (txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to change author", layerPost, "author_id", update.Id)
 
 	tx, rollback, err := p.beginTx(ctx)
 	if err != nil {
@@ -205,27 +361,351 @@ func (p *postgresRepository) ChangeAuthor(ctx context.Context, authorId string,
 
 	defer rollback(txErr)
 
+	nameKey := p.authorNameKey(update.Name)
 	err = measureQueryLatency("change_author", func() error {
-		_, err = tx.Exec(ctx, updateAuthorQuery, newAuthorName, authorId)
-		return err
+		if update.ExpectedRevision != nil {
+			err := checkRevision(ctx, tx, lockAuthorRevisionQuery, update.Id, *update.ExpectedRevision,
+				entity.ErrAuthorNotFound, entity.ErrAuthorRevisionMismatch)
+			if err != nil {
+				return err
+			}
+		}
+
+		var id uuid.UUID
+		err := tx.QueryRow(ctx, updateAuthorQuery, update.Name, update.Id, nameKey, update.Biography,
+			update.BirthDate, update.DeathDate, update.ExternalIds,
+			entity.SortName(update.Name), entity.NameSearchKey(update.Name)).Scan(&id)
+		if err != nil {
+			return err
+		}
+
+		if update.Aliases != nil {
+			if _, err = tx.Exec(ctx, replaceAuthorAliasesQuery, update.Id, *update.Aliases); err != nil {
+				return err
+			}
+		}
+
+		return saveAuthorRevision(ctx, tx, update.Id)
+	})
+	if err != nil {
+		return p.withExistingId(ctx, mapPostgresError(err, entity.ErrAuthorNotFound),
+			getAuthorIdByNameKeyQuery, nameKey)
+	}
+
+	return nil
+}
+
+func (p *postgresRepository) DeleteAuthor(
+	ctx context.Context,
+	authorId string,
+	policy entity.DeleteAuthorPolicy,
+) (removal *entity.AuthorRemoval, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to delete author.", layerPost, "author_id", authorId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	start := time.Now()
+	defer func() {
+		dbQueryLatency.WithLabelValues("delete_author").Observe(time.Since(start).Seconds())
+	}()
+
+	// Блокировка автора защищает от параллельного добавления ему книг
+	var author entity.Author
+	err = tx.QueryRow(ctx, lockAuthorQuery, authorId).Scan(&author.Id, &author.Name)
+	if err != nil {
+		return nil, mapPostgresError(err, entity.ErrAuthorNotFound)
+	}
+
+	rows, err := tx.Query(ctx, getAuthorBooksQuery, authorId, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	books, err := collectBooks(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if policy == entity.DeleteAuthorPolicyRestrict && len(books) > 0 {
+		return nil, entity.ErrAuthorHasBooks
+	}
+
+	_, err = tx.Exec(ctx, deleteAuthorQuery, authorId)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedAt := make(map[string]time.Time)
+	if policy == entity.DeleteAuthorPolicyCascade && len(books) > 0 {
+		bookIds := make([]string, len(books))
+		for i, book := range books {
+			bookIds[i] = book.Id
+		}
+
+		deletedAt, err = deleteOrphanBooks(ctx, tx, bookIds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	removal = &entity.AuthorRemoval{
+		Author:        &author,
+		DetachedBooks: make([]*entity.Book, 0),
+		DeletedBooks:  make([]*entity.Book, 0),
+	}
+
+	bookIds := make([]string, len(books))
+	uniqueKeys := make([]*string, len(books))
+	for i, book := range books {
+		book.AuthorIds = lo.Without(book.AuthorIds, author.Id)
+		book.Contributors = lo.Reject(book.Contributors, func(contributor entity.Contributor, _ int) bool {
+			return contributor.AuthorId == author.Id
+		})
+		bookIds[i] = book.Id
+		uniqueKeys[i] = p.bookUniqueKey(book.Name, book.AuthorIds)
+
+		if at, ok := deletedAt[book.Id]; ok {
+			book.DeletedAt = &at
+			removal.DeletedBooks = append(removal.DeletedBooks, book)
+		} else {
+			removal.DetachedBooks = append(removal.DetachedBooks, book)
+		}
+	}
+
+	if len(books) > 0 {
+		// Книга без удаленного автора может совпасть с уже существующей
+		if err = p.updateBookUniqueKeys(ctx, tx, books, uniqueKeys); err != nil {
+			return nil, err
+		}
+	}
+
+	// Книги потеряли автора, а часть из них удалена
+	if err = saveBookRevisions(ctx, tx, bookIds); err != nil {
+		return nil, err
+	}
+
+	return removal, nil
+}
+
+func (p *postgresRepository) MergeAuthors(
+	ctx context.Context,
+	sourceIds []string,
+	targetId string,
+) (merge *entity.AuthorMerge, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to merge authors.", layerPost, "author_id", targetId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	start := time.Now()
+	defer func() {
+		dbQueryLatency.WithLabelValues("merge_authors").Observe(time.Since(start).Seconds())
+	}()
+
+	// Блокировка авторов защищает от параллельного добавления им книг и повторного слияния
+	rows, err := tx.Query(ctx, lockAuthorsQuery, append([]string{targetId}, sourceIds...))
+	if err != nil {
+		return nil, err
+	}
+
+	locked, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return nil, mapPostgresError(err, entity.ErrAuthorNotFound)
+	}
+
+	if len(locked) != len(sourceIds)+1 {
+		return nil, entity.ErrAuthorNotFound
+	}
+
+	var movedBookIds []string
+	err = tx.QueryRow(ctx, moveAuthorBooksQuery, sourceIds, targetId).Scan(&movedBookIds)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, mergeAuthorAliasesQuery, sourceIds, targetId)
+	if err != nil {
+		return nil, err
+	}
+
+	merge = &entity.AuthorMerge{
+		Target:    &entity.Author{},
+		SourceIds: sourceIds,
+		Books:     make([]*entity.Book, 0),
+	}
+
+	err = tx.QueryRow(ctx, insertAuthorRedirectsQuery, sourceIds, targetId).Scan(&merge.MergedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, deleteAuthorsQuery, sourceIds)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, touchAuthorQuery, targetId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRow(ctx, getAuthorQuery, targetId).Scan(authorFields(merge.Target)...)
+	if err != nil {
+		return nil, mapPostgresError(err, entity.ErrAuthorNotFound)
+	}
+
+	// Псевдонимы основной записи пополнились именами дубликатов
+	if err = saveAuthorRevision(ctx, tx, targetId); err != nil {
+		return nil, err
+	}
+
+	if len(movedBookIds) == 0 {
+		return merge, nil
+	}
+
+	rows, err = tx.Query(ctx, getAuthorBooksQuery, targetId, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	books, err := collectBooks(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	merge.Books = lo.Filter(books, func(book *entity.Book, _ int) bool {
+		return lo.Contains(movedBookIds, book.Id)
 	})
+
+	bookIds := make([]string, len(merge.Books))
+	uniqueKeys := make([]*string, len(merge.Books))
+	for i, book := range merge.Books {
+		bookIds[i] = book.Id
+		uniqueKeys[i] = p.bookUniqueKey(book.Name, book.AuthorIds)
+	}
+
+	// Книги дубликатов с одинаковым названием совпадают после слияния
+	if err = p.updateBookUniqueKeys(ctx, tx, merge.Books, uniqueKeys); err != nil {
+		return nil, err
+	}
+
+	if err = saveBookRevisions(ctx, tx, bookIds); err != nil {
+		return nil, err
+	}
+
+	return merge, nil
+}
+
+// updateBookUniqueKeys пересчитывает ключи книг и переносит в них revision и updated_at после обновления
+func (p *postgresRepository) updateBookUniqueKeys(
+	ctx context.Context,
+	tx pgx.Tx,
+	books []*entity.Book,
+	uniqueKeys []*string,
+) error {
+	bookIds := lo.Map(books, func(book *entity.Book, _ int) string {
+		return book.Id
+	})
+
+	rows, err := tx.Query(ctx, updateBookUniqueKeysQuery, bookIds, uniqueKeys)
 	if err != nil {
 		return err
 	}
 
+	byId := lo.KeyBy(books, func(book *entity.Book) string {
+		return book.Id
+	})
+
+	for rows.Next() {
+		var (
+			id        string
+			revision  int64
+			updatedAt time.Time
+		)
+
+		if err = rows.Scan(&id, &revision, &updatedAt); err != nil {
+			rows.Close()
+			return err
+		}
+
+		if book, ok := byId[id]; ok {
+			book.Revision = revision
+			book.UpdatedAt = updatedAt
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return p.withExistingId(ctx, mapPostgresError(err, entity.ErrBookNotFound),
+			getBookIdByUniqueKeyQuery, uniqueKeys)
+	}
+
 	return nil
 }
 
+// checkRevision блокирует запись и сверяет ее версию с ожидаемой до конца транзакции
+func checkRevision(
+	ctx context.Context,
+	tx pgx.Tx,
+	lockQuery string,
+	id string,
+	expected int64,
+	notFoundErr error,
+	mismatchErr error,
+) error {
+	var revision int64
+	if err := tx.QueryRow(ctx, lockQuery, id).Scan(&revision); err != nil {
+		return mapPostgresError(err, notFoundErr)
+	}
+
+	if revision != expected {
+		return mismatchErr
+	}
+
+	return nil
+}
+
+func deleteOrphanBooks(ctx context.Context, tx pgx.Tx, bookIds []string) (map[string]time.Time, error) {
+	rows, err := tx.Query(ctx, deleteOrphanBooksQuery, bookIds)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	deletedAt := make(map[string]time.Time)
+	for rows.Next() {
+		var id string
+		var at time.Time
+
+		if err = rows.Scan(&id, &at); err != nil {
+			return nil, err
+		}
+
+		deletedAt[id] = at
+	}
+
+	return deletedAt, rows.Err()
+}
+
 func (p *postgresRepository) addRelations(ctx context.Context, tx pgx.Tx, book *entity.Book) error {
-	rows := make([][]interface{}, len(book.AuthorIds))
-	for i, authorID := range book.AuthorIds {
-		rows[i] = []interface{}{authorID, book.Id}
+	rows := make([][]interface{}, len(book.Contributors))
+	for i, contributor := range book.Contributors {
+		rows[i] = []interface{}{contributor.AuthorId, book.Id, contributor.Role.String(), i + 1}
 	}
 
 	_, err := tx.CopyFrom(
 		ctx,
 		pgx.Identifier{"author_book"},
-		[]string{"author_id", "book_id"},
+		[]string{"author_id", "book_id", "role", "ordinal"},
 		pgx.CopyFromRows(rows),
 	)
 
@@ -265,6 +745,91 @@ func (p *postgresRepository) beginTx(
 	return tx, rollbackFunc, nil
 }
 
+// contributorColumns раскладывает участников на массивы id и ролей для unnest
+func contributorColumns(contributors []entity.Contributor) ([]string, []string) {
+	roles := make([]string, len(contributors))
+	for i, contributor := range contributors {
+		roles[i] = contributor.Role.String()
+	}
+
+	return entity.ContributorIds(contributors), roles
+}
+
+// authorFields возвращает поля для Scan в порядке колонок getAuthorQuery
+func authorFields(author *entity.Author) []any {
+	return []any{
+		&author.Id,
+		&author.Name,
+		&author.SortName,
+		&author.CreatedAt,
+		&author.UpdatedAt,
+		&author.Biography,
+		&author.BirthDate,
+		&author.DeathDate,
+		&author.Aliases,
+		&author.ExternalIds,
+		&author.Revision,
+	}
+}
+
+// bookAuthors - агрегированные связи author_book, упорядоченные по ordinal
+type bookAuthors struct {
+	ids   []uuid.UUID
+	roles []string
+}
+
+// fill заполняет авторов и участников книги после Scan
+func (a *bookAuthors) fill(book *entity.Book) {
+	book.AuthorIds = convertUUIDsToStrings(a.ids)
+	book.Contributors = make([]entity.Contributor, 0, len(a.roles))
+	for i, role := range a.roles {
+		book.Contributors = append(book.Contributors, entity.Contributor{
+			AuthorId: book.AuthorIds[i],
+			Role:     entity.ParseContributorRole(role),
+		})
+	}
+}
+
+// bookFields возвращает поля для Scan в порядке колонок запросов, читающих книгу
+func bookFields(book *entity.Book, authors *bookAuthors) []any {
+	return []any{
+		&book.Id,
+		&book.Name,
+		&book.CreatedAt,
+		&book.UpdatedAt,
+		&book.ISBN,
+		&book.PublicationYear,
+		&book.Language,
+		&book.PageCount,
+		&book.Description,
+		&book.PublisherId,
+		&book.Revision,
+		&authors.ids,
+		&authors.roles,
+		&book.GenreIds,
+		&book.Series,
+	}
+}
+
+func collectBooks(rows pgx.Rows) ([]*entity.Book, error) {
+	defer rows.Close()
+
+	books := make([]*entity.Book, 0)
+	for rows.Next() {
+		var book entity.Book
+		var authors bookAuthors
+
+		if err := rows.Scan(bookFields(&book, &authors)...); err != nil {
+			return nil, err
+		}
+
+		authors.fill(&book)
+		books = append(books, &book)
+	}
+
+	return books, rows.Err()
+}
+
 func convertUUIDsToStrings(uuids []uuid.UUID) []string {
 	strs := make([]string, len(uuids))
 	for i, id := range uuids {
@@ -280,9 +845,25 @@ func mapPostgresError(err error, notFoundErr error) error {
 	if errors.Is(err, sql.ErrNoRows) {
 		return notFoundErr
 	}
-	if errors.As(err, &ErrForeignKeyViolation) {
+
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case foreignKeyViolationCode:
 		return notFoundErr
+	case uniqueViolationCode:
+		if existsErr, ok := uniqueConstraintErrors[pgErr.ConstraintName]; ok {
+			return existsErr
+		}
+	case checkViolationCode:
+		if checkErr, ok := checkConstraintErrors[pgErr.ConstraintName]; ok {
+			return checkErr
+		}
 	}
+
 	return err
 }
 
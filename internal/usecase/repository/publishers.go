@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/project/library/internal/entity"
+)
+
+func (p *postgresRepository) CreatePublisher(
+	ctx context.Context,
+	publisher *entity.Publisher,
+) (resPublisher *entity.Publisher, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to create publisher.", layerPost,
+		"publisher_name", publisher.Name)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	err = measureQueryLatency("create_publisher", func() error {
+		return tx.QueryRow(ctx, insertPublisherQuery, publisher.Name).
+			Scan(&publisher.Id, &publisher.CreatedAt, &publisher.UpdatedAt)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return publisher, nil
+}
+
+func (p *postgresRepository) GetPublisher(ctx context.Context, publisherId string) (*entity.Publisher, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to get publisher.", layerPost, "publisher_id", publisherId)
+
+	var publisher entity.Publisher
+	err := measureQueryLatency("get_publisher", func() error {
+		return p.db.QueryRow(ctx, getPublisherQuery, publisherId).
+			Scan(&publisher.Id, &publisher.Name, &publisher.CreatedAt, &publisher.UpdatedAt)
+	})
+
+	if err != nil {
+		return nil, mapPostgresError(err, entity.ErrPublisherNotFound)
+	}
+
+	return &publisher, nil
+}
+
+func (p *postgresRepository) UpdatePublisher(ctx context.Context, publisherId string, newName string) (txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to update publisher.", layerPost, "publisher_id", publisherId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer rollback(txErr)
+
+	err = measureQueryLatency("update_publisher", func() error {
+		return tx.QueryRow(ctx, updatePublisherQuery, newName, publisherId).Scan(&publisherId)
+	})
+
+	return mapPostgresError(err, entity.ErrPublisherNotFound)
+}
+
+func (p *postgresRepository) DeletePublisher(
+	ctx context.Context,
+	publisherId string,
+) (books []*entity.Book, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to delete publisher.", layerPost, "publisher_id", publisherId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	var bookIds []string
+	err = measureQueryLatency("delete_publisher", func() error {
+		rows, err := tx.Query(ctx, lockPublisherBooksQuery, publisherId)
+		if err != nil {
+			return err
+		}
+
+		if bookIds, err = pgx.CollectRows(rows, pgx.RowTo[string]); err != nil {
+			return err
+		}
+
+		return tx.QueryRow(ctx, deletePublisherQuery, publisherId).Scan(&publisherId)
+	})
+
+	if err != nil {
+		return nil, mapPostgresError(err, entity.ErrPublisherNotFound)
+	}
+
+	if len(bookIds) == 0 {
+		return make([]*entity.Book, 0), nil
+	}
+
+	// Книги остались без издательства, в том числе удаленные
+	if err = saveBookRevisions(ctx, tx, bookIds); err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, getBooksByIdsQuery, bookIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectBooks(rows)
+}
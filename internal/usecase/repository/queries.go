@@ -2,54 +2,119 @@ package repository
 
 // AddBook
 const insertBookQuery = `
-	INSERT INTO book (name)
-	VALUES ($1)
-	RETURNING id, created_at, updated_at;
+	INSERT INTO book (name, unique_key, isbn, publication_year, language, page_count, description, publisher_id)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	RETURNING id, created_at, updated_at, revision;
 `
 
+// Колонки таблицы book в порядке bookFields
+const bookTableColumns = `
+		book.id,
+		book.name,
+		book.created_at,
+		book.updated_at,
+		book.isbn,
+		book.publication_year,
+		book.language,
+		book.page_count,
+		book.description,
+		book.publisher_id,
+		book.revision`
+
+// Колонки книги в порядке bookFields: запрос соединяет book с author_book и группирует строки по книге
+const bookColumns = bookTableColumns + `,
+		array_agg(author_book.author_id ORDER BY author_book.ordinal, author_book.author_id),
+		array_remove(array_agg(author_book.role ORDER BY author_book.ordinal, author_book.author_id), NULL),
+		ARRAY(SELECT genre_id::text FROM book_genre WHERE book_id = book.id ORDER BY genre_id),
+		(
+			SELECT coalesce(jsonb_agg(jsonb_build_object('series_id', series_id, 'volume', volume)
+				ORDER BY series_id), '[]')
+			FROM book_series
+			WHERE book_id = book.id
+		)`
+
 // GetBook
 const getBookQuery = `
-	SELECT 
-		book.id, 
-  		book.name, 
-  		book.created_at, 
-		book.updated_at, 
-  		array_agg(author_book.author_id) AS author_ids
-	FROM 
+	SELECT` + bookColumns + `
+	FROM
 		book
-	LEFT JOIN 
-  		author_book ON book.id = author_book.book_id
-	WHERE 
-  		book.id = $1
-	GROUP BY 
-  		book.id;
+	LEFT JOIN
+		author_book ON book.id = author_book.book_id
+	WHERE
+		book.id = $1
+		AND book.deleted_at IS NULL
+	GROUP BY
+		book.id;
+`
+
+// DeletePublisher. Книги после изменения, удаленные не возвращаются
+const getBooksByIdsQuery = `
+	SELECT` + bookColumns + `
+	FROM
+		book
+	LEFT JOIN
+		author_book ON book.id = author_book.book_id
+	WHERE
+		book.id = ANY($1::uuid[])
+		AND book.deleted_at IS NULL
+	GROUP BY
+		book.id
+	ORDER BY
+		book.id;
 `
 
-// UpdateBook
+// GetBookByISBN
+const getBookByISBNQuery = `
+	SELECT` + bookColumns + `
+	FROM
+		book
+	LEFT JOIN
+		author_book ON book.id = author_book.book_id
+	WHERE
+		book.isbn = $1
+		AND book.deleted_at IS NULL
+	GROUP BY
+		book.id;
+`
+
+// UpdateBook. Для необязательных полей NULL - не менять, пустая строка или 0 - удалить
 const updateBookQuery = `
-	UPDATE book SET name = $1 WHERE id = $2;
+	UPDATE book
+	SET
+		name = $1,
+		unique_key = $3,
+		isbn = CASE WHEN $4::text IS NULL THEN isbn ELSE NULLIF($4, '') END,
+		publication_year = CASE WHEN $5::int IS NULL THEN publication_year ELSE NULLIF($5, 0) END,
+		language = CASE WHEN $6::text IS NULL THEN language ELSE NULLIF($6, '') END,
+		page_count = CASE WHEN $7::int IS NULL THEN page_count ELSE NULLIF($7, 0) END,
+		description = CASE WHEN $8::text IS NULL THEN description ELSE NULLIF($8, '') END,
+		publisher_id = CASE WHEN $9::text IS NULL THEN publisher_id ELSE NULLIF($9, '')::uuid END
+	WHERE id = $2 AND deleted_at IS NULL;
+`
+
+// UpdateBook с ожидаемой версией. Блокировка не дает параллельному изменению пройти между проверкой и записью
+const lockBookRevisionQuery = `
+	SELECT revision FROM book WHERE id = $1 AND deleted_at IS NULL FOR UPDATE;
 `
 
-// UpdateBook
+// UpdateBook. $1 - id участников, $3 - их роли; позиция в массиве становится ordinal
 const updateBookAuthorsQuery = `
-	WITH inserted AS (
-		INSERT INTO author_book (author_id, book_id)
-		SELECT unnest($1::uuid[]), $2
-		ON CONFLICT (author_id, book_id) DO NOTHING
+	WITH contributors AS (
+		SELECT *
+		FROM unnest($1::uuid[], $3::text[]) WITH ORDINALITY AS contributor(author_id, role, ordinal)
+	), upserted AS (
+		INSERT INTO author_book (author_id, book_id, role, ordinal)
+		SELECT author_id, $2, role, ordinal FROM contributors
+		ON CONFLICT (author_id, book_id) DO UPDATE SET role = excluded.role, ordinal = excluded.ordinal
 	)
 	DELETE FROM author_book
 	WHERE book_id = $2
-		AND author_id NOT IN (SELECT unnest($1::uuid[]));
+		AND author_id NOT IN (SELECT author_id FROM contributors);
 `
 
-// GetAuthorBooks
+// GetAuthorBooks. $2 - роль автора или NULL для любой роли
 const getAuthorBooksQuery = `
-	SELECT
-		book.id,
-		book.name,
-		book.created_at,
-		book.updated_at,
-		array_agg(author_book.author_id)
+	SELECT` + bookColumns + `
 	FROM
 		book
 	LEFT JOIN
@@ -59,28 +124,1085 @@ const getAuthorBooksQuery = `
 			SELECT book_id
 			FROM author_book
 			WHERE author_id = $1
+				AND ($2::text IS NULL OR role = $2)
 		)
+		AND book.deleted_at IS NULL
 	GROUP BY
 		book.id;
 `
 
+// GetPublisherBooks
+const getPublisherBooksQuery = `
+	SELECT` + bookColumns + `
+	FROM
+		book
+	LEFT JOIN
+		author_book ON book.id = author_book.book_id
+	WHERE
+		book.publisher_id = $1
+		AND book.deleted_at IS NULL
+	GROUP BY
+		book.id;
+`
+
+// GetSeriesBooks. Книги идут по номеру тома
+const getSeriesBooksQuery = `
+	SELECT` + bookColumns + `
+	FROM
+		book
+	JOIN
+		book_series AS position ON book.id = position.book_id AND position.series_id = $1
+	LEFT JOIN
+		author_book ON book.id = author_book.book_id
+	WHERE
+		book.deleted_at IS NULL
+	GROUP BY
+		book.id, position.volume
+	ORDER BY
+		position.volume;
+`
+
+// DeleteBook. Измененная строка называется book, чтобы подошли общие колонки книги
+const deleteBookQuery = `
+	WITH deleted AS (
+		UPDATE book SET deleted_at = now()
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING *
+	)
+	SELECT` + bookColumns + `,
+		book.deleted_at
+	FROM
+		deleted AS book
+	LEFT JOIN
+		author_book ON book.id = author_book.book_id
+	GROUP BY` + bookTableColumns + `,
+		book.deleted_at;
+`
+
+// RestoreBook. Измененная строка называется book, как в DeleteBook
+const restoreBookQuery = `
+	WITH restored AS (
+		UPDATE book SET deleted_at = NULL
+		WHERE id = $1 AND deleted_at IS NOT NULL
+		RETURNING *
+	)
+	SELECT` + bookColumns + `
+	FROM
+		restored AS book
+	LEFT JOIN
+		author_book ON book.id = author_book.book_id
+	GROUP BY` + bookTableColumns + `;
+`
+
+// AddBook, UpdateBook, DeleteBook, RestoreBook, DeleteAuthor, MergeAuthors. Снимок книг $1 после изменения
+const insertBookRevisionsQuery = `
+	INSERT INTO book_revision (book_id, name, isbn, publication_year, language, page_count, description,
+		publisher_id, revision, author_ids, roles, deleted_at)
+	SELECT
+		book.id,
+		book.name,
+		book.isbn,
+		book.publication_year,
+		book.language,
+		book.page_count,
+		book.description,
+		book.publisher_id,
+		book.revision,
+		array_remove(array_agg(author_book.author_id ORDER BY author_book.ordinal, author_book.author_id), NULL),
+		array_remove(array_agg(author_book.role ORDER BY author_book.ordinal, author_book.author_id), NULL),
+		book.deleted_at
+	FROM
+		book
+	LEFT JOIN
+		author_book ON book.id = author_book.book_id
+	WHERE
+		book.id = ANY($1::uuid[])
+	GROUP BY
+		book.id;
+`
+
+// GetBookHistory, GetBookAsOf. Время изменения снимка становится updated_at книги
+const bookRevisionColumns = `
+		book_revision.book_id,
+		book_revision.name,
+		book.created_at,
+		book_revision.revised_at,
+		book_revision.isbn,
+		book_revision.publication_year,
+		book_revision.language,
+		book_revision.page_count,
+		book_revision.description,
+		book_revision.publisher_id,
+		book_revision.revision,
+		book_revision.author_ids,
+		book_revision.roles,
+		book_revision.deleted_at`
+
+// GetBookHistory. Сначала старые изменения
+const getBookHistoryQuery = `
+	SELECT` + bookRevisionColumns + `
+	FROM
+		book_revision
+	JOIN
+		book ON book.id = book_revision.book_id
+	WHERE
+		book_revision.book_id = $1
+	ORDER BY
+		book_revision.revised_at, book_revision.id;
+`
+
+// GetBookAsOf. Последний снимок не позже $2
+const getBookAsOfQuery = `
+	SELECT` + bookRevisionColumns + `
+	FROM
+		book_revision
+	JOIN
+		book ON book.id = book_revision.book_id
+	WHERE
+		book_revision.book_id = $1
+		AND book_revision.revised_at <= $2
+	ORDER BY
+		book_revision.revised_at DESC, book_revision.id DESC
+	LIMIT 1;
+`
+
+// ListBooks. Условия, сортировка и лимит подставляются из bookOrders и conditionBuilder
+const listBooksQuery = `
+	SELECT` + bookColumns + `
+	FROM
+		book
+	LEFT JOIN
+		author_book ON book.id = author_book.book_id
+	WHERE
+		%s
+	GROUP BY
+		book.id
+	ORDER BY
+		%s
+	LIMIT %d;
+`
+
+// SearchBooks. $1 - конфигурация, $2 - запрос; условия курсора и лимит подставляются при построении.
+// Сниппеты строятся только для книг страницы, т.к. ts_headline дорогой.
+const searchBooksQuery = `
+	WITH query AS (
+		SELECT websearch_to_tsquery($1::regconfig, $2) AS q
+	), page AS (
+		SELECT` + bookTableColumns + `,
+			ts_rank(book.name_tsv, query.q) AS rank
+		FROM
+			book, query
+		WHERE
+			book.name_tsv @@ query.q
+			AND book.deleted_at IS NULL
+			AND %s
+		ORDER BY
+			%s
+		LIMIT %d
+	)
+	SELECT` + bookColumns + `,
+		book.rank,
+		ts_headline($1::regconfig, book.name, (SELECT q FROM query))
+	FROM
+		page AS book
+	LEFT JOIN
+		author_book ON book.id = author_book.book_id
+	GROUP BY` + bookTableColumns + `,
+		book.rank
+	ORDER BY
+		book.rank DESC, book.id DESC;
+`
+
+// SearchAuthors. Оператор % использует порог pg_trgm.similarity_threshold
+// и индексы idx_author_name_trgm, idx_author_search_key_trgm, idx_author_alias_trgm
+const setSimilarityThresholdQuery = `
+	SELECT set_config('pg_trgm.similarity_threshold', $1, true);
+`
+
+// Автор находится по имени, его транслитерации ($3 - ключ поиска запроса) и псевдонимам,
+// похожесть - лучшая из них
+const searchAuthorsQuery = `
+	WITH candidates AS (
+		SELECT id AS author_id, similarity(name, $1) AS score
+		FROM author
+		WHERE name % $1
+		UNION ALL
+		SELECT id AS author_id, similarity(search_key, $3) AS score
+		FROM author
+		WHERE search_key % $3
+		UNION ALL
+		SELECT author_id, similarity(alias, $1) AS score
+		FROM author_alias
+		WHERE alias % $1
+	)
+	SELECT
+		author.id,
+		author.name,
+		max(candidates.score) AS score
+	FROM
+		candidates
+	JOIN
+		author ON author.id = candidates.author_id
+	GROUP BY
+		author.id, author.name
+	ORDER BY
+		score DESC, author.id
+	LIMIT $2;
+`
+
+// Поиск записей, с которыми конфликтует добавляемая или изменяемая
+const getAuthorIdByNameKeyQuery = `
+	SELECT id FROM author WHERE name_key = $1;
+`
+
+const getBookIdByUniqueKeyQuery = `
+	SELECT id FROM book WHERE unique_key = ANY($1::text[]) AND deleted_at IS NULL LIMIT 1;
+`
+
+// BackfillUniquenessKeys. Записи без ключа читаются страницами по id, $1 - последний id предыдущей страницы
+const getAuthorsWithoutNameKeyQuery = `
+	SELECT id, name
+	FROM author
+	WHERE name_key IS NULL AND id > $1
+	ORDER BY id
+	LIMIT $2;
+`
+
+const getBooksWithoutUniqueKeyQuery = `
+	SELECT
+		book.id,
+		book.name,
+		array_remove(array_agg(author_book.author_id::text), NULL)
+	FROM
+		book
+	LEFT JOIN
+		author_book ON book.id = author_book.book_id
+	WHERE
+		book.unique_key IS NULL AND book.id > $1
+	GROUP BY
+		book.id
+	ORDER BY
+		book.id
+	LIMIT $2;
+`
+
+// Заполнение ключа не считается изменением записи: триггеры не меняют updated_at и revision
+const keepRecordVersionQuery = `
+	SET LOCAL library.keep_version = 'on';
+`
+
+const setAuthorNameKeyQuery = `
+	UPDATE author SET name_key = $2 WHERE id = $1 AND name_key IS NULL;
+`
+
+const setBookUniqueKeyQuery = `
+	UPDATE book SET unique_key = $2 WHERE id = $1 AND unique_key IS NULL;
+`
+
+// Книга может конфликтовать и по ключу уникальности, и по ISBN. $3 - id изменяемой книги или NULL
+const getConflictingBookIdQuery = `
+	SELECT id
+	FROM book
+	WHERE (unique_key = $1 OR isbn = $2)
+		AND id IS DISTINCT FROM $3::uuid
+		AND deleted_at IS NULL
+	LIMIT 1;
+`
+
+const getBookDuplicateIdQuery = `
+	SELECT duplicate.id
+	FROM book AS duplicate
+	JOIN book AS original
+		ON original.unique_key = duplicate.unique_key OR original.isbn = duplicate.isbn
+	WHERE original.id = $1 AND duplicate.id <> $1 AND duplicate.deleted_at IS NULL
+	LIMIT 1;
+`
+
 // RegisterAuthor
 const insertAuthorQuery = `
-	INSERT INTO author (name)
-	VALUES ($1)
-	RETURNING id;
+	INSERT INTO author (name, name_key, biography, birth_date, death_date, external_ids, sort_name, search_key)
+	VALUES ($1, $2, $3, $4::date, $5::date, $6, $7, $8)
+	RETURNING id, created_at, updated_at, revision;
+`
+
+// RegisterAuthor, ChangeAuthor. Позиция в массиве $2 становится position
+const replaceAuthorAliasesQuery = `
+	WITH aliases AS (
+		SELECT *
+		FROM unnest($2::text[]) WITH ORDINALITY AS entry(alias, position)
+	), upserted AS (
+		INSERT INTO author_alias (author_id, alias, position)
+		SELECT $1, alias, position FROM aliases
+		ON CONFLICT (author_id, alias) DO UPDATE SET position = excluded.position
+	)
+	DELETE FROM author_alias
+	WHERE author_id = $1
+		AND alias NOT IN (SELECT alias FROM aliases);
 `
 
-// GetAuthorInfo
+// GetAuthorInfo. Id слитого автора перенаправляется на основную запись
 const getAuthorQuery = `
+	SELECT
+		id,
+		name,
+		sort_name,
+		created_at,
+		updated_at,
+		biography,
+		to_char(birth_date, 'YYYY-MM-DD'),
+		to_char(death_date, 'YYYY-MM-DD'),
+		ARRAY(SELECT alias FROM author_alias WHERE author_id = author.id ORDER BY position),
+		external_ids,
+		revision
+	FROM author
+	WHERE id = coalesce((SELECT target_id FROM author_redirect WHERE source_id = $1), $1);
+`
+
+// ChangeAuthor. Для $4-$6 NULL не меняет поле, пустая строка удаляет значение
+const updateAuthorQuery = `
+	UPDATE author
+	SET
+		name = $1,
+		name_key = $3,
+		biography = CASE WHEN $4::text IS NULL THEN biography ELSE NULLIF($4, '') END,
+		birth_date = CASE WHEN $5::text IS NULL THEN birth_date ELSE NULLIF($5, '')::date END,
+		death_date = CASE WHEN $6::text IS NULL THEN death_date ELSE NULLIF($6, '')::date END,
+		external_ids = coalesce($7::jsonb, external_ids),
+		sort_name = $8,
+		search_key = $9
+	WHERE id = $2
+	RETURNING id;
+`
+
+// ChangeAuthor с ожидаемой версией. Id слитого автора не перенаправляется, как и в updateAuthorQuery
+const lockAuthorRevisionQuery = `
+	SELECT revision FROM author WHERE id = $1 FOR UPDATE;
+`
+
+// ListAuthors. Колонка количества книг, условия и лимит подставляются при построении запроса
+const listAuthorsQuery = `
+	SELECT
+		author.id,
+		author.name,
+		author.sort_name,
+		%s
+	FROM
+		author
+	WHERE
+		%s
+	ORDER BY
+		%s
+	LIMIT %d;
+`
+
+// ListAuthors
+const authorBookCountColumn = `(
+			SELECT count(*)
+			FROM author_book
+			JOIN book ON book.id = author_book.book_id
+			WHERE author_book.author_id = author.id
+				AND book.deleted_at IS NULL
+		)`
+
+// DeleteAuthor
+const lockAuthorQuery = `
 	SELECT id, name
 	FROM author
+	WHERE id = $1
+	FOR UPDATE;
+`
+
+// DeleteAuthor
+const deleteAuthorQuery = `
+	DELETE FROM author WHERE id = $1; -- Связи author_book удаляются каскадно
+`
+
+// DeleteAuthor
+const deleteOrphanBooksQuery = `
+	UPDATE book SET deleted_at = now()
+	WHERE id = ANY($1)
+		AND deleted_at IS NULL
+		AND NOT EXISTS (
+			SELECT 1
+			FROM author_book
+			WHERE author_book.book_id = book.id
+		)
+	RETURNING id, deleted_at;
+`
+
+// DeleteAuthor, MergeAuthors. Набор авторов книг изменился, ключи уникальности пересчитываются
+const updateBookUniqueKeysQuery = `
+	UPDATE book SET unique_key = data.unique_key
+	FROM unnest($1::uuid[], $2::text[]) AS data(id, unique_key)
+	WHERE book.id = data.id
+	RETURNING book.id::text, book.revision, book.updated_at;
+`
+
+// MergeAuthors. Порядок блокировок по id исключает взаимоблокировку параллельных слияний
+const lockAuthorsQuery = `
+	SELECT id
+	FROM author
+	WHERE id = ANY($1::uuid[])
+	ORDER BY id
+	FOR UPDATE;
+`
+
+// MergeAuthors. Связи источников $1 переносятся на $2. Если книга уже связана с $2 или с несколькими
+// источниками, остается одна связь с ролью $2 или первого источника и наименьшим ordinal
+const moveAuthorBooksQuery = `
+	WITH moved AS (
+		DELETE FROM author_book
+		WHERE author_id = ANY($1::uuid[])
+		RETURNING book_id, role, ordinal
+	), upserted AS (
+		INSERT INTO author_book (author_id, book_id, role, ordinal)
+		SELECT DISTINCT ON (book_id) $2, book_id, role, ordinal
+		FROM moved
+		ORDER BY book_id, ordinal
+		ON CONFLICT (author_id, book_id) DO UPDATE SET ordinal = least(author_book.ordinal, excluded.ordinal)
+	)
+	SELECT ARRAY(SELECT DISTINCT book_id::text FROM moved);
+`
+
+// MergeAuthors. Имена и псевдонимы источников $1 добавляются в конец псевдонимов $2
+const mergeAuthorAliasesQuery = `
+	WITH candidates AS (
+		SELECT id AS author_id, name AS alias, 0 AS position
+		FROM author
+		WHERE id = ANY($1::uuid[])
+		UNION ALL
+		SELECT author_id, alias, position
+		FROM author_alias
+		WHERE author_id = ANY($1::uuid[])
+	)
+	INSERT INTO author_alias (author_id, alias, position)
+	SELECT
+		$2,
+		alias,
+		(SELECT coalesce(max(position), 0) FROM author_alias WHERE author_id = $2)
+			+ row_number() OVER (ORDER BY array_position($1::uuid[], author_id), position)
+	FROM candidates
+	WHERE alias <> (SELECT name FROM author WHERE id = $2)
+	ON CONFLICT (author_id, alias) DO NOTHING;
+`
+
+// MergeAuthors. Перенаправления на источники переводятся на $2, поэтому цепочек перенаправлений нет
+const insertAuthorRedirectsQuery = `
+	WITH rerouted AS (
+		UPDATE author_redirect SET target_id = $2 WHERE target_id = ANY($1::uuid[])
+	), inserted AS (
+		INSERT INTO author_redirect (source_id, target_id)
+		SELECT unnest($1::uuid[]), $2
+		RETURNING merged_at
+	)
+	SELECT max(merged_at) FROM inserted;
+`
+
+// MergeAuthors. Связи и псевдонимы источников уже перенесены
+const deleteAuthorsQuery = `
+	DELETE FROM author WHERE id = ANY($1::uuid[]);
+`
+
+// MergeAuthors. Обновление вызывает триггер updated_at
+const touchAuthorQuery = `
+	UPDATE author SET updated_at = now() WHERE id = $1;
+`
+
+// RegisterAuthor, ChangeAuthor, MergeAuthors. Снимок автора $1 после изменения
+const insertAuthorRevisionQuery = `
+	INSERT INTO author_revision (author_id, name, sort_name, biography, birth_date, death_date, aliases, external_ids,
+		revision)
+	SELECT
+		id,
+		name,
+		sort_name,
+		biography,
+		birth_date,
+		death_date,
+		ARRAY(SELECT alias FROM author_alias WHERE author_id = author.id ORDER BY position),
+		external_ids,
+		revision
+	FROM author
 	WHERE id = $1;
 `
 
-// ChangeAuthor
-const updateAuthorQuery = `
-	UPDATE author SET name = $1 WHERE id = $2;
+// GetAuthorHistory, GetAuthorInfoAsOf. Колонки в порядке getAuthorQuery и время изменения
+const authorRevisionColumns = `
+		author.id,
+		author_revision.name,
+		author_revision.sort_name,
+		author.created_at,
+		author_revision.revised_at,
+		author_revision.biography,
+		to_char(author_revision.birth_date, 'YYYY-MM-DD'),
+		to_char(author_revision.death_date, 'YYYY-MM-DD'),
+		author_revision.aliases,
+		author_revision.external_ids,
+		author_revision.revision,
+		author_revision.revised_at`
+
+// GetAuthorHistory. Сначала старые изменения, id слитого автора перенаправляется на основную запись
+const getAuthorHistoryQuery = `
+	SELECT` + authorRevisionColumns + `
+	FROM
+		author_revision
+	JOIN
+		author ON author.id = author_revision.author_id
+	WHERE
+		author_revision.author_id = coalesce((SELECT target_id FROM author_redirect WHERE source_id = $1), $1)
+	ORDER BY
+		author_revision.revised_at, author_revision.id;
+`
+
+// GetAuthorInfoAsOf. Последний снимок не позже $2
+const getAuthorAsOfQuery = `
+	SELECT` + authorRevisionColumns + `
+	FROM
+		author_revision
+	JOIN
+		author ON author.id = author_revision.author_id
+	WHERE
+		author_revision.author_id = coalesce((SELECT target_id FROM author_redirect WHERE source_id = $1), $1)
+		AND author_revision.revised_at <= $2
+	ORDER BY
+		author_revision.revised_at DESC, author_revision.id DESC
+	LIMIT 1;
+`
+
+// CreateGenre. Родитель задается при создании и не меняется, поэтому циклов в дереве нет
+const insertGenreQuery = `
+	INSERT INTO genre (name, parent_id)
+	VALUES ($1, $2)
+	RETURNING id, created_at;
+`
+
+const getGenreIdByParentNameQuery = `
+	SELECT id FROM genre WHERE parent_id IS NOT DISTINCT FROM $1::uuid AND name = $2;
+`
+
+// ListGenres. $1 - id родителя или NULL для всех жанров
+const listGenresQuery = `
+	SELECT id, name, parent_id, created_at
+	FROM genre
+	WHERE $1::uuid IS NULL OR parent_id = $1
+	ORDER BY name, id;
+`
+
+// AttachBookGenres, DetachBookGenres, AddBookToSeries. Книга блокируется, чтобы ее не удалили параллельно
+const lockBookQuery = `
+	SELECT id FROM book WHERE id = $1 AND deleted_at IS NULL FOR SHARE;
+`
+
+// AttachBookGenres. Возвращаются только новые привязки
+const insertBookGenresQuery = `
+	INSERT INTO book_genre (book_id, genre_id)
+	SELECT $1, unnest($2::uuid[])
+	ON CONFLICT (book_id, genre_id) DO NOTHING
+	RETURNING genre_id, created_at;
+`
+
+// DetachBookGenres
+const deleteBookGenresQuery = `
+	DELETE FROM book_genre WHERE book_id = $1 AND genre_id = ANY($2::uuid[]);
+`
+
+// CreateSeries
+const insertSeriesQuery = `
+	INSERT INTO series (name)
+	VALUES ($1)
+	RETURNING id, created_at;
+`
+
+// AddBookToSeries. Книга, уже входящая в серию, переносится на новый том
+const upsertBookSeriesQuery = `
+	INSERT INTO book_series (series_id, book_id, volume)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (series_id, book_id) DO UPDATE SET volume = excluded.volume;
+`
+
+// RemoveBookFromSeries
+const deleteBookSeriesQuery = `
+	DELETE FROM book_series WHERE series_id = $1 AND book_id = $2 RETURNING book_id;
+`
+
+// ListBooks. Книги перечисленных жанров и всех их поджанров
+const bookGenreSubtreeCondition = `book.id IN (
+			WITH RECURSIVE subtree AS (
+				SELECT id FROM genre WHERE id = ANY(%s::uuid[])
+				UNION
+				SELECT genre.id FROM genre JOIN subtree ON genre.parent_id = subtree.id
+			)
+			SELECT book_genre.book_id
+			FROM book_genre
+			JOIN subtree ON subtree.id = book_genre.genre_id
+		)`
+
+// AddCopy. Экземпляр добавляется только к неудаленной книге
+const insertCopyQuery = `
+	INSERT INTO book_copy (barcode, book_id, branch, location, condition)
+	SELECT $1, id, $3, $4, $5
+	FROM book
+	WHERE id = $2 AND deleted_at IS NULL
+	RETURNING id, created_at, updated_at;
+`
+
+const getCopyIdByBarcodeQuery = `
+	SELECT id FROM book_copy WHERE barcode = $1;
+`
+
+// UpdateCopy, RetireCopy
+const lockCopyQuery = `
+	SELECT status
+	FROM book_copy
+	WHERE id = $1
+	FOR UPDATE;
+`
+
+// UpdateCopy. Для $2-$5 NULL не меняет поле, пустая строка в $3 удаляет расположение
+const updateCopyQuery = `
+	UPDATE book_copy
+	SET
+		branch = coalesce($2, branch),
+		location = CASE WHEN $3::text IS NULL THEN location ELSE NULLIF($3, '') END,
+		condition = coalesce($4, condition),
+		status = coalesce($5, status)
+	WHERE id = $1;
+`
+
+// RetireCopy
+const retireCopyQuery = `
+	UPDATE book_copy
+	SET status = 'retired', retired_at = now()
+	WHERE id = $1
+	RETURNING id, book_id, barcode, branch, location, condition, status, created_at, updated_at, retired_at;
+`
+
+// ListBookCopies. $2 - филиал или пустая строка, $3 - включать ли списанные
+const listBookCopiesQuery = `
+	SELECT id, book_id, barcode, branch, location, condition, status, created_at, updated_at, retired_at
+	FROM book_copy
+	WHERE book_id = $1
+		AND ($2 = '' OR branch = $2)
+		AND ($3 OR status <> 'retired')
+	ORDER BY branch, barcode;
+`
+
+// GetBook. Выданный экземпляр не считается доступным
+const getCopyAvailabilityQuery = `
+	SELECT
+		count(*) FILTER (WHERE status <> 'retired'),
+		count(*) FILTER (WHERE status = 'available' AND NOT EXISTS (
+			SELECT 1 FROM loan WHERE loan.copy_id = book_copy.id AND loan.returned_at IS NULL
+		))
+	FROM book_copy
+	WHERE book_id = $1;
+`
+
+// RegisterPatron
+const insertPatronQuery = `
+	INSERT INTO patron (card_number, name, email, phone, expires_on)
+	VALUES ($1, $2, $3, $4, $5::date)
+	RETURNING id, created_at, updated_at;
+`
+
+const getPatronIdByCardNumberQuery = `
+	SELECT id FROM patron WHERE card_number = $1;
+`
+
+// GetPatron
+const getPatronQuery = `
+	SELECT id, card_number, name, email, phone, status, to_char(expires_on, 'YYYY-MM-DD'), created_at, updated_at
+	FROM patron
+	WHERE id = $1;
+`
+
+// UpdatePatron. Для $2-$6 NULL не меняет поле, пустая строка в $3 и $4 удаляет значение
+const updatePatronQuery = `
+	UPDATE patron
+	SET
+		name = coalesce($2, name),
+		email = CASE WHEN $3::text IS NULL THEN email ELSE NULLIF($3, '') END,
+		phone = CASE WHEN $4::text IS NULL THEN phone ELSE NULLIF($4, '') END,
+		status = coalesce($5, status),
+		expires_on = coalesce($6::date, expires_on)
+	WHERE id = $1
+	RETURNING id, card_number, name, email, phone, status, to_char(expires_on, 'YYYY-MM-DD'), created_at, updated_at;
+`
+
+// DeletePatron. Блокирует читателя, чтобы ему нельзя было выдать экземпляр до удаления
+const lockPatronLoansQuery = `
+	SELECT EXISTS (SELECT 1 FROM loan WHERE patron_id = $1 AND returned_at IS NULL)
+	FROM patron
+	WHERE id = $1
+	FOR UPDATE;
+`
+
+// DeletePatron
+const deletePatronQuery = `
+	DELETE FROM patron
+	WHERE id = $1
+	RETURNING id, card_number, name, email, phone, status, to_char(expires_on, 'YYYY-MM-DD'), created_at, updated_at;
+`
+
+// CheckoutCopy. Блокирует читателя, чтобы его нельзя было удалить во время выдачи
+const lockBorrowingPatronQuery = `
+	SELECT status, expires_on < current_date
+	FROM patron
+	WHERE id = $1
+	FOR SHARE;
+`
+
+const activeLoanExistsQuery = `
+	SELECT EXISTS (SELECT 1 FROM loan WHERE copy_id = $1 AND returned_at IS NULL);
+`
+
+// CheckoutCopy. $3 - период выдачи в днях
+const insertLoanQuery = `
+	WITH inserted AS (
+		INSERT INTO loan (copy_id, patron_id, due_at)
+		VALUES ($1, $2, now() + make_interval(days => $3))
+		RETURNING id, copy_id, patron_id, checked_out_at, due_at, renewals, returned_at, updated_at
+	)
+	SELECT inserted.id, inserted.copy_id, book_copy.book_id, inserted.patron_id, inserted.checked_out_at,
+		inserted.due_at, inserted.renewals, inserted.returned_at, inserted.updated_at
+	FROM inserted
+	JOIN book_copy ON book_copy.id = inserted.copy_id;
+`
+
+// ReturnCopy
+const returnLoanQuery = `
+	WITH returned AS (
+		UPDATE loan
+		SET returned_at = now()
+		WHERE copy_id = $1 AND returned_at IS NULL
+		RETURNING id, copy_id, patron_id, checked_out_at, due_at, renewals, returned_at, updated_at
+	)
+	SELECT returned.id, returned.copy_id, book_copy.book_id, returned.patron_id, returned.checked_out_at,
+		returned.due_at, returned.renewals, returned.returned_at, returned.updated_at
+	FROM returned
+	JOIN book_copy ON book_copy.id = returned.copy_id;
+`
+
+// RenewLoan
+const lockLoanQuery = `
+	SELECT patron_id, returned_at IS NOT NULL
+	FROM loan
+	WHERE id = $1
+	FOR UPDATE;
+`
+
+// RenewLoan. Просроченная выдача продлевается от текущего момента, $2 - период выдачи в днях
+const renewLoanQuery = `
+	WITH renewed AS (
+		UPDATE loan
+		SET
+			due_at = greatest(due_at, now()::timestamp) + make_interval(days => $2),
+			renewals = renewals + 1
+		WHERE id = $1
+		RETURNING id, copy_id, patron_id, checked_out_at, due_at, renewals, returned_at, updated_at
+	)
+	SELECT renewed.id, renewed.copy_id, book_copy.book_id, renewed.patron_id, renewed.checked_out_at,
+		renewed.due_at, renewed.renewals, renewed.returned_at, renewed.updated_at
+	FROM renewed
+	JOIN book_copy ON book_copy.id = renewed.copy_id;
+`
+
+// ListPatronLoans. $2 - включать ли возвращенные
+const listPatronLoansQuery = `
+	SELECT loan.id, loan.copy_id, book_copy.book_id, loan.patron_id, loan.checked_out_at,
+		loan.due_at, loan.renewals, loan.returned_at, loan.updated_at
+	FROM loan
+	JOIN book_copy ON book_copy.id = loan.copy_id
+	WHERE loan.patron_id = $1
+		AND ($2 OR loan.returned_at IS NULL)
+	ORDER BY loan.checked_out_at DESC, loan.id;
+`
+
+// PlaceHold. Удаленную книгу забронировать нельзя
+const insertHoldQuery = `
+	INSERT INTO book_hold (book_id, patron_id)
+	SELECT id, $2
+	FROM book
+	WHERE id = $1 AND deleted_at IS NULL
+	RETURNING id, book_id, patron_id, status, placed_at, ready_at, expires_at, updated_at;
+`
+
+const getActiveHoldIdQuery = `
+	SELECT id FROM book_hold WHERE book_id = $1 AND patron_id = $2 AND status IN ('waiting', 'ready');
+`
+
+// CancelHold
+const lockHoldQuery = `
+	SELECT status
+	FROM book_hold
+	WHERE id = $1
+	FOR UPDATE;
+`
+
+// CancelHold, ExpireHolds, CheckoutCopy
+const closeHoldQuery = `
+	UPDATE book_hold
+	SET status = $2
+	WHERE id = $1
+	RETURNING id, book_id, patron_id, status, placed_at, ready_at, expires_at, updated_at;
+`
+
+// FulfillNextHold. Первая бронь очереди блокируется без SKIP LOCKED, поэтому продвижения очереди книги идут
+// по одному, а бронь, закрытая за время ожидания, не проходит перепроверку и уступает следующей.
+// $2 - сколько дней готовая бронь ждет читателя
+const fulfillNextHoldQuery = `
+	UPDATE book_hold
+	SET status = 'ready', ready_at = now(), expires_at = now() + make_interval(days => $2)
+	WHERE id = (
+		SELECT id
+		FROM book_hold
+		WHERE book_id = $1 AND status = 'waiting'
+		ORDER BY placed_at, id
+		LIMIT 1
+		FOR UPDATE
+	)
+	RETURNING id, book_id, patron_id, status, placed_at, ready_at, expires_at, updated_at;
+`
+
+// ExpireHolds. $1 - максимальное число броней за один проход
+const expireHoldsQuery = `
+	UPDATE book_hold
+	SET status = 'expired'
+	WHERE id IN (
+		SELECT id
+		FROM book_hold
+		WHERE status = 'ready' AND expires_at < now()
+		ORDER BY expires_at
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING id, book_id, patron_id, status, placed_at, ready_at, expires_at, updated_at;
+`
+
+// CheckoutCopy. Выдача закрывает бронь читателя на книгу, даже если его очередь еще не подошла
+const lockPatronHoldQuery = `
+	SELECT id
+	FROM book_hold
+	WHERE book_id = $1 AND patron_id = $2 AND status IN ('waiting', 'ready')
+	FOR UPDATE;
+`
+
+// ListHolds. Пустые $1 и $2 не фильтруют, $3 - включать ли закрытые брони
+const listHoldsQuery = `
+	SELECT id, book_id, patron_id, status, placed_at, ready_at, expires_at, updated_at
+	FROM book_hold
+	WHERE ($1 = '' OR book_id = NULLIF($1, '')::uuid)
+		AND ($2 = '' OR patron_id = NULLIF($2, '')::uuid)
+		AND ($3 OR status IN ('waiting', 'ready'))
+	ORDER BY placed_at, id;
+`
+
+// RecordLateReturn. Штраф начисляется только существующему читателю
+const lockChargedPatronQuery = `
+	SELECT id
+	FROM patron
+	WHERE id = $1
+	FOR SHARE;
+`
+
+// RecordLateReturn. Сумма передается строкой, чтобы не терять точность
+const insertChargeQuery = `
+	INSERT INTO charge (patron_id, book_id, due_at, returned_at, days_late, amount)
+	VALUES ($1, $2, $3, $4, $5, $6::numeric)
+	RETURNING id, patron_id, book_id, due_at, returned_at, days_late, amount::text, status, waiver_reason,
+		settled_at, reminded_at, created_at, updated_at;
+`
+
+const getChargeIdQuery = `
+	SELECT id FROM charge WHERE patron_id = $1 AND book_id = $2 AND due_at = $3;
+`
+
+// PayCharge, WaiveCharge
+const lockChargeQuery = `
+	SELECT status
+	FROM charge
+	WHERE id = $1
+	FOR UPDATE;
+`
+
+// PayCharge, WaiveCharge. $3 - причина списания, у оплаты NULL
+const settleChargeQuery = `
+	UPDATE charge
+	SET status = $2, waiver_reason = $3, settled_at = now()
+	WHERE id = $1
+	RETURNING id, patron_id, book_id, due_at, returned_at, days_late, amount::text, status, waiver_reason,
+		settled_at, reminded_at, created_at, updated_at;
+`
+
+// ListCharges. Пустой $2 не фильтрует по книге, $3 - включать ли оплаченные и списанные штрафы
+const listChargesQuery = `
+	SELECT id, patron_id, book_id, due_at, returned_at, days_late, amount::text, status, waiver_reason,
+		settled_at, reminded_at, created_at, updated_at
+	FROM charge
+	WHERE patron_id = $1
+		AND ($2 = '' OR book_id = NULLIF($2, '')::uuid)
+		AND ($3 OR status = 'outstanding')
+	ORDER BY created_at DESC, id;
+`
+
+// ClaimChargeReminders. Отмечает неоплаченные штрафы, о которых не напоминали $2 дней.
+// Штрафы, которые обрабатывает другая транзакция, пропускаются. $1 - максимальное число штрафов за один проход
+const claimChargeRemindersQuery = `
+	UPDATE charge
+	SET reminded_at = now()
+	WHERE id IN (
+		SELECT id
+		FROM charge
+		WHERE status = 'outstanding' AND COALESCE(reminded_at, created_at) < now() - make_interval(days => $2)
+		ORDER BY COALESCE(reminded_at, created_at)
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING id, patron_id, book_id, due_at, returned_at, days_late, amount::text, status, waiver_reason,
+		settled_at, reminded_at, created_at, updated_at;
+`
+
+// DeletePatron. Читатель с неоплаченными штрафами не удаляется
+const outstandingChargesExistQuery = `
+	SELECT EXISTS (SELECT 1 FROM charge WHERE patron_id = $1 AND status = 'outstanding');
+`
+
+// DeletePatron. Рецензии удаляемого читателя удаляются каскадно, их оценки вычитаются из агрегата книг.
+// Рецензии блокируются раньше книг, как в UpdateReview и DeleteReview
+const removePatronReviewsFromRatingQuery = `
+	WITH removed AS (
+		SELECT book_id, rating FROM review WHERE patron_id = $1 FOR UPDATE
+	)
+	UPDATE book
+	SET
+		review_count = book.review_count - totals.review_count,
+		rating_sum = book.rating_sum - totals.rating_sum
+	FROM (
+		SELECT book_id, count(*) AS review_count, sum(rating) AS rating_sum FROM removed GROUP BY book_id
+	) AS totals
+	WHERE book.id = totals.book_id;
+`
+
+// CreateReview. Блокирует книгу, поэтому рецензии одной книги меняют агрегат по очереди
+const addBookRatingQuery = `
+	UPDATE book
+	SET review_count = review_count + 1, rating_sum = rating_sum + $2
+	WHERE id = $1 AND deleted_at IS NULL
+	RETURNING id;
+`
+
+// CreateReview
+const insertReviewQuery = `
+	INSERT INTO review (book_id, patron_id, rating, text)
+	VALUES ($1, $2, $3, $4)
+	RETURNING id, created_at, updated_at;
+`
+
+// CreateReview. Поиск рецензии, с которой конфликтует новая
+const getReviewIdQuery = `
+	SELECT id FROM review WHERE book_id = $1 AND patron_id = $2;
+`
+
+// UpdateReview, DeleteReview
+const lockReviewQuery = `
+	SELECT book_id, rating FROM review WHERE id = $1 FOR UPDATE;
+`
+
+// UpdateReview
+const updateReviewQuery = `
+	UPDATE review
+	SET
+		rating = coalesce($2, rating),
+		text = coalesce($3, text)
+	WHERE id = $1
+	RETURNING id, book_id, patron_id, rating, text, created_at, updated_at;
+`
+
+// UpdateReview, DeleteReview. $2 и $3 - изменение числа рецензий и суммы оценок.
+// Агрегат мягко удаленной книги тоже поддерживается, чтобы он был верным после RestoreBook
+const changeBookRatingQuery = `
+	UPDATE book
+	SET review_count = review_count + $2, rating_sum = rating_sum + $3
+	WHERE id = $1;
+`
+
+// DeleteReview
+const deleteReviewQuery = `
+	DELETE FROM review WHERE id = $1;
+`
+
+// ListBookReviews
+const listBookReviewsQuery = `
+	SELECT id, book_id, patron_id, rating, text, created_at, updated_at
+	FROM review
+	WHERE %s
+	ORDER BY %s
+	LIMIT %d;
+`
+
+// GetBook
+const getBookRatingQuery = `
+	SELECT review_count, rating_sum FROM book WHERE id = $1;
+`
+
+// UploadBookCover. Новая обложка заменяет прежнюю
+const upsertBookCoverQuery = `
+	INSERT INTO book_cover (book_id, content_type, size, sha256)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (book_id) DO UPDATE
+	SET content_type = EXCLUDED.content_type, size = EXCLUDED.size, sha256 = EXCLUDED.sha256
+	RETURNING created_at, updated_at;
+`
+
+// DownloadBookCover. Обложка удаленной книги не отдается
+const getBookCoverQuery = `
+	SELECT book_cover.book_id, book_cover.content_type, book_cover.size, book_cover.sha256,
+		book_cover.created_at, book_cover.updated_at
+	FROM book_cover
+		JOIN book ON book.id = book_cover.book_id
+	WHERE book_cover.book_id = $1 AND book.deleted_at IS NULL;
+`
+
+// PutBlob. Если такое содержимое уже сохранено, строка не возвращается
+const insertBlobQuery = `
+	INSERT INTO blob (sha256, oid, size)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (sha256) DO NOTHING
+	RETURNING oid;
+`
+
+// GetBlob
+const getBlobOidQuery = `
+	SELECT oid FROM blob WHERE sha256 = $1;
+`
+
+// CreatePublisher
+const insertPublisherQuery = `
+	INSERT INTO publisher (name)
+	VALUES ($1)
+	RETURNING id, created_at, updated_at;
+`
+
+// GetPublisher
+const getPublisherQuery = `
+	SELECT id, name, created_at, updated_at
+	FROM publisher
+	WHERE id = $1;
+`
+
+// UpdatePublisher
+const updatePublisherQuery = `
+	UPDATE publisher SET name = $1 WHERE id = $2 RETURNING id;
+`
+
+// DeletePublisher. ON DELETE SET NULL меняет книги издательства, поэтому они блокируются до удаления
+const lockPublisherBooksQuery = `
+	SELECT id::text FROM book WHERE publisher_id = $1 FOR UPDATE;
+`
+
+// DeletePublisher. У книг издательство обнуляется внешним ключом
+const deletePublisherQuery = `
+	DELETE FROM publisher WHERE id = $1 RETURNING id;
 `
 
 // Outbox
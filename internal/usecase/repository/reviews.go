@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/project/library/internal/entity"
+)
+
+// Новые рецензии первыми, индекс idx_review_book_created_at покрывает и порядок, и пагинацию
+var reviewCreatedAtOrder = keysetOrder{column: "review.created_at", cast: "timestamp", desc: true}
+
+const reviewCreatedAtOrderName = "created_at_desc"
+
+func (p *postgresRepository) CreateReview(ctx context.Context, review *entity.Review) (resReview *entity.Review, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to create review.", layerPost, "book_id", review.BookId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	err = measureQueryLatency("create_review", func() error {
+		var bookId string
+		if err := tx.QueryRow(ctx, addBookRatingQuery, review.BookId, review.Rating).Scan(&bookId); err != nil {
+			return mapPostgresError(err, entity.ErrBookNotFound)
+		}
+
+		// Книга уже заблокирована, поэтому нарушение внешнего ключа означает отсутствие читателя
+		err := tx.QueryRow(ctx, insertReviewQuery, review.BookId, review.PatronId, review.Rating, review.Text).
+			Scan(&review.Id, &review.CreatedAt, &review.UpdatedAt)
+		return mapPostgresError(err, entity.ErrPatronNotFound)
+	})
+
+	if err != nil {
+		return nil, p.withExistingId(ctx, err, getReviewIdQuery, review.BookId, review.PatronId)
+	}
+
+	return review, nil
+}
+
+func (p *postgresRepository) UpdateReview(ctx context.Context, update entity.ReviewUpdate) (resReview *entity.Review, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to update review.", layerPost, "review_id", update.Id)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	var review *entity.Review
+	err = measureQueryLatency("update_review", func() error {
+		var bookId string
+		var rating int32
+		if err := tx.QueryRow(ctx, lockReviewQuery, update.Id).Scan(&bookId, &rating); err != nil {
+			return mapPostgresError(err, entity.ErrReviewNotFound)
+		}
+
+		var err error
+		review, err = scanReview(tx.QueryRow(ctx, updateReviewQuery, update.Id, update.Rating, update.Text))
+		if err != nil {
+			return err
+		}
+
+		if review.Rating == rating {
+			return nil
+		}
+
+		_, err = tx.Exec(ctx, changeBookRatingQuery, bookId, 0, review.Rating-rating)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+func (p *postgresRepository) DeleteReview(ctx context.Context, reviewId string) (txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to delete review.", layerPost, "review_id", reviewId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer rollback(txErr)
+
+	return measureQueryLatency("delete_review", func() error {
+		var bookId string
+		var rating int32
+		if err := tx.QueryRow(ctx, lockReviewQuery, reviewId).Scan(&bookId, &rating); err != nil {
+			return mapPostgresError(err, entity.ErrReviewNotFound)
+		}
+
+		if _, err := tx.Exec(ctx, deleteReviewQuery, reviewId); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(ctx, changeBookRatingQuery, bookId, -1, -rating)
+		return err
+	})
+}
+
+func (p *postgresRepository) ListBookReviews(ctx context.Context, filter entity.ReviewFilter) (*entity.ReviewPage, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to list book reviews.", layerPost, "book_id", filter.BookId)
+	start := time.Now()
+	defer func() {
+		dbQueryLatency.WithLabelValues("list_book_reviews").Observe(time.Since(start).Seconds())
+	}()
+
+	cursor, err := entity.DecodeCursor(filter.PageToken, reviewCreatedAtOrderName)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := &conditionBuilder{}
+	builder.add("review.book_id = " + builder.arg(filter.BookId))
+
+	if cursor != nil {
+		builder.add(reviewCreatedAtOrder.after(builder, "review.id", cursor.Value, cursor.Id))
+	}
+
+	pageSize := entity.PageSize(filter.PageSize)
+	query := fmt.Sprintf(listBookReviewsQuery, builder.where(), reviewCreatedAtOrder.orderBy("review.id"), pageSize+1)
+
+	rows, err := p.db.Query(ctx, query, builder.args...)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := collectReviews(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &entity.ReviewPage{Reviews: reviews}
+	if len(reviews) > pageSize {
+		page.Reviews = reviews[:pageSize]
+		last := page.Reviews[pageSize-1]
+		page.NextPageToken = entity.EncodeCursor(entity.Cursor{
+			Order: reviewCreatedAtOrderName,
+			Value: last.CreatedAt.Format(time.RFC3339Nano),
+			Id:    last.Id,
+		})
+	}
+
+	return page, nil
+}
+
+func (p *postgresRepository) GetBookRating(ctx context.Context, bookId string) (*entity.BookRating, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to get book rating.", layerPost, "book_id", bookId)
+
+	var rating entity.BookRating
+	err := measureQueryLatency("get_book_rating", func() error {
+		return p.db.QueryRow(ctx, getBookRatingQuery, bookId).Scan(&rating.ReviewCount, &rating.RatingSum)
+	})
+
+	if err != nil {
+		return nil, mapPostgresError(err, entity.ErrBookNotFound)
+	}
+
+	return &rating, nil
+}
+
+func scanReview(row pgx.Row) (*entity.Review, error) {
+	var review entity.Review
+	if err := row.Scan(reviewFields(&review)...); err != nil {
+		return nil, err
+	}
+
+	return &review, nil
+}
+
+// reviewFields возвращает поля для Scan в порядке колонок запросов, читающих рецензию
+func reviewFields(review *entity.Review) []any {
+	return []any{
+		&review.Id,
+		&review.BookId,
+		&review.PatronId,
+		&review.Rating,
+		&review.Text,
+		&review.CreatedAt,
+		&review.UpdatedAt,
+	}
+}
+
+func collectReviews(rows pgx.Rows) ([]*entity.Review, error) {
+	defer rows.Close()
+
+	reviews := make([]*entity.Review, 0)
+	for rows.Next() {
+		var review entity.Review
+		if err := rows.Scan(reviewFields(&review)...); err != nil {
+			return nil, err
+		}
+
+		reviews = append(reviews, &review)
+	}
+
+	return reviews, rows.Err()
+}
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/project/library/internal/entity"
+)
+
+func (p *postgresRepository) GetBookHistory(ctx context.Context, bookId string) ([]*entity.BookRevision, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to get book history.", layerPost, "book_id", bookId)
+	start := time.Now()
+	defer func() {
+		dbQueryLatency.WithLabelValues("get_book_history").Observe(time.Since(start).Seconds())
+	}()
+
+	rows, err := p.db.Query(ctx, getBookHistoryQuery, bookId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	revisions := make([]*entity.BookRevision, 0)
+	for rows.Next() {
+		revision, err := scanBookRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, revision)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// У существующей книги есть хотя бы снимок создания
+	if len(revisions) == 0 {
+		return nil, entity.ErrBookNotFound
+	}
+
+	return revisions, nil
+}
+
+func (p *postgresRepository) GetBookAsOf(ctx context.Context, bookId string, asOf time.Time) (*entity.Book, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to get book as of time.", layerPost, "book_id", bookId)
+
+	var revision *entity.BookRevision
+	err := measureQueryLatency("get_book_as_of", func() error {
+		var err error
+		revision, err = scanBookRevision(p.db.QueryRow(ctx, getBookAsOfQuery, bookId, asOf))
+		return err
+	})
+
+	if err != nil {
+		return nil, mapPostgresError(err, entity.ErrBookNotFound)
+	}
+
+	// На этот момент книга была удалена
+	if revision.Book.DeletedAt != nil {
+		return nil, entity.ErrBookNotFound
+	}
+
+	return revision.Book, nil
+}
+
+func (p *postgresRepository) GetAuthorHistory(ctx context.Context, authorId string) ([]*entity.AuthorRevision, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to get author history.", layerPost, "author_id", authorId)
+	start := time.Now()
+	defer func() {
+		dbQueryLatency.WithLabelValues("get_author_history").Observe(time.Since(start).Seconds())
+	}()
+
+	rows, err := p.db.Query(ctx, getAuthorHistoryQuery, authorId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	revisions := make([]*entity.AuthorRevision, 0)
+	for rows.Next() {
+		revision, err := scanAuthorRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, revision)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(revisions) == 0 {
+		return nil, entity.ErrAuthorNotFound
+	}
+
+	return revisions, nil
+}
+
+func (p *postgresRepository) GetAuthorInfoAsOf(ctx context.Context, authorId string, asOf time.Time) (*entity.Author, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to get author info as of time.", layerPost, "author_id", authorId)
+
+	var revision *entity.AuthorRevision
+	err := measureQueryLatency("get_author_info_as_of", func() error {
+		var err error
+		revision, err = scanAuthorRevision(p.db.QueryRow(ctx, getAuthorAsOfQuery, authorId, asOf))
+		return err
+	})
+
+	if err != nil {
+		return nil, mapPostgresError(err, entity.ErrAuthorNotFound)
+	}
+
+	return revision.Author, nil
+}
+
+// saveBookRevisions сохраняет снимки книг в транзакции изменения, поэтому история совпадает с записанным
+func saveBookRevisions(ctx context.Context, tx pgx.Tx, bookIds []string) error {
+	if len(bookIds) == 0 {
+		return nil
+	}
+
+	_, err := tx.Exec(ctx, insertBookRevisionsQuery, bookIds)
+	return err
+}
+
+// saveAuthorRevision сохраняет снимок автора в транзакции изменения
+func saveAuthorRevision(ctx context.Context, tx pgx.Tx, authorId string) error {
+	_, err := tx.Exec(ctx, insertAuthorRevisionQuery, authorId)
+	return err
+}
+
+func scanBookRevision(row pgx.Row) (*entity.BookRevision, error) {
+	var book entity.Book
+	var authors bookAuthors
+
+	err := row.Scan(
+		&book.Id,
+		&book.Name,
+		&book.CreatedAt,
+		&book.UpdatedAt,
+		&book.ISBN,
+		&book.PublicationYear,
+		&book.Language,
+		&book.PageCount,
+		&book.Description,
+		&book.PublisherId,
+		&book.Revision,
+		&authors.ids,
+		&authors.roles,
+		&book.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	authors.fill(&book)
+
+	return &entity.BookRevision{Book: &book, RevisedAt: book.UpdatedAt}, nil
+}
+
+func scanAuthorRevision(row pgx.Row) (*entity.AuthorRevision, error) {
+	var author entity.Author
+	var revisedAt time.Time
+
+	if err := row.Scan(append(authorFields(&author), &revisedAt)...); err != nil {
+		return nil, err
+	}
+
+	return &entity.AuthorRevision{Author: &author, RevisedAt: revisedAt}, nil
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/project/library/internal/entity"
+)
+
+const defaultAuthorSearchLimit = 10
+
+func (p *postgresRepository) SearchAuthors(
+	ctx context.Context,
+	search entity.AuthorSearch,
+) (matches []*entity.AuthorMatch, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to search authors.", layerPost, "query", search.Query)
+
+	threshold := search.Threshold
+	if threshold == 0 {
+		threshold = p.cfg.Search.AuthorSimilarityThreshold
+	}
+
+	limit := search.Limit
+	if limit == 0 {
+		limit = defaultAuthorSearchLimit
+	}
+
+	// set_config(..., true) действует только до конца транзакции и не влияет на другие запросы пула
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	err = measureQueryLatency("search_authors", func() error {
+		_, err = tx.Exec(ctx, setSimilarityThresholdQuery,
+			strconv.FormatFloat(float64(threshold), 'f', -1, 32))
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, searchAuthorsQuery, search.Query, limit, entity.NameSearchKey(search.Query))
+		if err != nil {
+			return err
+		}
+
+		defer rows.Close()
+
+		matches = make([]*entity.AuthorMatch, 0)
+		for rows.Next() {
+			var author entity.Author
+			match := &entity.AuthorMatch{Author: &author}
+
+			if err = rows.Scan(&author.Id, &author.Name, &match.Similarity); err != nil {
+				return err
+			}
+
+			matches = append(matches, match)
+		}
+
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
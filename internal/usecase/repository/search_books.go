@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/project/library/internal/entity"
+)
+
+var bookRankOrder = keysetOrder{column: "ts_rank(book.name_tsv, query.q)", cast: "real", desc: true}
+
+const bookRankOrderName = "rank_desc"
+
+func (p *postgresRepository) SearchBooks(ctx context.Context, search entity.BookSearch) (*entity.BookSearchPage, error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to search books.", layerPost, "query", search.Query)
+	start := time.Now()
+	defer func() {
+		dbQueryLatency.WithLabelValues("search_books").Observe(time.Since(start).Seconds())
+	}()
+
+	cursor, err := entity.DecodeCursor(search.PageToken, bookRankOrderName)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := &conditionBuilder{}
+	// Конфигурация должна совпадать с той, по которой построена колонка book.name_tsv
+	builder.arg(p.cfg.Search.TextConfig)
+	builder.arg(search.Query)
+
+	if cursor != nil {
+		if _, err = strconv.ParseFloat(cursor.Value, 32); err != nil {
+			return nil, entity.ErrInvalidPageToken
+		}
+
+		builder.add(bookRankOrder.after(builder, "book.id", cursor.Value, cursor.Id))
+	}
+
+	pageSize := entity.PageSize(search.PageSize)
+	query := fmt.Sprintf(searchBooksQuery, builder.where(), bookRankOrder.orderBy("book.id"), pageSize+1)
+
+	rows, err := p.db.Query(ctx, query, builder.args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	hits := make([]*entity.BookSearchHit, 0)
+	for rows.Next() {
+		var book entity.Book
+		var authors bookAuthors
+		hit := &entity.BookSearchHit{Book: &book}
+
+		if err = rows.Scan(append(bookFields(&book, &authors), &hit.Rank, &hit.Snippet)...); err != nil {
+			return nil, err
+		}
+
+		authors.fill(&book)
+		hits = append(hits, hit)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	page := &entity.BookSearchPage{Hits: hits}
+	if len(hits) > pageSize {
+		page.Hits = hits[:pageSize]
+		last := page.Hits[pageSize-1]
+		page.NextPageToken = entity.EncodeCursor(entity.Cursor{
+			Order: bookRankOrderName,
+			Value: strconv.FormatFloat(float64(last.Rank), 'g', -1, 32),
+			Id:    last.Book.Id,
+		})
+	}
+
+	return page, nil
+}
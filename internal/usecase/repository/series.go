@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/project/library/internal/entity"
+)
+
+func (p *postgresRepository) CreateSeries(ctx context.Context, series *entity.Series) (resSeries *entity.Series, txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to create series.", layerPost, "series_name", series.Name)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback(txErr)
+
+	err = measureQueryLatency("create_series", func() error {
+		return tx.QueryRow(ctx, insertSeriesQuery, series.Name).Scan(&series.Id, &series.CreatedAt)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return series, nil
+}
+
+func (p *postgresRepository) AddBookToSeries(
+	ctx context.Context,
+	seriesId string,
+	bookId string,
+	volume int32,
+) (txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to add book to series.", layerPost, "series_id", seriesId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer rollback(txErr)
+
+	err = measureQueryLatency("lock_book", func() error {
+		return tx.QueryRow(ctx, lockBookQuery, bookId).Scan(&bookId)
+	})
+
+	if err != nil {
+		return mapPostgresError(err, entity.ErrBookNotFound)
+	}
+
+	err = measureQueryLatency("add_book_to_series", func() error {
+		_, err := tx.Exec(ctx, upsertBookSeriesQuery, seriesId, bookId, volume)
+		return err
+	})
+
+	// Книга уже заблокирована, поэтому внешний ключ может нарушить только серия
+	return mapPostgresError(err, entity.ErrSeriesNotFound)
+}
+
+func (p *postgresRepository) RemoveBookFromSeries(ctx context.Context, seriesId string, bookId string) (txErr error) {
+	entity.SendLoggerInfoWithCondition(p.logger, ctx, "Start to remove book from series.", layerPost, "series_id", seriesId)
+
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer rollback(txErr)
+
+	err = measureQueryLatency("remove_book_from_series", func() error {
+		return tx.QueryRow(ctx, deleteBookSeriesQuery, seriesId, bookId).Scan(&bookId)
+	})
+
+	return mapPostgresError(err, entity.ErrBookNotInSeries)
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/repository"
+)
+
+func TestFileBlobStorage(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	storage, err := repository.NewFileBlobStorage(dir, zap.NewNop())
+	require.NoError(t, err)
+
+	ctx := t.Context()
+	content := "cover content"
+	hash := sha256.Sum256([]byte(content))
+	wantSHA256 := hex.EncodeToString(hash[:])
+
+	first, err := storage.PutBlob(ctx, strings.NewReader(content))
+	require.NoError(t, err)
+	assert.Equal(t, &entity.Blob{SHA256: wantSHA256, Size: int64(len(content))}, first)
+
+	// Повторное содержимое не создает новый файл
+	second, err := storage.PutBlob(ctx, strings.NewReader(content))
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	files, err := os.ReadDir(filepath.Join(dir, wantSHA256[:2]))
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	// Временные файлы не остаются
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	reader, err := storage.GetBlob(ctx, wantSHA256)
+	require.NoError(t, err)
+	read, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, content, string(read))
+
+	_, err = storage.GetBlob(ctx, strings.Repeat("0", 64))
+	require.ErrorIs(t, err, entity.ErrBlobNotFound)
+
+	_, err = storage.GetBlob(ctx, "../../etc/passwd")
+	require.ErrorIs(t, err, entity.ErrBlobNotFound)
+}
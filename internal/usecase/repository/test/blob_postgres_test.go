@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/project/library/internal/entity"
+	"github.com/project/library/internal/usecase/repository"
+)
+
+// eofReader запоминает, было ли содержимое прочитано до конца
+type eofReader struct {
+	r   io.Reader
+	eof bool
+}
+
+func (r *eofReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if errors.Is(err, io.EOF) {
+		r.eof = true
+	}
+
+	return n, err
+}
+
+var errClientDisconnected = errors.New("client disconnected")
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errClientDisconnected
+}
+
+func TestPostgresBlobStorageReadsContentBeforeTransaction(t *testing.T) {
+	t.Parallel()
+
+	beginErr := errors.New("pool exhausted")
+
+	tests := []struct {
+		name        string
+		content     func() io.Reader
+		expectBegin bool
+		wantErr     error
+	}{
+		{
+			name:        "content is read before begin",
+			content:     func() io.Reader { return &eofReader{r: strings.NewReader("cover content")} },
+			expectBegin: true,
+			wantErr:     beginErr,
+		},
+		{
+			name:    "read error | no transaction",
+			content: func() io.Reader { return failingReader{} },
+			wantErr: errClientDisconnected,
+		},
+		{
+			name:    "too large | no transaction",
+			content: func() io.Reader { return strings.NewReader(strings.Repeat("x", entity.MaxCoverSize+1)) },
+			wantErr: entity.ErrCoverTooLarge,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockDB, err := pgxmock.NewPool()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			if test.expectBegin {
+				mockDB.ExpectBegin().WillReturnError(beginErr)
+			}
+
+			storage := repository.NewPostgresBlobStorage(mockDB, zap.NewNop())
+			content := test.content()
+
+			_, err = storage.PutBlob(t.Context(), content)
+			assert.ErrorIs(t, err, test.wantErr)
+
+			if reader, ok := content.(*eofReader); ok {
+				assert.True(t, reader.eof)
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
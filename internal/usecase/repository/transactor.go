@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"github.com/project/library/internal/entity"
 
-	"github.com/project/library/internal/entity"
-
 	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
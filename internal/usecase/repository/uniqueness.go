@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"github.com/project/library/internal/entity"
+)
+
+// uniqueConstraintErrors сопоставляет уникальные индексы с ошибками, которые возвращаются при конфликте
+var uniqueConstraintErrors = map[string]error{
+	"idx_author_name_key":      entity.ErrAuthorAlreadyExists,
+	"idx_book_unique_key":      entity.ErrBookAlreadyExists,
+	"idx_book_isbn":            entity.ErrBookAlreadyExists,
+	"genre_parent_name_key":    entity.ErrGenreAlreadyExists,
+	"book_series_volume_key":   entity.ErrSeriesVolumeTaken,
+	"book_copy_barcode_key":    entity.ErrCopyAlreadyExists,
+	"patron_card_number_key":   entity.ErrPatronAlreadyExists,
+	"idx_loan_active_copy_key": entity.ErrCopyOnLoan,
+	"idx_book_hold_active_key": entity.ErrHoldAlreadyExists,
+	"charge_late_return_key":   entity.ErrChargeAlreadyExists,
+	"review_book_patron_key":   entity.ErrReviewAlreadyExists,
+}
+
+// authorNameKey возвращает ключ уникальности автора или nil, если режим уникальности выключен
+func (p *postgresRepository) authorNameKey(name string) *string {
+	if !p.cfg.Uniqueness.Enabled {
+		return nil
+	}
+
+	key := entity.NormalizeName(name)
+	return &key
+}
+
+// bookUniqueKey возвращает ключ уникальности книги или nil, если режим уникальности выключен
+func (p *postgresRepository) bookUniqueKey(name string, authorIds []string) *string {
+	if !p.cfg.Uniqueness.Enabled {
+		return nil
+	}
+
+	key := entity.BookUniqueKey(name, authorIds)
+	return &key
+}
+
+// withExistingId дополняет ошибку уникальности id уже существующей записи.
+// Транзакция после ошибки прервана, поэтому запись ищется вне ее.
+func (p *postgresRepository) withExistingId(ctx context.Context, err error, query string, args ...any) error {
+	if _, ok := entity.AlreadyExistsResource(err); !ok {
+		return err
+	}
+
+	var id uuid.UUID
+	if lookupErr := p.db.QueryRow(ctx, query, args...).Scan(&id); lookupErr != nil {
+		entity.SendLoggerInfo(p.logger, ctx, "Failed to find existing record.", layerPost)
+		return err
+	}
+
+	return &entity.AlreadyExistsError{Err: err, Id: id.String()}
+}
+
+const backfillPageSize = 500
+
+// BackfillUniquenessKeys заполняет ключи уникальности записей, сохраненных при выключенном режиме.
+// Запись, совпадающая с уже проверенной, остается без ключа и попадает в лог вместе с id существующей:
+// такие дубликаты нужно слить или переименовать вручную, при следующем запуске они проверяются снова.
+func (p *postgresRepository) BackfillUniquenessKeys(ctx context.Context) error {
+	if !p.cfg.Uniqueness.Enabled {
+		return nil
+	}
+
+	entity.SendLoggerInfo(p.logger, ctx, "Start to backfill uniqueness keys.", layerPost)
+	start := time.Now()
+	defer func() {
+		dbQueryLatency.WithLabelValues("backfill_uniqueness_keys").Observe(time.Since(start).Seconds())
+	}()
+
+	err := p.backfillKeys(ctx, getAuthorsWithoutNameKeyQuery, func(rows pgx.Rows) (string, string, error) {
+		var id uuid.UUID
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			return "", "", err
+		}
+
+		return id.String(), *p.authorNameKey(name), nil
+	}, setAuthorNameKeyQuery, func(key string) (string, []any) {
+		return getAuthorIdByNameKeyQuery, []any{key}
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.backfillKeys(ctx, getBooksWithoutUniqueKeyQuery, func(rows pgx.Rows) (string, string, error) {
+		var id uuid.UUID
+		var name string
+		var authorIds []string
+		if err := rows.Scan(&id, &name, &authorIds); err != nil {
+			return "", "", err
+		}
+
+		return id.String(), *p.bookUniqueKey(name, authorIds), nil
+	}, setBookUniqueKeyQuery, func(key string) (string, []any) {
+		return getBookIdByUniqueKeyQuery, []any{[]string{key}}
+	})
+}
+
+// backfillKeys проходит записи без ключа страницами и сохраняет ключ каждой в отдельной транзакции,
+// чтобы конфликт одной записи не откатывал остальные
+func (p *postgresRepository) backfillKeys(
+	ctx context.Context,
+	selectQuery string,
+	scan func(rows pgx.Rows) (string, string, error),
+	updateQuery string,
+	existingQuery func(key string) (string, []any),
+) error {
+	lastId := uuid.Nil.String()
+	for {
+		rows, err := p.db.Query(ctx, selectQuery, lastId, backfillPageSize)
+		if err != nil {
+			return err
+		}
+
+		page := make([][2]string, 0, backfillPageSize) // id и ключ
+		for rows.Next() {
+			id, key, err := scan(rows)
+			if err != nil {
+				rows.Close()
+				return err
+			}
+
+			page = append(page, [2]string{id, key})
+		}
+
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		for _, record := range page {
+			err = p.setKey(ctx, updateQuery, record[0], record[1])
+			if _, ok := entity.AlreadyExistsResource(err); ok {
+				query, args := existingQuery(record[1])
+				err = p.withExistingId(ctx, err, query, args...)
+				p.logger.Warn("Record duplicates existing one, uniqueness key is not set.",
+					zap.String("id", record[0]), zap.Error(err))
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(page) < backfillPageSize {
+			return nil
+		}
+
+		lastId = page[len(page)-1][0]
+	}
+}
+
+func (p *postgresRepository) setKey(ctx context.Context, updateQuery string, id string, key string) (txErr error) {
+	tx, rollback, err := p.beginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer rollback(txErr)
+
+	if _, err = tx.Exec(ctx, keepRecordVersionQuery); err != nil {
+		return err
+	}
+
+	// Переводится только нарушение уникальности, остальные ошибки возвращаются как есть и прерывают заполнение
+	if _, err = tx.Exec(ctx, updateQuery, id, key); err != nil {
+		return mapPostgresError(err, err)
+	}
+
+	return nil
+}